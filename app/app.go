@@ -52,11 +52,12 @@ func Setup() (runAppFunc, cleanUpFunc) {
 		app.Clients, err = clients.Setup(app.Config, app.Tools)
 		logs.LogFatalIfErr(err)
 
-		app.Service = service.Setup(app.Clients, app.Tools)
+		app.Service = service.Setup(app.Clients, app.Tools, app.Config)
 		app.Servers = servers.Setup(app.Service, app.Tools)
 	})
 
 	initStep(2, func() {
+		app.Tools.AddCleanupFunc(app.Service.ShutdownHealth)
 		app.Tools.AddCleanupFunc(func() { app.Clients.CloseDB() })
 		app.Tools.AddCleanupFunc(app.Servers.Shutdown)
 		app.Tools.AddCleanupFuncWithErr(logs.SyncLogger)
@@ -72,7 +73,7 @@ func initStep(step int, fn func()) {
 }
 
 func (app *App) Run() {
-	workers.RunAll(app.Service)
+	workers.RunAll(app.Service, app.Config)
 	//gui.Start(app.Service)
 	app.Servers.Run()
 }
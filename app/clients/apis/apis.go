@@ -18,12 +18,12 @@ type APIClients struct {
 }
 
 func NewAPIs(cfg *core.APIsCfg) *APIClients {
-	gptAPIHTTPClient := newAPIHTTPClient()
-	weatherAPIHTTPClient := newAPIHTTPClient()
+	gptAPIHTTPClient := NewAPIHTTPClient(cfg.Fixtures)
+	weatherAPIHTTPClient := NewAPIHTTPClient(cfg.Fixtures)
 
 	return &APIClients{
-		gpt.NewAPI(gptAPIHTTPClient, cfg.GPT.APIKey, cfg.MockCalls, cfg.MockData),
-		weather.NewAPI(weatherAPIHTTPClient),
+		gpt.NewAPI(gptAPIHTTPClient, cfg),
+		weather.NewAPI(weatherAPIHTTPClient, cfg.Weather),
 	}
 }
 
@@ -31,13 +31,17 @@ func NewAPIs(cfg *core.APIsCfg) *APIClients {
 //
 // Do we gain anything by having them be injected from here instead of
 // being created in each API itself?
-func newAPIHTTPClient() *http.Client {
-	return &http.Client{
-		Timeout: 90 * time.Second,
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
+//
+// Their calls can be recorded and replayed, see fixtureTransport.
+func NewAPIHTTPClient(fixturesCfg core.FixturesCfg) *http.Client {
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: true,
 		},
 	}
+
+	return &http.Client{
+		Timeout:   90 * time.Second,
+		Transport: newFixtureTransport(transport, fixturesCfg),
+	}
 }
@@ -0,0 +1,237 @@
+package apis
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*         - Record & Replay -         */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+const (
+	fixturesOff    = "off"
+	fixturesRecord = "record"
+	fixturesReplay = "replay"
+)
+
+// Sits below the HTTP Clients of our APIs. When recording, it makes each call and saves it on a fixture file.
+// When replaying, it answers each call with the fixture that has the same method, URL and body, without making it.
+//
+// There's one file for each different call, so recording the same call again overwrites it.
+// Headers aren't part of the match and aren't saved, so API keys don't end up on the fixtures,
+// and neither do the secrets on the query, which are replaced on the saved URL.
+type fixtureTransport struct {
+	next   http.RoundTripper
+	mode   string
+	dir    string
+	strict bool
+}
+
+// Returns the next transport if fixtures are off.
+func newFixtureTransport(next http.RoundTripper, cfg core.FixturesCfg) http.RoundTripper {
+	switch cfg.Mode {
+	case fixturesOff, "":
+		return next
+	case fixturesRecord:
+		logs.LogFatalIfErr(os.MkdirAll(cfg.Dir, 0o755), "error creating fixtures dir: %v")
+	case fixturesReplay:
+	default:
+		logs.LogFatal(fmt.Errorf("invalid fixtures mode %q, it should be off, record or replay", cfg.Mode))
+	}
+
+	logs.LogImportant("API calls fixtures mode: " + cfg.Mode + " on " + cfg.Dir)
+	return &fixtureTransport{next: next, mode: cfg.Mode, dir: cfg.Dir, strict: cfg.Strict}
+}
+
+// One call and its response, as saved on its file.
+type fixture struct {
+	Request  fixtureRequest  `json:"request"`
+	Response fixtureResponse `json:"response"`
+}
+
+type fixtureRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"` // Normalized, see normalizeFixtureBody.
+}
+
+// Body is saved as text when it's valid UTF-8, like JSON. If not, like images, as base64 on BodyBytes.
+type fixtureResponse struct {
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+	BodyBytes   []byte `json:"body_bytes,omitempty"`
+}
+
+func (t *fixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readAndRestoreBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	request := fixtureRequest{
+		Method: req.Method,
+		URL:    redactURL(req.URL),
+		Body:   normalizeFixtureBody(reqBody, req.Header.Get("Content-Type")),
+	}
+	path := filepath.Join(t.dir, fixtureFileName(req.URL.Host, request))
+
+	if t.mode == fixturesReplay {
+		if fixture, ok := loadFixture(path); ok {
+			return fixture.Response.toHTTPResponse(req), nil
+		}
+		if t.strict {
+			return nil, fmt.Errorf("no fixture for %s %s on %s", request.Method, request.URL, path)
+		}
+		logs.LogStrange("No fixture for API call, making it", "method", request.Method, "url", request.URL)
+		return t.next.RoundTrip(req)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	response := fixtureResponse{Status: resp.StatusCode, ContentType: resp.Header.Get("Content-Type")}
+	if utf8.Valid(respBody) {
+		response.Body = string(respBody)
+	} else {
+		response.BodyBytes = respBody
+	}
+
+	// The call was already made, so if the fixture can't be saved we just log it.
+	var fixtureJSON bytes.Buffer
+	encoder := json.NewEncoder(&fixtureJSON)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(fixture{request, response})
+	if err == nil {
+		err = os.WriteFile(path, fixtureJSON.Bytes(), 0o644)
+	}
+	logs.WarnIfErr(err, "error saving API call fixture: %v")
+
+	return resp, nil
+}
+
+func (r fixtureResponse) toHTTPResponse(req *http.Request) *http.Response {
+	body := r.BodyBytes
+	if body == nil {
+		body = []byte(r.Body)
+	}
+
+	header := http.Header{}
+	if r.ContentType != "" {
+		header.Set("Content-Type", r.ContentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.Status, http.StatusText(r.Status)),
+		StatusCode:    r.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+func readAndRestoreBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body for fixture: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func loadFixture(path string) (fixture, bool) {
+	var f fixture
+	fixtureJSON, err := os.ReadFile(path)
+	if err != nil {
+		return f, false
+	}
+	if err := json.Unmarshal(fixtureJSON, &f); err != nil {
+		logs.LogStrange("Invalid API call fixture", "path", path, "error", err)
+		return f, false
+	}
+	return f, true
+}
+
+// Like post_api.openai.com_3f2a9c1b7d4e8a60.json, the hash being of the normalized call.
+func fixtureFileName(host string, request fixtureRequest) string {
+	hash := sha256.Sum256([]byte(request.Method + " " + request.URL + "\n" + request.Body))
+	host = strings.ReplaceAll(host, ":", "_")
+	return fmt.Sprintf("%s_%s_%s.json", strings.ToLower(request.Method), host, hex.EncodeToString(hash[:8]))
+}
+
+// Query params that hold keys are replaced, so they are neither saved nor matched.
+var secretQueryParams = []string{"appid", "api_key", "apikey", "key", "token", "access_token"}
+
+func redactURL(u *url.URL) string {
+	redacted := *u
+	query := redacted.Query()
+	for _, param := range secretQueryParams {
+		if query.Has(param) {
+			query.Set(param, "REDACTED")
+		}
+	}
+	redacted.RawQuery = query.Encode() // Also sorts them.
+	return redacted.String()
+}
+
+// JSON bodies are compacted with their keys sorted. Multipart ones get a fixed boundary, as it's random on each call.
+func normalizeFixtureBody(body []byte, contentType string) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var anyJSON any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if decoder.Decode(&anyJSON) == nil && !decoder.More() {
+		normalized, _ := json.Marshal(anyJSON) // Maps are marshalled with sorted keys.
+		return string(normalized)
+	}
+
+	if mediaType, params, err := mime.ParseMediaType(contentType); err == nil && strings.HasPrefix(mediaType, "multipart/") {
+		if boundary := params["boundary"]; boundary != "" {
+			body = bytes.ReplaceAll(body, []byte(boundary), []byte("fixture-boundary"))
+		}
+	}
+
+	if !utf8.Valid(body) {
+		hash := sha256.Sum256(body)
+		return "sha256:" + hex.EncodeToString(hash[:])
+	}
+	return string(body)
+}
@@ -0,0 +1,274 @@
+package gpt
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/utils"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*        - Anthropic Provider -       */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+var _ core.LLMProvider = &anthropicProvider{}
+
+// Talks to Anthropic's Messages API. The system prompt goes on its own field instead of on a message.
+type anthropicProvider struct {
+	httpClient *http.Client
+	cfg        core.AnthropicAPICfg
+	mocks      apiMocks
+}
+
+func newAnthropicProvider(httpClient *http.Client, cfg core.AnthropicAPICfg, mocks apiMocks) *anthropicProvider {
+	cfg.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
+	return &anthropicProvider{httpClient: httpClient, cfg: cfg, mocks: mocks}
+}
+
+func (p *anthropicProvider) Chat(ctx context.Context, req apimodels.LLMChatRequest) (apimodels.GPTChatResult, error) {
+	var url = p.cfg.BaseURL + "/messages"
+	var messagesReq = p.newMessagesRequest(req)
+
+	// These are from the response we will get from the API, they can be mocked.
+	var status = http.StatusOK
+	var body []byte
+	var err error
+
+	body, mockMatch := p.mocks.getMockedBody(url)
+
+	// If there's no matching mock data, we make the actual API call.
+	if !mockMatch {
+		status, body, err = utils.POSTWithHeaders(ctx, url, &messagesReq, p.headers(), p.httpClient)
+		logs.LogAPICall(url, status, body)
+		if err != nil {
+			return apimodels.GPTChatResult{}, logs.LogUnexpected(llmCallErr(apimodels.LLMAnthropic, err))
+		}
+	}
+	if status != http.StatusOK {
+		return apimodels.GPTChatResult{}, anthropicErr(status, body)
+	}
+
+	var response apimodels.AnthropicMessagesResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return apimodels.GPTChatResult{}, anthropicBadResponse(fmt.Errorf("error unmarshalling anthropic messages response: %w", err))
+	}
+
+	var content strings.Builder
+	var toolCalls []apimodels.GPTToolCall
+	for _, block := range response.Content {
+		switch block.Type {
+		case "text":
+			content.WriteString(block.Text)
+		case "tool_use":
+			toolCalls = append(toolCalls, apimodels.GPTToolCall{
+				ID: block.ID, Type: "function",
+				Function: apimodels.GPTToolCallFunction{Name: block.Name, Arguments: string(block.Input)},
+			})
+		}
+	}
+	if content.Len() == 0 && len(toolCalls) == 0 {
+		return apimodels.GPTChatResult{}, anthropicBadResponse(errors.New("no text in anthropic messages response"))
+	}
+
+	result := p.newResult(messagesReq, content.String(), response.Usage)
+	result.ToolCalls = toolCalls
+	return result, nil
+}
+
+// Same as Chat, but with stream: true. Anthropic's events are named, but their data has the type too,
+// so we only read the data lines.
+func (p *anthropicProvider) StreamChat(ctx context.Context, req apimodels.LLMChatRequest, onDelta func(delta string) error) (apimodels.GPTChatResult, error) {
+	var url = p.cfg.BaseURL + "/messages"
+	var messagesReq = p.newMessagesRequest(req)
+	messagesReq.Stream = true
+
+	// Mocked calls send the whole mocked answer as a single delta.
+	if _, mockMatch := p.mocks.getMockedBody(url); mockMatch {
+		result, err := p.Chat(ctx, req)
+		if err != nil {
+			return apimodels.GPTChatResult{}, err
+		}
+		return result, onDelta(result.Content)
+	}
+
+	// A whole answer can take longer than the client's timeout, streams are only cancelled through the ctx.
+	streamingClient := *p.httpClient
+	streamingClient.Timeout = 0
+
+	resp, err := utils.POSTStreamWithHeaders(ctx, url, &messagesReq, p.headers(), &streamingClient)
+	if err != nil {
+		return apimodels.GPTChatResult{}, logs.LogUnexpected(llmCallErr(apimodels.LLMAnthropic, err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		logs.LogAPICall(url, resp.StatusCode, body)
+		return apimodels.GPTChatResult{}, anthropicErr(resp.StatusCode, body)
+	}
+	logs.LogAPICall(url, resp.StatusCode, nil)
+
+	var usage apimodels.AnthropicUsage
+	var content strings.Builder
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		data, isData := strings.CutPrefix(scanner.Text(), "data: ")
+		if !isData {
+			continue // Event names, empty lines between events, or SSE comments.
+		}
+
+		var event apimodels.AnthropicStreamEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			return apimodels.GPTChatResult{}, anthropicBadResponse(fmt.Errorf("error unmarshalling anthropic stream event: %w", err))
+		}
+
+		switch event.Type {
+		case "message_start":
+			if event.Message != nil {
+				usage.InputTokens = event.Message.Usage.InputTokens
+			}
+		case "message_delta":
+			if event.Usage != nil {
+				usage.OutputTokens = event.Usage.OutputTokens
+			}
+		case "error":
+			if event.Error == nil {
+				event.Error = &apimodels.AnthropicError{Type: "api_error", Message: data}
+			}
+			return apimodels.GPTChatResult{}, &errs.LLMErr{
+				Provider: string(apimodels.LLMAnthropic), Kind: anthropicErrKind(event.Error.Type, http.StatusOK),
+				Status: http.StatusOK, Err: errors.New(event.Error.Message),
+			}
+		case "content_block_delta":
+			if event.Delta.Type != "text_delta" || event.Delta.Text == "" {
+				continue
+			}
+			content.WriteString(event.Delta.Text)
+			if err := onDelta(event.Delta.Text); err != nil {
+				return apimodels.GPTChatResult{}, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return apimodels.GPTChatResult{}, llmCallErr(apimodels.LLMAnthropic, fmt.Errorf("error reading anthropic stream: %w", err))
+	}
+
+	if content.Len() == 0 {
+		return apimodels.GPTChatResult{}, anthropicBadResponse(errors.New("empty anthropic stream"))
+	}
+	return p.newResult(messagesReq, content.String(), usage), nil
+}
+
+func (p *anthropicProvider) DefaultModel() apimodels.GPTs {
+	return apimodels.GPTs(p.cfg.DefaultModel)
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+func (p *anthropicProvider) newMessagesRequest(req apimodels.LLMChatRequest) apimodels.AnthropicMessagesRequest {
+	model := req.Model
+	if model == "" {
+		model = p.DefaultModel()
+	}
+	messagesReq := apimodels.AnthropicMessagesRequest{
+		Model:       model,
+		System:      req.System,
+		Messages:    toAnthropicMessages(req.Messages),
+		MaxTokens:   p.cfg.MaxTokens,
+		Temperature: req.Temperature,
+	}
+	if req.MaxTokens != nil {
+		messagesReq.MaxTokens = *req.MaxTokens
+	}
+	for _, tool := range req.Tools {
+		messagesReq.Tools = append(messagesReq.Tools, apimodels.AnthropicTool{Name: tool.Name, Description: tool.Description, InputSchema: tool.Parameters})
+	}
+	return messagesReq
+}
+
+// Anthropic has no tool role. Tool calls are tool_use blocks on the assistant's message, and their results
+// are tool_result blocks on the next user message, all of them on the same one.
+func toAnthropicMessages(msgs []apimodels.GPTChatMsg) []apimodels.AnthropicMessage {
+	anthropicMsgs := make([]apimodels.AnthropicMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		switch {
+		case msg.Role == apimodels.LLMRoleTool:
+			toolResult := apimodels.AnthropicContentBlock{Type: "tool_result", ToolUseID: msg.ToolCallID, Content: msg.Content}
+			if last := len(anthropicMsgs) - 1; last >= 0 && anthropicMsgs[last].Role == apimodels.LLMRoleUser {
+				if blocks, ok := anthropicMsgs[last].Content.([]apimodels.AnthropicContentBlock); ok {
+					anthropicMsgs[last].Content = append(blocks, toolResult)
+					continue
+				}
+			}
+			anthropicMsgs = append(anthropicMsgs, apimodels.AnthropicMessage{Role: apimodels.LLMRoleUser, Content: []apimodels.AnthropicContentBlock{toolResult}})
+
+		case len(msg.ToolCalls) > 0:
+			var blocks []apimodels.AnthropicContentBlock
+			if msg.Content != "" {
+				blocks = append(blocks, apimodels.AnthropicContentBlock{Type: "text", Text: msg.Content})
+			}
+			for _, toolCall := range msg.ToolCalls {
+				input := json.RawMessage(toolCall.Function.Arguments)
+				if !json.Valid(input) {
+					input = json.RawMessage("{}")
+				}
+				blocks = append(blocks, apimodels.AnthropicContentBlock{Type: "tool_use", ID: toolCall.ID, Name: toolCall.Function.Name, Input: input})
+			}
+			anthropicMsgs = append(anthropicMsgs, apimodels.AnthropicMessage{Role: msg.Role, Content: blocks})
+
+		default:
+			anthropicMsgs = append(anthropicMsgs, apimodels.AnthropicMessage{Role: msg.Role, Content: msg.Content})
+		}
+	}
+	return anthropicMsgs
+}
+
+func (p *anthropicProvider) headers() map[string]string {
+	return map[string]string{"x-api-key": p.cfg.APIKey, "anthropic-version": p.cfg.Version}
+}
+
+func (p *anthropicProvider) newResult(req apimodels.AnthropicMessagesRequest, content string, usage apimodels.AnthropicUsage) apimodels.GPTChatResult {
+	return apimodels.GPTChatResult{Content: content, Provider: apimodels.LLMAnthropic, Model: req.Model, Usage: usage.ToGPTChatUsage()}
+}
+
+func anthropicErr(status int, body []byte) error {
+	var response apimodels.AnthropicErrorResponse
+	if err := json.Unmarshal(body, &response); err != nil || response.Error.Message == "" {
+		response.Error.Message = strings.TrimSpace(string(body))
+	}
+	return &errs.LLMErr{
+		Provider: string(apimodels.LLMAnthropic), Kind: anthropicErrKind(response.Error.Type, status),
+		Status: status, Err: errors.New(response.Error.Message),
+	}
+}
+
+// Anthropic tells the error's type, which is more precise than its status. Overloads come as a 529.
+func anthropicErrKind(errType string, status int) errs.LLMErrKind {
+	switch errType {
+	case "invalid_request_error", "not_found_error", "request_too_large":
+		return errs.LLMInvalidRequest
+	case "authentication_error", "permission_error":
+		return errs.LLMUnauthorized
+	case "rate_limit_error":
+		return errs.LLMRateLimited
+	case "overloaded_error", "api_error":
+		return errs.LLMUnavailable
+	}
+	return errs.LLMErrKindFromStatus(status)
+}
+
+func anthropicBadResponse(err error) error {
+	return &errs.LLMErr{Provider: string(apimodels.LLMAnthropic), Kind: errs.LLMBadResponse, Status: http.StatusOK, Err: err}
+}
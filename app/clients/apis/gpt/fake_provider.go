@@ -0,0 +1,153 @@
+package gpt
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*           - Fake Provider -         */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+var _ core.LLMProvider = &fakeProvider{}
+
+// Answers in-process without calling anything, always the same way for the same request.
+// It echoes the last message, and counts tokens as words. Useful for tests and for working offline.
+// Temperature is ignored, MaxTokens cuts the answer.
+//
+// When offered tools, a last message like `/tool get_my_groups {}` asks for that tool,
+// and once the tools answer, their results are echoed.
+type fakeProvider struct{}
+
+func newFakeProvider() *fakeProvider {
+	return &fakeProvider{}
+}
+
+func (p *fakeProvider) Chat(ctx context.Context, req apimodels.LLMChatRequest) (apimodels.GPTChatResult, error) {
+	if err := ctx.Err(); err != nil {
+		return apimodels.GPTChatResult{}, err
+	}
+	return p.answer(req), nil
+}
+
+// Sends the answer word by word.
+func (p *fakeProvider) StreamChat(ctx context.Context, req apimodels.LLMChatRequest, onDelta func(delta string) error) (apimodels.GPTChatResult, error) {
+	result, err := p.Chat(ctx, req)
+	if err != nil {
+		return apimodels.GPTChatResult{}, err
+	}
+	for _, delta := range strings.SplitAfter(result.Content, " ") {
+		if err := onDelta(delta); err != nil {
+			return apimodels.GPTChatResult{}, err
+		}
+	}
+	return result, nil
+}
+
+func (p *fakeProvider) DefaultModel() apimodels.GPTs {
+	return apimodels.FAKE_LLM
+}
+
+func (p *fakeProvider) answer(req apimodels.LLMChatRequest) apimodels.GPTChatResult {
+	model := req.Model
+	if model == "" {
+		model = p.DefaultModel()
+	}
+
+	lastMsg := req.Messages[len(req.Messages)-1]
+	content := fmt.Sprintf("Title: Echo\nYou said: %s", lastMsg.Content)
+
+	var toolCalls []apimodels.GPTToolCall
+	if toolCommand, ok := strings.CutPrefix(lastMsg.Content, "/tool "); ok && len(req.Tools) > 0 && lastMsg.Role == apimodels.LLMRoleUser {
+		name, args, _ := strings.Cut(strings.TrimSpace(toolCommand), " ")
+		if args = strings.TrimSpace(args); args == "" {
+			args = "{}"
+		}
+		toolCalls = []apimodels.GPTToolCall{{
+			ID: fmt.Sprintf("call_%d", len(req.Messages)), Type: "function",
+			Function: apimodels.GPTToolCallFunction{Name: name, Arguments: args},
+		}}
+		content = ""
+	}
+	if lastMsg.Role == apimodels.LLMRoleTool {
+		var results []string
+		for i := len(req.Messages) - 1; i >= 0 && req.Messages[i].Role == apimodels.LLMRoleTool; i-- {
+			results = append([]string{req.Messages[i].Content}, results...)
+		}
+		content = fmt.Sprintf("Title: Tools\nThe tools said: %s", strings.Join(results, "\n"))
+	}
+
+	if req.MaxTokens != nil {
+		if words := strings.SplitAfter(content, " "); len(words) > *req.MaxTokens {
+			content = strings.Join(words[:*req.MaxTokens], "")
+		}
+	}
+
+	promptTokens := len(strings.Fields(req.System))
+	for _, msg := range req.Messages {
+		promptTokens += len(strings.Fields(msg.Content))
+	}
+	completionTokens := len(strings.Fields(content))
+
+	return apimodels.GPTChatResult{
+		Content:   content,
+		ToolCalls: toolCalls,
+		Provider:  apimodels.LLMFake,
+		Model:     model,
+		Usage:     apimodels.GPTChatUsage{InPrompt: promptTokens, InCompletion: completionTokens, InTotal: promptTokens + completionTokens},
+	}
+}
+
+/* -~-~-~- Embeddings -~-~-~- */
+
+const fakeEmbeddingDims = 256
+
+// Hashes each word of the text into one of the dimensions, so texts sharing words end up close.
+// Vectors are normalized, a text without words gets all zeros.
+func (p *fakeProvider) embed(ctx context.Context, texts []string) (apimodels.EmbeddingsResult, error) {
+	if err := ctx.Err(); err != nil {
+		return apimodels.EmbeddingsResult{}, err
+	}
+
+	result := apimodels.EmbeddingsResult{Provider: apimodels.LLMFake, Model: apimodels.FAKE_EMBEDDING, Vectors: make([][]float32, len(texts))}
+	for i, text := range texts {
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+
+		vector := make([]float32, fakeEmbeddingDims)
+		for _, word := range words {
+			hash := fnv.New32a()
+			hash.Write([]byte(word))
+			sum := hash.Sum32()
+
+			sign := float32(1)
+			if sum&(1<<31) != 0 {
+				sign = -1
+			}
+			vector[sum%fakeEmbeddingDims] += sign
+		}
+
+		var norm float64
+		for _, v := range vector {
+			norm += float64(v * v)
+		}
+		if norm > 0 {
+			norm = math.Sqrt(norm)
+			for j := range vector {
+				vector[j] = float32(float64(vector[j]) / norm)
+			}
+		}
+
+		result.Vectors[i] = vector
+		result.Usage.InPrompt += len(words)
+	}
+	result.Usage.InTotal = result.Usage.InPrompt
+
+	return result, nil
+}
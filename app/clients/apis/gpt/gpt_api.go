@@ -2,174 +2,191 @@ package gpt
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+	"errors"
+	"image"
 	"net/http"
 	"strings"
 
 	"github.com/gilperopiola/grpc-gateway-impl/app/core"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
-	"github.com/gilperopiola/grpc-gateway-impl/app/core/utils"
 )
 
 /* ———————————————————————————————— — — — GPT API — — — ———————————————————————————————— */
 
 var _ core.GPTAPI = &gptAPI{}
 
+// Sends each chat to the LLM provider its request asks for, or to the default one.
+// Images are always made by OpenAI's DALL·E.
+//
+// Chats that aren't streamed go through the responseCache first.
+// Embeddings are made by OpenAI or by the fake provider, depending on the config.
 type gptAPI struct {
-	httpClient *http.Client
-	baseURL    string
-	key        string
-
-	mockCalls bool
-	mockData  map[string]string
+	openAI          *openAIProvider
+	fake            *fakeProvider
+	providers       map[apimodels.LLMProviderName]core.LLMProvider
+	defaultProvider apimodels.LLMProviderName
+	embeddings      core.EmbeddingsCfg
+	cache           *responseCache
 }
 
-func NewAPI(httpClient *http.Client, apiKey string, mockCalls bool, mockData map[string]string) core.GPTAPI {
+func NewAPI(httpClient *http.Client, cfg *core.APIsCfg) core.GPTAPI {
+	mocks := apiMocks{enabled: cfg.MockCalls, data: cfg.MockData}
+	openAI := newOpenAIProvider(httpClient, cfg.GPT, mocks)
+	fake := newFakeProvider()
+
 	return &gptAPI{
-		httpClient: httpClient, baseURL: "https://api.openai.com/v1", key: apiKey,
-		mockCalls: mockCalls, mockData: mockData,
+		openAI: openAI,
+		fake:   fake,
+		providers: map[apimodels.LLMProviderName]core.LLMProvider{
+			apimodels.LLMOpenAI:    openAI,
+			apimodels.LLMAnthropic: newAnthropicProvider(httpClient, cfg.Anthropic, mocks),
+			apimodels.LLMFake:      fake,
+		},
+		defaultProvider: apimodels.LLMProviderName(cfg.LLMProvider),
+		embeddings:      cfg.Embeddings,
+		cache:           newResponseCache(cfg.GPTCache),
 	}
 }
 
 /* -~-~-~- Endpoints -~-~-~- */
 
-var chatInstructions = apimodels.GPTChatMsg{Role: "user", Content: `You are a highly intelligent and useful AI, showing expertise and excellence in all fields. 
-Keep your answers concise and to the point, without repetition. At the beginning of each message, write Title: and a short title for it.`}
+func (api *gptAPI) SendRequestToGPT(ctx context.Context, req apimodels.LLMChatRequest) (apimodels.GPTChatResult, error) {
+	provider, req, err := api.prepareChat(req)
+	if err != nil {
+		return apimodels.GPTChatResult{}, err
+	}
 
-func (api *gptAPI) SendRequestToGPT(ctx context.Context, prompt string, prevMsgs ...apimodels.GPTChatMsg) (string, error) {
+	cached, ok, store := api.cache.lookup(ctx, req)
+	if ok {
+		return cached, nil
+	}
 
-	gptModel := apimodels.GPT_O1_MINI
+	result, err := provider.Chat(ctx, req)
+	if err != nil {
+		return apimodels.GPTChatResult{}, err
+	}
+	store(result)
+	return result, nil
+}
 
-	// If no previous messages are provided, we use a default starting message.
-	if len(prevMsgs) == 0 {
-		prevMsgs = append(prevMsgs, chatInstructions)
+// Same as SendRequestToGPT, but each piece of the answer is passed to onDelta as soon as it arrives,
+// and the result has the whole answer. If onDelta fails, the stream is stopped.
+// Streams don't use the cache.
+func (api *gptAPI) StreamRequestToGPT(ctx context.Context, req apimodels.LLMChatRequest, onDelta func(delta string) error) (apimodels.GPTChatResult, error) {
+	provider, req, err := api.prepareChat(req)
+	if err != nil {
+		return apimodels.GPTChatResult{}, err
+	}
+	return provider.StreamChat(ctx, req, onDelta)
+}
 
-		if gptModel == apimodels.GPT_4O || gptModel == apimodels.GPT_4O_MINI {
-			prevMsgs[0].Role = "system"
-		}
+func (api *gptAPI) SendRequestToDallE(ctx context.Context, prompt string, size pbs.GPTImageSize) (apimodels.GPTImageMsg, error) {
+	return api.openAI.generateImage(ctx, prompt, size)
+}
+
+func (api *gptAPI) SendEditToDallE(ctx context.Context, img, mask image.Image, prompt string, size pbs.GPTImageSize) (apimodels.GPTImageMsg, error) {
+	return api.openAI.editImage(ctx, img, mask, prompt, size)
+}
+
+func (api *gptAPI) SendVariationToDallE(ctx context.Context, img image.Image, size pbs.GPTImageSize) (apimodels.GPTImageMsg, error) {
+	return api.openAI.makeImageVariation(ctx, img, size)
+}
+
+// Returns one vector per text, in the same order.
+func (api *gptAPI) SendToEmbeddings(ctx context.Context, texts []string) (apimodels.EmbeddingsResult, error) {
+	if apimodels.LLMProviderName(api.embeddings.Provider) == apimodels.LLMFake {
+		return api.fake.embed(ctx, texts)
 	}
+	return api.openAI.embed(ctx, texts, apimodels.GPTs(api.embeddings.Model))
+}
 
-	var url = api.baseURL + "/chat/completions"
-	var req = apimodels.GPTChatEndpointRequest{
-		Model:    gptModel,
-		Messages: append(prevMsgs, apimodels.GPTChatMsg{Role: "user", Content: prompt}),
+// Moderation is always done by OpenAI, whichever provider answers.
+func (api *gptAPI) SendToModeration(ctx context.Context, text string) (apimodels.ModerationResult, error) {
+	return api.openAI.moderate(ctx, text)
+}
+
+// Returns "" if there's no such provider.
+func (api *gptAPI) DefaultLLMModel(provider apimodels.LLMProviderName) apimodels.GPTs {
+	if provider == "" {
+		provider = api.defaultProvider
 	}
+	if llmProvider, ok := api.providers[provider]; ok {
+		return llmProvider.DefaultModel()
+	}
+	return ""
+}
 
-	// These are from the response we will get from the API, they can be mocked.
-	var status int
-	var body []byte
-	var err error
+/* -~-~-~- Helpers -~-~-~- */
 
-	mockMatch := false
-	if api.mockCalls {
-		for key, data := range api.mockData {
-			if strings.Contains(url, key) {
-				mockMatch = true
-				body = []byte(data)
-				status = http.StatusOK
-				logs.LogStrange("Mocked API call", "url", url, "responseBody", data)
-				break
-			}
-		}
+// Picks the request's provider and normalizes the request for it.
+// New chats get our default instructions, unless they bring their own system prompt.
+func (api *gptAPI) prepareChat(req apimodels.LLMChatRequest) (core.LLMProvider, apimodels.LLMChatRequest, error) {
+	if req.Provider == "" {
+		req.Provider = api.defaultProvider
 	}
 
-	// If there's no matching mock data, we make the actual API call.
-	if !mockMatch {
-		status, body, err = utils.POST(ctx, url, &req, api.key, api.httpClient)
-		logs.LogAPICall(url, status, body)
-		if err != nil {
-			return "", logs.LogUnexpected(err)
-		}
+	provider, ok := api.providers[req.Provider]
+	if !ok {
+		return nil, req, &errs.LLMErr{Provider: string(req.Provider), Kind: errs.LLMInvalidRequest, Err: errors.New("unknown llm provider")}
 	}
 
-	var response apimodels.GPTChatEndpointResponse
-	if err := json.Unmarshal(body, &response); err != nil {
-		return "", fmt.Errorf("error unmarshalling gpt chat response: %w", err)
+	req.System, req.Messages = normalizeMessages(req.System, req.Messages)
+	if len(req.Messages) == 0 {
+		return nil, req, &errs.LLMErr{Provider: string(req.Provider), Kind: errs.LLMInvalidRequest, Err: errors.New("no messages to send")}
 	}
-	if len(response.Choices) == 0 {
-		return "", fmt.Errorf("no choices in gpt chat response")
+
+	if req.System == "" && len(req.Messages) == 1 {
+		req.System = apimodels.DefaultSystemPrompt
 	}
 
-	return response.Choices[0].Message.Content, nil
+	return provider, req, nil
 }
 
-func (api *gptAPI) SendRequestToDallE(ctx context.Context, prompt string, size pbs.GPTImageSize) (apimodels.GPTImageMsg, error) {
-	url := api.baseURL + "/images/generations"
-	req := apimodels.GPTImageEndpointRequest{
-		Model:  apimodels.DALL_E3,
-		Prompt: prompt,
-		Size:   api.imageSizeToActualPixels(size),
-		N:      1,
-	}
-	if size == pbs.GPTImageSize_TINY {
-		req.Model = apimodels.DALL_E2
-		req.N = 1
-	}
-
-	// These are from the response we will get from the API, they can be mocked.
-	var status int
-	var body []byte
-	var err error
-
-	mockMatch := false
-	if api.mockCalls {
-		for key, data := range api.mockData {
-			if strings.Contains(url, key) {
-				mockMatch = true
-				body = []byte(data)
-				status = http.StatusOK
-				logs.LogStrange("Mocked API call", "url", url, "responseBody", data)
-				break
-			}
-		}
+// System messages are moved to the system prompt, and every other role becomes user, assistant or tool.
+func normalizeMessages(system string, msgs []apimodels.GPTChatMsg) (string, []apimodels.GPTChatMsg) {
+	var systemParts []string
+	if system != "" {
+		systemParts = append(systemParts, system)
 	}
 
-	// If there's no matching mock data, we make the actual API call.
-	if !mockMatch {
-		status, body, err = utils.POST(ctx, url, &req, api.key, api.httpClient)
-		logs.LogAPICall(url, status, body)
-		if err != nil {
-			return apimodels.GPTImageMsg{}, logs.LogUnexpected(err)
+	normalized := make([]apimodels.GPTChatMsg, 0, len(msgs))
+	for _, msg := range msgs {
+		switch strings.ToLower(strings.TrimSpace(msg.Role)) {
+		case "system", "developer":
+			systemParts = append(systemParts, msg.Content)
+			continue
+		case "assistant", "ai", "bot", "model":
+			msg.Role = apimodels.LLMRoleAssistant
+		case "tool":
+			msg.Role = apimodels.LLMRoleTool
+		default:
+			msg.Role = apimodels.LLMRoleUser
 		}
+		normalized = append(normalized, msg)
 	}
 
-	var response apimodels.GPTImageEndpointResponse
-	if err := json.Unmarshal(body, &response); err != nil {
-		return apimodels.GPTImageMsg{}, fmt.Errorf("error unmarshalling dall-e response: %w. request: %+v", err, req)
-	}
-	if len(response.Data) == 0 {
-
-		// Dall-E-2
-		if req.Model == apimodels.DALL_E2 {
-			if len(response.ImageURLs) == 0 {
-				return apimodels.GPTImageMsg{}, fmt.Errorf("no image URLs in dall-e 2 response")
-			}
-			return apimodels.GPTImageMsg{URL: response.ImageURLs[0], RevisedPrompt: response.RevisedPrompt}, nil
-		}
-		return apimodels.GPTImageMsg{}, fmt.Errorf("no data in dall-e 3 response")
-	}
+	return strings.Join(systemParts, "\n\n"), normalized
+}
 
-	// Dall-E-3
-	return apimodels.GPTImageMsg{URL: response.Data[0].URL, RevisedPrompt: response.Data[0].RevisedPrompt}, nil
+// When calls are mocked, the mock data's keys are matched against each call's URL.
+type apiMocks struct {
+	enabled bool
+	data    map[string]string
 }
 
-func (api *gptAPI) imageSizeToActualPixels(size pbs.GPTImageSize) string {
-	switch size {
-	case pbs.GPTImageSize_DEFAULT:
-		return "1024x1024"
-	case pbs.GPTImageSize_WIDE:
-		return "1792x1024"
-	case pbs.GPTImageSize_TALL:
-		return "1024x1792"
-	case pbs.GPTImageSize_SMALL:
-		return "512x512"
-	case pbs.GPTImageSize_TINY:
-		return "256x256"
-	default:
-		logs.LogUnexpected(fmt.Errorf("invalid dall-eimage size: %v", size))
-		return "1024x1024"
+// Returns the mocked response body for the URL, if calls are mocked and there's one for it.
+func (mocks apiMocks) getMockedBody(url string) ([]byte, bool) {
+	if !mocks.enabled {
+		return nil, false
+	}
+	for key, data := range mocks.data {
+		if strings.Contains(url, key) {
+			logs.LogStrange("Mocked API call", "url", url, "responseBody", data)
+			return []byte(data), true
+		}
 	}
+	return nil, false
 }
@@ -0,0 +1,513 @@
+package gpt
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/utils"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - OpenAI Provider -        */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+var _ core.LLMProvider = &openAIProvider{}
+
+// Talks to OpenAI, or to any server with an OpenAI-compatible API on its base URL,
+// like llama.cpp or Ollama.
+type openAIProvider struct {
+	httpClient   *http.Client
+	baseURL      string
+	key          string
+	defaultModel apimodels.GPTs
+	mocks        apiMocks
+
+	moderationURL string // Not on baseURL, see core.ChatGptAPICfg.
+	moderationKey string
+}
+
+func newOpenAIProvider(httpClient *http.Client, cfg core.ChatGptAPICfg, mocks apiMocks) *openAIProvider {
+	moderationKey := cfg.ModerationAPIKey
+	if moderationKey == "" {
+		moderationKey = cfg.APIKey
+	}
+
+	return &openAIProvider{
+		httpClient:    httpClient,
+		baseURL:       strings.TrimSuffix(cfg.BaseURL, "/"),
+		key:           cfg.APIKey,
+		defaultModel:  apimodels.GPTs(cfg.DefaultModel),
+		mocks:         mocks,
+		moderationURL: strings.TrimSuffix(cfg.ModerationBaseURL, "/") + "/moderations",
+		moderationKey: moderationKey,
+	}
+}
+
+func (p *openAIProvider) Chat(ctx context.Context, req apimodels.LLMChatRequest) (apimodels.GPTChatResult, error) {
+	var url = p.baseURL + "/chat/completions"
+	var chatReq = p.newChatRequest(req)
+
+	// These are from the response we will get from the API, they can be mocked.
+	var status = http.StatusOK
+	var body []byte
+	var err error
+
+	body, mockMatch := p.mocks.getMockedBody(url)
+
+	// If there's no matching mock data, we make the actual API call.
+	if !mockMatch {
+		status, body, err = utils.POST(ctx, url, &chatReq, p.key, p.httpClient)
+		logs.LogAPICall(url, status, body)
+		if err != nil {
+			return apimodels.GPTChatResult{}, logs.LogUnexpected(llmCallErr(apimodels.LLMOpenAI, err))
+		}
+	}
+	if status != http.StatusOK {
+		return apimodels.GPTChatResult{}, openAIErr(status, body)
+	}
+
+	var response apimodels.GPTChatEndpointResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return apimodels.GPTChatResult{}, openAIBadResponse(fmt.Errorf("error unmarshalling gpt chat response: %w", err))
+	}
+	if len(response.Choices) == 0 {
+		return apimodels.GPTChatResult{}, openAIBadResponse(errors.New("no choices in gpt chat response"))
+	}
+
+	result := p.newResult(chatReq, response.Choices[0].Message.Content, response.Usage)
+	result.ToolCalls = response.Choices[0].Message.ToolCalls
+	return result, nil
+}
+
+// Same as Chat, but with stream: true.
+func (p *openAIProvider) StreamChat(ctx context.Context, req apimodels.LLMChatRequest, onDelta func(delta string) error) (apimodels.GPTChatResult, error) {
+	var url = p.baseURL + "/chat/completions"
+	var chatReq = p.newChatRequest(req)
+	chatReq.Stream = true
+	chatReq.StreamOptions = &apimodels.GPTChatStreamOptions{IncludeUsage: true}
+
+	// Mocked calls send the whole mocked answer as a single delta.
+	if _, mockMatch := p.mocks.getMockedBody(url); mockMatch {
+		result, err := p.Chat(ctx, req)
+		if err != nil {
+			return apimodels.GPTChatResult{}, err
+		}
+		return result, onDelta(result.Content)
+	}
+
+	// A whole answer can take longer than the client's timeout, streams are only cancelled through the ctx.
+	streamingClient := *p.httpClient
+	streamingClient.Timeout = 0
+
+	resp, err := utils.POSTStream(ctx, url, &chatReq, p.key, &streamingClient)
+	if err != nil {
+		return apimodels.GPTChatResult{}, logs.LogUnexpected(llmCallErr(apimodels.LLMOpenAI, err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		logs.LogAPICall(url, resp.StatusCode, body)
+		return apimodels.GPTChatResult{}, openAIErr(resp.StatusCode, body)
+	}
+	logs.LogAPICall(url, resp.StatusCode, nil)
+
+	var usage apimodels.GPTChatUsage
+	var content strings.Builder
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		data, isData := strings.CutPrefix(scanner.Text(), "data: ")
+		if !isData {
+			continue // Empty lines between events, or SSE comments.
+		}
+		if data == "[DONE]" {
+			break
+		}
+
+		var chunk apimodels.GPTChatStreamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return apimodels.GPTChatResult{}, openAIBadResponse(fmt.Errorf("error unmarshalling gpt chat stream chunk: %w", err))
+		}
+		if chunk.Usage != nil {
+			usage = *chunk.Usage
+		}
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			continue
+		}
+
+		delta := chunk.Choices[0].Delta.Content
+		content.WriteString(delta)
+		if err := onDelta(delta); err != nil {
+			return apimodels.GPTChatResult{}, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return apimodels.GPTChatResult{}, llmCallErr(apimodels.LLMOpenAI, fmt.Errorf("error reading gpt chat stream: %w", err))
+	}
+
+	if content.Len() == 0 {
+		return apimodels.GPTChatResult{}, openAIBadResponse(errors.New("empty gpt chat stream"))
+	}
+	return p.newResult(chatReq, content.String(), usage), nil
+}
+
+// Asks OpenAI's moderation endpoint about the text. Flagged texts are blocked under each flagged category.
+func (p *openAIProvider) moderate(ctx context.Context, text string) (apimodels.ModerationResult, error) {
+	url := p.moderationURL
+	req := apimodels.GPTModerationRequest{Model: "omni-moderation-latest", Input: text}
+
+	// These are from the response we will get from the API, they can be mocked.
+	var status = http.StatusOK
+	var body []byte
+	var err error
+
+	body, mockMatch := p.mocks.getMockedBody(url)
+
+	// If there's no matching mock data, we make the actual API call.
+	if !mockMatch {
+		status, body, err = utils.POST(ctx, url, &req, p.moderationKey, p.httpClient)
+		logs.LogAPICall(url, status, body)
+		if err != nil {
+			return apimodels.ModerationResult{}, logs.LogUnexpected(llmCallErr(apimodels.LLMOpenAI, err))
+		}
+	}
+	if status != http.StatusOK {
+		return apimodels.ModerationResult{}, openAIErr(status, body)
+	}
+
+	var response apimodels.GPTModerationResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return apimodels.ModerationResult{}, openAIBadResponse(fmt.Errorf("error unmarshalling gpt moderation response: %w", err))
+	}
+
+	result := apimodels.ModerationResult{Action: apimodels.ModerationAllow, Text: text}
+	for _, moderation := range response.Results {
+		if !moderation.Flagged {
+			continue
+		}
+		result.Action = apimodels.ModerationBlock
+		for category, flagged := range moderation.Categories {
+			if flagged {
+				result.Matches = append(result.Matches, apimodels.ModerationMatch{Category: category, Rule: "openai_moderation", Action: apimodels.ModerationBlock})
+			}
+		}
+	}
+	slices.SortFunc(result.Matches, func(a, b apimodels.ModerationMatch) int { return strings.Compare(a.Category, b.Category) })
+
+	return result, nil
+}
+
+// Sends the texts to the embeddings endpoint, in batches so big documents don't go over its limits.
+func (p *openAIProvider) embed(ctx context.Context, texts []string, model apimodels.GPTs) (apimodels.EmbeddingsResult, error) {
+	const maxBatchSize = 256
+
+	url := p.baseURL + "/embeddings"
+	result := apimodels.EmbeddingsResult{Provider: apimodels.LLMOpenAI, Model: model, Vectors: make([][]float32, 0, len(texts))}
+
+	for batch := range slices.Chunk(texts, maxBatchSize) {
+		req := apimodels.GPTEmbeddingsRequest{Model: model, Input: batch}
+
+		// These are from the response we will get from the API, they can be mocked.
+		var status = http.StatusOK
+		var body []byte
+		var err error
+
+		body, mockMatch := p.mocks.getMockedBody(url)
+
+		// If there's no matching mock data, we make the actual API call.
+		if !mockMatch {
+			status, body, err = utils.POST(ctx, url, &req, p.key, p.httpClient)
+			logs.LogAPICall(url, status, nil) // The vectors are way too long to log.
+			if err != nil {
+				return apimodels.EmbeddingsResult{}, logs.LogUnexpected(llmCallErr(apimodels.LLMOpenAI, err))
+			}
+		}
+		if status != http.StatusOK {
+			return apimodels.EmbeddingsResult{}, openAIErr(status, body)
+		}
+
+		var response apimodels.GPTEmbeddingsResponse
+		if err := json.Unmarshal(body, &response); err != nil {
+			return apimodels.EmbeddingsResult{}, openAIBadResponse(fmt.Errorf("error unmarshalling gpt embeddings response: %w", err))
+		}
+		if len(response.Data) != len(batch) {
+			return apimodels.EmbeddingsResult{}, openAIBadResponse(fmt.Errorf("got %d embeddings for %d texts", len(response.Data), len(batch)))
+		}
+
+		slices.SortFunc(response.Data, func(a, b apimodels.GPTEmbeddingData) int { return a.Index - b.Index })
+		for _, data := range response.Data {
+			result.Vectors = append(result.Vectors, data.Embedding)
+		}
+		result.Usage = result.Usage.Add(response.Usage)
+	}
+
+	return result, nil
+}
+
+// DALL-E 3 makes the images, unless it can't make them that size.
+func (p *openAIProvider) generateImage(ctx context.Context, prompt string, size pbs.GPTImageSize) (apimodels.GPTImageMsg, error) {
+	model := apimodels.DALL_E3
+	if _, err := dallESize(model, size); err != nil {
+		model = apimodels.DALL_E2
+	}
+	pixels, err := dallESize(model, size)
+	if err != nil {
+		return apimodels.GPTImageMsg{}, err
+	}
+
+	url := p.baseURL + "/images/generations"
+	req := apimodels.GPTImageEndpointRequest{
+		Model:  model,
+		Prompt: prompt,
+		Size:   pixels,
+		N:      1,
+	}
+
+	// These are from the response we will get from the API, they can be mocked.
+	var status = http.StatusOK
+	var body []byte
+
+	body, mockMatch := p.mocks.getMockedBody(url)
+
+	// If there's no matching mock data, we make the actual API call.
+	if !mockMatch {
+		status, body, err = utils.POST(ctx, url, &req, p.key, p.httpClient)
+		logs.LogAPICall(url, status, body)
+		if err != nil {
+			return apimodels.GPTImageMsg{}, logs.LogUnexpected(llmCallErr(apimodels.LLMOpenAI, err))
+		}
+	}
+
+	return parseImageResponse(status, body, model, pixels)
+}
+
+// Only DALL-E 2 edits images. The transparent areas of the mask are the ones edited,
+// without a mask it's the image's own. Both must be square and the same size.
+func (p *openAIProvider) editImage(ctx context.Context, img, mask image.Image, prompt string, size pbs.GPTImageSize) (apimodels.GPTImageMsg, error) {
+	pixels, err := dallESize(apimodels.DALL_E2, size)
+	if err != nil {
+		return apimodels.GPTImageMsg{}, err
+	}
+
+	imageFile, err := dallEImageFile("image", img)
+	if err != nil {
+		return apimodels.GPTImageMsg{}, err
+	}
+	files := []utils.MultipartFile{imageFile}
+
+	if mask != nil {
+		if mask.Bounds().Size() != img.Bounds().Size() {
+			return apimodels.GPTImageMsg{}, dallEInvalidRequest(errors.New("the mask must be the same size as the image"))
+		}
+		maskFile, err := dallEImageFile("mask", mask)
+		if err != nil {
+			return apimodels.GPTImageMsg{}, err
+		}
+		files = append(files, maskFile)
+	}
+
+	fields := map[string]string{"model": string(apimodels.DALL_E2), "prompt": prompt, "size": pixels, "n": "1"}
+	return p.sendImageForm(ctx, "/images/edits", fields, files, pixels)
+}
+
+// Only DALL-E 2 makes variations. The image must be square.
+func (p *openAIProvider) makeImageVariation(ctx context.Context, img image.Image, size pbs.GPTImageSize) (apimodels.GPTImageMsg, error) {
+	pixels, err := dallESize(apimodels.DALL_E2, size)
+	if err != nil {
+		return apimodels.GPTImageMsg{}, err
+	}
+
+	imageFile, err := dallEImageFile("image", img)
+	if err != nil {
+		return apimodels.GPTImageMsg{}, err
+	}
+
+	fields := map[string]string{"model": string(apimodels.DALL_E2), "size": pixels, "n": "1"}
+	return p.sendImageForm(ctx, "/images/variations", fields, []utils.MultipartFile{imageFile}, pixels)
+}
+
+// Edits and variations upload their images, so they're sent as multipart forms instead of JSON.
+func (p *openAIProvider) sendImageForm(ctx context.Context, path string, fields map[string]string, files []utils.MultipartFile, pixels string) (apimodels.GPTImageMsg, error) {
+	url := p.baseURL + path
+
+	var status = http.StatusOK
+	var err error
+
+	body, mockMatch := p.mocks.getMockedBody(url)
+	if !mockMatch {
+		status, body, err = utils.POSTMultipart(ctx, url, fields, files, p.key, p.httpClient)
+		logs.LogAPICall(url, status, body)
+		if err != nil {
+			return apimodels.GPTImageMsg{}, logs.LogUnexpected(llmCallErr(apimodels.LLMOpenAI, err))
+		}
+	}
+
+	return parseImageResponse(status, body, apimodels.DALL_E2, pixels)
+}
+
+func parseImageResponse(status int, body []byte, model apimodels.GPTs, pixels string) (apimodels.GPTImageMsg, error) {
+	if status != http.StatusOK {
+		return apimodels.GPTImageMsg{}, openAIErr(status, body)
+	}
+
+	var response apimodels.GPTImageEndpointResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return apimodels.GPTImageMsg{}, openAIBadResponse(fmt.Errorf("error unmarshalling %s response: %w", model, err))
+	}
+	if len(response.Data) == 0 {
+
+		// Dall-E-2
+		if model == apimodels.DALL_E2 {
+			if len(response.ImageURLs) == 0 {
+				return apimodels.GPTImageMsg{}, openAIBadResponse(errors.New("no image URLs in dall-e 2 response"))
+			}
+			return apimodels.GPTImageMsg{URL: response.ImageURLs[0], RevisedPrompt: response.RevisedPrompt, Model: model, Size: pixels}, nil
+		}
+		return apimodels.GPTImageMsg{}, openAIBadResponse(errors.New("no data in dall-e 3 response"))
+	}
+
+	// Dall-E-3, or Dall-E-2 with the same format
+	return apimodels.GPTImageMsg{URL: response.Data[0].URL, RevisedPrompt: response.Data[0].RevisedPrompt, Model: model, Size: pixels}, nil
+}
+
+func (p *openAIProvider) DefaultModel() apimodels.GPTs {
+	return p.defaultModel
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+// The o1 models don't take system messages, so they get the system prompt as the first user message.
+// They also want max_completion_tokens instead of max_tokens.
+func (p *openAIProvider) newChatRequest(req apimodels.LLMChatRequest) apimodels.GPTChatEndpointRequest {
+	model := req.Model
+	if model == "" {
+		model = p.defaultModel
+	}
+
+	isO1 := strings.HasPrefix(string(model), "o1")
+
+	msgs := make([]apimodels.GPTChatMsg, 0, len(req.Messages)+1)
+	if req.System != "" {
+		systemRole := apimodels.LLMRoleSystem
+		if isO1 {
+			systemRole = apimodels.LLMRoleUser
+		}
+		msgs = append(msgs, apimodels.GPTChatMsg{Role: systemRole, Content: req.System})
+	}
+
+	chatReq := apimodels.GPTChatEndpointRequest{
+		Model:       model,
+		Messages:    append(msgs, req.Messages...),
+		Temperature: req.Temperature,
+		MaxTokens:   req.MaxTokens,
+	}
+	if isO1 {
+		chatReq.MaxTokens, chatReq.MaxCompletionTokens = nil, req.MaxTokens
+	}
+	for _, tool := range req.Tools {
+		toolDef := apimodels.GPTToolDef{Type: "function"}
+		toolDef.Function.Name = tool.Name
+		toolDef.Function.Description = tool.Description
+		toolDef.Function.Parameters = tool.Parameters
+		chatReq.Tools = append(chatReq.Tools, toolDef)
+	}
+	return chatReq
+}
+
+func (p *openAIProvider) newResult(req apimodels.GPTChatEndpointRequest, content string, usage apimodels.GPTChatUsage) apimodels.GPTChatResult {
+	return apimodels.GPTChatResult{Content: content, Provider: apimodels.LLMOpenAI, Model: req.Model, Usage: usage}
+}
+
+func openAIErr(status int, body []byte) error {
+	var response apimodels.GPTErrorResponse
+	msg := strings.TrimSpace(string(body))
+	if err := json.Unmarshal(body, &response); err == nil && response.Error.Message != "" {
+		msg = response.Error.Message
+	}
+	return &errs.LLMErr{Provider: string(apimodels.LLMOpenAI), Kind: errs.LLMErrKindFromStatus(status), Status: status, Err: errors.New(msg)}
+}
+
+func openAIBadResponse(err error) error {
+	return &errs.LLMErr{Provider: string(apimodels.LLMOpenAI), Kind: errs.LLMBadResponse, Status: http.StatusOK, Err: err}
+}
+
+// The call didn't get a response, cancelled calls are left as they are.
+func llmCallErr(provider apimodels.LLMProviderName, err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	return &errs.LLMErr{Provider: string(provider), Kind: errs.LLMUnavailable, Err: err}
+}
+
+// The sizes each DALL-E model can make, as imageSizeToActualPixels returns them.
+var dallESizes = map[apimodels.GPTs][]string{
+	apimodels.DALL_E3: {"1024x1024", "1792x1024", "1024x1792"},
+	apimodels.DALL_E2: {"1024x1024", "512x512", "256x256"},
+}
+
+// Returns the size in pixels if the model can make it.
+func dallESize(model apimodels.GPTs, size pbs.GPTImageSize) (string, error) {
+	pixels := imageSizeToActualPixels(size)
+	if !slices.Contains(dallESizes[model], pixels) {
+		return "", dallEInvalidRequest(fmt.Errorf("%s can't make %s images, only %s", model, pixels, strings.Join(dallESizes[model], ", ")))
+	}
+	return pixels, nil
+}
+
+// DALL-E takes square PNG images of less than 4 MB.
+const dallEMaxImageBytes = 4 << 20
+
+func dallEImageFile(field string, img image.Image) (utils.MultipartFile, error) {
+	if bounds := img.Bounds(); bounds.Dx() != bounds.Dy() {
+		return utils.MultipartFile{}, dallEInvalidRequest(fmt.Errorf("the %s must be square, it's %dx%d", field, bounds.Dx(), bounds.Dy()))
+	}
+
+	var content bytes.Buffer
+	if err := png.Encode(&content, img); err != nil {
+		return utils.MultipartFile{}, dallEInvalidRequest(fmt.Errorf("error encoding the %s as png: %w", field, err))
+	}
+	if content.Len() >= dallEMaxImageBytes {
+		return utils.MultipartFile{}, dallEInvalidRequest(fmt.Errorf("the %s must be less than 4 MB as png, it's %d bytes", field, content.Len()))
+	}
+
+	return utils.MultipartFile{Field: field, FileName: field + ".png", ContentType: "image/png", Content: content.Bytes()}, nil
+}
+
+func dallEInvalidRequest(err error) error {
+	return &errs.LLMErr{Provider: string(apimodels.LLMOpenAI), Kind: errs.LLMInvalidRequest, Status: http.StatusBadRequest, Err: err}
+}
+
+func imageSizeToActualPixels(size pbs.GPTImageSize) string {
+	switch size {
+	case pbs.GPTImageSize_DEFAULT:
+		return "1024x1024"
+	case pbs.GPTImageSize_WIDE:
+		return "1792x1024"
+	case pbs.GPTImageSize_TALL:
+		return "1024x1792"
+	case pbs.GPTImageSize_SMALL:
+		return "512x512"
+	case pbs.GPTImageSize_TINY:
+		return "256x256"
+	default:
+		logs.LogUnexpected(fmt.Errorf("invalid dall-eimage size: %v", size))
+		return "1024x1024"
+	}
+}
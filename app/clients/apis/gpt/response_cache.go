@@ -0,0 +1,167 @@
+package gpt
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"google.golang.org/grpc/metadata"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - Response Cache -         */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Keeps the answers to the chats we already sent, so sending the same one again doesn't hit the provider.
+// Only the configured routes use it, each one keeping its answers for its own TTL.
+//
+// Chats are the same if they have the same provider, model, parameters, tools and messages, ignoring only the
+// whitespace around the messages and their line endings. Callers can skip it with Cache-Control: no-cache, or
+// no-store to not even save the answer, either as an HTTP header or as gRPC metadata.
+type responseCache struct {
+	mu         sync.Mutex
+	routes     map[string]time.Duration
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List // Of *cachedResponse, the most recently used first.
+}
+
+type cachedResponse struct {
+	key       string
+	result    apimodels.GPTChatResult
+	expiresAt time.Time
+}
+
+func newResponseCache(cfg core.GPTCacheCfg) *responseCache {
+	return &responseCache{
+		routes:     cfg.Routes,
+		maxEntries: cfg.MaxEntries,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// Returns the cached answer to the request, if its route uses the cache and there's one.
+// Cached answers have Cached set and no usage, as they didn't use any tokens.
+//
+// If there's none, the returned store func saves the answer to be reused. It does nothing if the answer shouldn't be saved.
+func (c *responseCache) lookup(ctx context.Context, req apimodels.LLMChatRequest) (apimodels.GPTChatResult, bool, func(apimodels.GPTChatResult)) {
+	noStore := func(apimodels.GPTChatResult) {}
+
+	ttl, ok := c.routes[core.GetRouteFromCtx(ctx).Name]
+	if !ok || c.maxEntries <= 0 {
+		return apimodels.GPTChatResult{}, false, noStore
+	}
+
+	noCache, noStoreDirective := cacheDirectives(ctx)
+	if noStoreDirective {
+		return apimodels.GPTChatResult{}, false, noStore
+	}
+
+	key := cacheKey(req)
+	if !noCache {
+		if result, ok := c.get(key); ok {
+			result.Cached, result.Usage = true, apimodels.GPTChatUsage{}
+			return result, true, noStore
+		}
+	}
+
+	return apimodels.GPTChatResult{}, false, func(result apimodels.GPTChatResult) {
+		c.set(key, result, ttl)
+	}
+}
+
+func (c *responseCache) get(key string) (apimodels.GPTChatResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return apimodels.GPTChatResult{}, false
+	}
+
+	entry := elem.Value.(*cachedResponse)
+	if time.Now().After(entry.expiresAt) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		return apimodels.GPTChatResult{}, false
+	}
+
+	c.lru.MoveToFront(elem)
+	return entry.result, true
+}
+
+func (c *responseCache) set(key string, result apimodels.GPTChatResult, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.lru.Remove(elem)
+	}
+	c.entries[key] = c.lru.PushFront(&cachedResponse{key: key, result: result, expiresAt: time.Now().Add(ttl)})
+
+	for c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedResponse).key)
+	}
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+// The request should already be normalized by prepareChat.
+func cacheKey(req apimodels.LLMChatRequest) string {
+	type normalizedMsg struct {
+		Role       string
+		Content    string
+		ToolCalls  []apimodels.GPTToolCall
+		ToolCallID string
+	}
+
+	msgs := make([]normalizedMsg, 0, len(req.Messages))
+	for _, msg := range req.Messages {
+		msgs = append(msgs, normalizedMsg{msg.Role, normalizeForCache(msg.Content), msg.ToolCalls, msg.ToolCallID})
+	}
+
+	// Marshalling these can't fail.
+	keyJSON, _ := json.Marshal(struct {
+		Provider    apimodels.LLMProviderName
+		Model       apimodels.GPTs
+		System      string
+		Messages    []normalizedMsg
+		Temperature *float64
+		MaxTokens   *int
+		Tools       []apimodels.LLMTool
+	}{req.Provider, req.Model, normalizeForCache(req.System), msgs, req.Temperature, req.MaxTokens, req.Tools})
+
+	hash := sha256.Sum256(keyJSON)
+	return hex.EncodeToString(hash[:])
+}
+
+// Case and whitespace can change the answer, as in code or tables, so they're kept.
+func normalizeForCache(text string) string {
+	return strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+}
+
+// Cache-Control comes as cache-control over gRPC, and the HTTP Gateway forwards it as grpcgateway-cache-control.
+func cacheDirectives(ctx context.Context) (noCache, noStore bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range append(md.Get("cache-control"), md.Get("grpcgateway-cache-control")...) {
+		for _, directive := range strings.Split(value, ",") {
+			switch strings.ToLower(strings.TrimSpace(directive)) {
+			case "no-cache":
+				noCache = true
+			case "no-store":
+				noStore = true
+			}
+		}
+	}
+	return noCache, noStore
+}
@@ -0,0 +1,134 @@
+package weather
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/gilperopiola/god"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*           - Fake Weather -          */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Answers with the fixtures, without calling anyone. It's for tests and for working without an app ID.
+//
+// The weather is always the same, but it's moved to now and to the coordinates asked for, and named after
+// the closest of the places on the geocoding fixture.
+
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+type fakeWeather struct {
+	now func() time.Time
+}
+
+func newFakeWeather() *fakeWeather {
+	return &fakeWeather{now: time.Now}
+}
+
+func (fw *fakeWeather) current(_ god.Ctx, lat, lon float64) (*apimodels.GetWeatherResponse, error) {
+	var out apimodels.GetWeatherResponse
+	if err := readFixture("current_weather.json", &out); err != nil {
+		return nil, err
+	}
+
+	now := int(fw.now().Unix())
+	daysLater := (now - out.Dt) / 86400 * 86400
+	out.Dt = now
+	out.Sys.Sunrise += daysLater
+	out.Sys.Sunset += daysLater
+
+	out.Coord = apimodels.Coord{Lat: lat, Lon: lon}
+	if place, ok := closestPlace(lat, lon); ok {
+		out.Name, out.Sys.Country = place.Name, place.Country
+	}
+	return &out, nil
+}
+
+// The forecast starts on the next 3 hour slot, like OpenWeatherMap's.
+func (fw *fakeWeather) forecast(_ god.Ctx, lat, lon float64) (*apimodels.GetForecastResponse, error) {
+	var out apimodels.GetForecastResponse
+	if err := readFixture("forecast.json", &out); err != nil {
+		return nil, err
+	}
+	if len(out.List) == 0 {
+		return &out, nil
+	}
+
+	const slot = 3 * 60 * 60
+	firstSlot := (int(fw.now().Unix())/slot + 1) * slot
+	later := firstSlot - out.List[0].Dt
+	for i := range out.List {
+		out.List[i].Dt += later
+		out.List[i].DtTxt = time.Unix(int64(out.List[i].Dt), 0).UTC().Format(time.DateTime)
+	}
+	daysLater := later / 86400 * 86400
+	out.City.Sunrise += daysLater
+	out.City.Sunset += daysLater
+
+	out.City.Coord = apimodels.Coord{Lat: lat, Lon: lon}
+	if place, ok := closestPlace(lat, lon); ok {
+		out.City.Name, out.City.Country = place.Name, place.Country
+	}
+	return &out, nil
+}
+
+func (fw *fakeWeather) geocode(_ god.Ctx, name string, limit int) ([]apimodels.GeocodingResult, error) {
+	var places []apimodels.GeocodingResult
+	if err := readFixture("geocoding.json", &places); err != nil {
+		return nil, err
+	}
+
+	// Like on OpenWeatherMap, the name can be followed by a country code, as in "London,GB".
+	parts := strings.Split(name, ",")
+	city, country := strings.TrimSpace(parts[0]), ""
+	if len(parts) > 1 {
+		country = strings.TrimSpace(parts[len(parts)-1])
+	}
+
+	out := []apimodels.GeocodingResult{}
+	for _, place := range places {
+		if len(out) < limit && strings.EqualFold(place.Name, city) && (country == "" || strings.EqualFold(place.Country, country)) {
+			out = append(out, place)
+		}
+	}
+	return out, nil
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+// Each call gets its own copy, so they can be changed freely.
+func readFixture(name string, out any) error {
+	data, err := fixtures.ReadFile("fixtures/" + name)
+	if err != nil {
+		return fmt.Errorf("error reading weather fixture %s: %w", name, err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("error unmarshalling weather fixture %s: %w", name, err)
+	}
+	return nil
+}
+
+// Not the real distance, but close enough to tell which place is nearer.
+func closestPlace(lat, lon float64) (apimodels.GeocodingResult, bool) {
+	var places []apimodels.GeocodingResult
+	if err := readFixture("geocoding.json", &places); err != nil || len(places) == 0 {
+		return apimodels.GeocodingResult{}, false
+	}
+
+	closest, closestDistance := places[0], math.Inf(1)
+	for _, place := range places {
+		lonDiff := math.Abs(place.Lon - lon)
+		lonDiff = min(lonDiff, 360-lonDiff) * math.Cos(lat*math.Pi/180)
+		if distance := math.Hypot(place.Lat-lat, lonDiff); distance < closestDistance {
+			closest, closestDistance = place, distance
+		}
+	}
+	return closest, true
+}
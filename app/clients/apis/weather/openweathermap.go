@@ -0,0 +1,109 @@
+package weather
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gilperopiola/god"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - OpenWeatherMap -         */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Always asks for standard units, so the same answer works for any units we convert it to.
+type openWeatherMap struct {
+	httpClient *http.Client
+	baseURL    string
+	appID      string
+}
+
+func newOpenWeatherMap(httpClient *http.Client, cfg core.OpenWeatherMapAPICfg) *openWeatherMap {
+	return &openWeatherMap{httpClient: httpClient, baseURL: cfg.BaseURL, appID: cfg.AppID}
+}
+
+func (owm *openWeatherMap) current(ctx god.Ctx, lat, lon float64) (*apimodels.GetWeatherResponse, error) {
+	var out apimodels.GetWeatherResponse
+	if err := owm.get(ctx, "/data/2.5/weather", coordinatesQuery(lat, lon), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (owm *openWeatherMap) forecast(ctx god.Ctx, lat, lon float64) (*apimodels.GetForecastResponse, error) {
+	var out apimodels.GetForecastResponse
+	if err := owm.get(ctx, "/data/2.5/forecast", coordinatesQuery(lat, lon), &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (owm *openWeatherMap) geocode(ctx god.Ctx, name string, limit int) ([]apimodels.GeocodingResult, error) {
+	var out []apimodels.GeocodingResult
+	query := url.Values{"q": {name}, "limit": {strconv.Itoa(limit)}}
+	if err := owm.get(ctx, "/geo/1.0/direct", query, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+// The app ID goes on the query, but not on what we log.
+func (owm *openWeatherMap) get(ctx god.Ctx, path string, query url.Values, out any) error {
+	if owm.appID == "" {
+		return errors.New("there's no OpenWeatherMap app ID configured")
+	}
+
+	endpoint := owm.baseURL + path + "?" + query.Encode()
+	query.Set("appid", owm.appID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, owm.baseURL+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf("error preparing GET %s: %w", endpoint, withoutAppID(err, endpoint))
+	}
+
+	resp, err := owm.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending GET %s: %w", endpoint, withoutAppID(err, endpoint))
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading GET %s: %w", endpoint, err)
+	}
+	logs.LogAPICall(endpoint, resp.StatusCode, body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status code %d on GET %s: %s", resp.StatusCode, endpoint, body)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("error unmarshalling GET %s: %w", endpoint, err)
+	}
+	return nil
+}
+
+// Errors about the request have its whole URL, app ID included, and they end up on our logs and responses.
+func withoutAppID(err error, endpoint string) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = endpoint
+	}
+	return err
+}
+
+func coordinatesQuery(lat, lon float64) url.Values {
+	return url.Values{
+		"lat": {strconv.FormatFloat(lat, 'f', -1, 64)},
+		"lon": {strconv.FormatFloat(lon, 'f', -1, 64)},
+	}
+}
@@ -1,46 +1,88 @@
 package weather
 
 import (
-	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
+	"strings"
 
 	"github.com/gilperopiola/god"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
-	"github.com/gilperopiola/grpc-gateway-impl/app/core/utils"
 )
 
+/* ———————————————————————————————— — — — Weather API — — — ———————————————————————————————— */
+
 var _ core.WeatherAPI = &WeatherAPI{}
 
+const (
+	providerOpenWeatherMap = "openweathermap"
+	providerFake           = "fake"
+)
+
+// Gets the weather from OpenWeatherMap, or from our fixtures with the fake provider.
+//
+// Coordinates are rounded before anything else, so places close to each other share their answers
+// on the cache and the calls made for them.
 type WeatherAPI struct {
-	httpClient *http.Client
+	source   weatherSource
+	cache    *weatherCache
+	decimals int
 }
 
-func NewAPI(httpClient *http.Client) core.WeatherAPI {
-	return &WeatherAPI{httpClient}
+// What both OpenWeatherMap and the fake do. Coordinates come already rounded.
+type weatherSource interface {
+	current(ctx god.Ctx, lat, lon float64) (*apimodels.GetWeatherResponse, error)
+	forecast(ctx god.Ctx, lat, lon float64) (*apimodels.GetForecastResponse, error)
+	geocode(ctx god.Ctx, name string, limit int) ([]apimodels.GeocodingResult, error)
 }
 
-func (api *WeatherAPI) GetCurrentWeather(ctx god.Ctx, lat, lon float64) (*apimodels.GetWeatherResponse, error) {
+func NewAPI(httpClient *http.Client, cfg core.OpenWeatherMapAPICfg) core.WeatherAPI {
+	var source weatherSource
+	switch cfg.Provider {
+	case providerOpenWeatherMap, "":
+		source = newOpenWeatherMap(httpClient, cfg)
+	case providerFake:
+		source = newFakeWeather()
+	default:
+		logs.LogFatal(fmt.Errorf("invalid weather provider %q, it should be openweathermap or fake", cfg.Provider))
+	}
 
-	// Prepare URL.
-	url := fmt.Sprintf("https://api.weathermap.org/data/2.5/weather?lat=%.2f&lon=%.2f&appid=%s", lat, lon, "f4ecb7e7e30e9c1a3219d1236a63303a")
+	return &WeatherAPI{source: source, cache: newWeatherCache(cfg.CacheTTL, cfg.CacheMaxEntries), decimals: max(cfg.CacheDecimals, 0)}
+}
 
-	// Send request.
-	status, respBody, err := utils.GET(ctx, url, nil, "", api.httpClient)
-	if err != nil {
-		return nil, logs.LogUnexpected(err)
-	}
+/* -~-~-~- Endpoints -~-~-~- */
 
-	if status != http.StatusOK {
-		return nil, fmt.Errorf("status code %d on GET %s: %s", status, url, respBody)
-	}
+func (api *WeatherAPI) GetCurrentWeather(ctx god.Ctx, lat, lon float64) (*apimodels.GetWeatherResponse, error) {
+	lat, lon = api.round(lat), api.round(lon)
+	return cached(api.cache, fmt.Sprintf("current:%v,%v", lat, lon), func() (*apimodels.GetWeatherResponse, error) {
+		return api.source.current(ctx, lat, lon)
+	})
+}
 
-	var out apimodels.GetWeatherResponse
-	if err := json.Unmarshal(respBody, &out); err != nil {
-		return nil, fmt.Errorf("error unmarshalling GetWeatherResponse: %w", err)
-	}
+func (api *WeatherAPI) GetWeatherForecast(ctx god.Ctx, lat, lon float64) (*apimodels.GetForecastResponse, error) {
+	lat, lon = api.round(lat), api.round(lon)
+	return cached(api.cache, fmt.Sprintf("forecast:%v,%v", lat, lon), func() (*apimodels.GetForecastResponse, error) {
+		return api.source.forecast(ctx, lat, lon)
+	})
+}
+
+// Returns up to limit places with that name, the most relevant first. Names are matched ignoring their case.
+func (api *WeatherAPI) GeocodeCity(ctx god.Ctx, name string, limit int) ([]apimodels.GeocodingResult, error) {
+	name = strings.Join(strings.Fields(name), " ")
+	return cached(api.cache, fmt.Sprintf("geocode:%s:%d", strings.ToLower(name), limit), func() ([]apimodels.GeocodingResult, error) {
+		return api.source.geocode(ctx, name, limit)
+	})
+}
+
+// Makes a call that skips the cache, so it fails whenever the provider does. It's for the health checks.
+func (api *WeatherAPI) PingWeather(ctx god.Ctx) error {
+	_, err := api.source.geocode(ctx, "London", 1)
+	return err
+}
 
-	return &out, nil
+func (api *WeatherAPI) round(coordinate float64) float64 {
+	scale := math.Pow(10, float64(api.decimals))
+	return math.Round(coordinate*scale) / scale
 }
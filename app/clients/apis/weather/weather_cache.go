@@ -0,0 +1,88 @@
+package weather
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - Weather Cache -          */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Keeps each answer for the TTL. The weather doesn't change that fast, and OpenWeatherMap
+// only updates it every 10 minutes anyway. A TTL of 0 turns it off.
+//
+// It keeps up to maxEntries answers, dropping the least recently used ones past that,
+// as the keys come from what callers ask for.
+type weatherCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List // Of *cachedWeather, the most recently used first.
+}
+
+type cachedWeather struct {
+	key       string
+	value     any
+	expiresAt time.Time
+}
+
+func newWeatherCache(ttl time.Duration, maxEntries int) *weatherCache {
+	return &weatherCache{ttl: ttl, maxEntries: maxEntries, entries: map[string]*list.Element{}, lru: list.New()}
+}
+
+// Returns the cached answer for the key, or gets it with fetch and caches it. Errors aren't cached.
+func cached[T any](c *weatherCache, key string, fetch func() (T, error)) (T, error) {
+	if value, ok := c.get(key); ok {
+		return value.(T), nil
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+	c.set(key, value)
+	return value, nil
+}
+
+func (c *weatherCache) get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*cachedWeather)
+	if time.Now().After(entry.expiresAt) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	return entry.value, true
+}
+
+func (c *weatherCache) set(key string, value any) {
+	if c.ttl <= 0 || c.maxEntries <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.lru.Remove(elem)
+	}
+	c.entries[key] = c.lru.PushFront(&cachedWeather{key: key, value: value, expiresAt: time.Now().Add(c.ttl)})
+
+	for c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedWeather).key)
+	}
+}
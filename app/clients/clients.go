@@ -1,6 +1,8 @@
 package clients
 
 import (
+	"context"
+
 	"github.com/gilperopiola/grpc-gateway-impl/app/clients/apis"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
@@ -57,6 +59,11 @@ func (c *Clients) CloseDB() error {
 	return c.DB.CloseDB()
 }
 
+// PingDB checks the database can be reached
+func (c *Clients) PingDB(ctx context.Context) error {
+	return c.DB.PingDB(ctx)
+}
+
 // UserRepository returns the user repository
 func (c *Clients) UserRepository() core.UserRepository {
 	return c.Repositories.UserRepository
@@ -71,3 +78,23 @@ func (c *Clients) GroupRepository() core.GroupRepository {
 func (c *Clients) GPTChatRepository() core.GPTChatRepository {
 	return c.Repositories.GPTChatRepository
 }
+
+// UsageRepository returns the LLM usage repository
+func (c *Clients) UsageRepository() core.UsageRepository {
+	return c.Repositories.UsageRepository
+}
+
+// ModerationRepository returns the moderation events repository
+func (c *Clients) ModerationRepository() core.ModerationRepository {
+	return c.Repositories.ModerationRepository
+}
+
+// ImageJobRepository returns the image jobs repository
+func (c *Clients) ImageJobRepository() core.ImageJobRepository {
+	return c.Repositories.ImageJobRepository
+}
+
+// DocumentRepository returns the documents repository
+func (c *Clients) DocumentRepository() core.DocumentRepository {
+	return c.Repositories.DocumentRepository
+}
@@ -0,0 +1,82 @@
+package apimodels
+
+import "encoding/json"
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*   - Anthropic Messages API Models - */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+type (
+	AnthropicMessagesRequest struct {
+		Model       GPTs               `json:"model"`
+		System      string             `json:"system,omitempty"`
+		Messages    []AnthropicMessage `json:"messages"`
+		MaxTokens   int                `json:"max_tokens"`
+		Temperature *float64           `json:"temperature,omitempty"`
+		Stream      bool               `json:"stream,omitempty"`
+		Tools       []AnthropicTool    `json:"tools,omitempty"`
+	}
+
+	// Content is a string, or a list of AnthropicContentBlock when there are tool calls or results.
+	AnthropicMessage struct {
+		Role    string `json:"role"`
+		Content any    `json:"content"`
+	}
+
+	// Which fields are set depends on the Type: text, tool_use or tool_result.
+	AnthropicContentBlock struct {
+		Type      string          `json:"type"`
+		Text      string          `json:"text,omitempty"`
+		ID        string          `json:"id,omitempty"`
+		Name      string          `json:"name,omitempty"`
+		Input     json.RawMessage `json:"input,omitempty"`
+		ToolUseID string          `json:"tool_use_id,omitempty"`
+		Content   string          `json:"content,omitempty"`
+	}
+
+	AnthropicTool struct {
+		Name        string          `json:"name"`
+		Description string          `json:"description"`
+		InputSchema json.RawMessage `json:"input_schema"`
+	}
+
+	AnthropicMessagesResponse struct {
+		ID         string                  `json:"id"`
+		Model      GPTs                    `json:"model"`
+		Content    []AnthropicContentBlock `json:"content"`
+		StopReason string                  `json:"stop_reason"`
+		Usage      AnthropicUsage          `json:"usage"`
+	}
+
+	AnthropicUsage struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+	}
+
+	// When streaming, each Server-Sent Event has one of these. Which fields are set depends on its Type:
+	// message_start has the Message, content_block_delta the Delta, message_delta the final Usage and error the Error.
+	AnthropicStreamEvent struct {
+		Type    string                     `json:"type"`
+		Message *AnthropicMessagesResponse `json:"message"`
+		Delta   struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		} `json:"delta"`
+		Usage *AnthropicUsage `json:"usage"`
+		Error *AnthropicError `json:"error"`
+	}
+
+	AnthropicErrorResponse struct {
+		Type  string         `json:"type"`
+		Error AnthropicError `json:"error"`
+	}
+
+	AnthropicError struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	}
+)
+
+func (u AnthropicUsage) ToGPTChatUsage() GPTChatUsage {
+	return GPTChatUsage{InPrompt: u.InputTokens, InCompletion: u.OutputTokens, InTotal: u.InputTokens + u.OutputTokens}
+}
@@ -0,0 +1,45 @@
+package apimodels
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - Document Models -        */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// The document types we can read.
+const (
+	DocumentTypeText     = "text/plain"
+	DocumentTypeMarkdown = "text/markdown"
+	DocumentTypePDF      = "application/pdf"
+)
+
+// What we could read from a document, ContentType is one of the DocumentTypes.
+type DocumentText struct {
+	ContentType string
+	Pages       []DocumentPage
+}
+
+// The text we could get out of a document's page. Documents that aren't PDFs are a single page.
+type DocumentPage struct {
+	Number int // From 1.
+	Text   string
+}
+
+// A piece of a document's text, as cut by the DocumentReader. Chunks never span pages.
+type DocumentChunkText struct {
+	Index   int
+	Page    int
+	Content string
+}
+
+// A chunk's vector, as saved on the VectorIndex.
+type VectorEntry struct {
+	ChunkID    int
+	DocumentID int
+	Vector     []float32
+}
+
+// A chunk found on the VectorIndex, Score is its cosine similarity with what we searched for.
+type VectorMatch struct {
+	ChunkID    int
+	DocumentID int
+	Score      float32
+}
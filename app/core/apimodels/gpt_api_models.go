@@ -1,5 +1,7 @@
 package apimodels
 
+import "encoding/json"
+
 /* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
 /*      - OpenAI GPT API Models -      */
 /* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
@@ -12,15 +14,34 @@ const (
 	GPT_4O         GPTs = "gpt-4o"
 	GPT_4O_MINI    GPTs = "gpt-4o-mini"
 
+	CLAUDE_35_SONNET GPTs = "claude-3-5-sonnet-latest"
+	CLAUDE_35_HAIKU  GPTs = "claude-3-5-haiku-latest"
+
+	FAKE_LLM GPTs = "fake-echo"
+
 	DALL_E2 GPTs = "dall-e-2"
 	DALL_E3 GPTs = "dall-e-3"
+
+	TEXT_EMBEDDING_3_SMALL GPTs = "text-embedding-3-small"
+	TEXT_EMBEDDING_3_LARGE GPTs = "text-embedding-3-large"
+	FAKE_EMBEDDING         GPTs = "fake-embedding"
 )
 
 // Data Models for the Chat Completions API
 type (
 	GPTChatEndpointRequest struct {
-		Model    GPTs         `json:"model"`
-		Messages []GPTChatMsg `json:"messages"`
+		Model               GPTs                  `json:"model"`
+		Messages            []GPTChatMsg          `json:"messages"`
+		Temperature         *float64              `json:"temperature,omitempty"`
+		MaxTokens           *int                  `json:"max_tokens,omitempty"`
+		MaxCompletionTokens *int                  `json:"max_completion_tokens,omitempty"` // The o1 models only take this one.
+		Stream              bool                  `json:"stream,omitempty"`
+		StreamOptions       *GPTChatStreamOptions `json:"stream_options,omitempty"`
+		Tools               []GPTToolDef          `json:"tools,omitempty"`
+	}
+
+	GPTChatStreamOptions struct {
+		IncludeUsage bool `json:"include_usage"`
 	}
 
 	GPTChatEndpointResponse struct {
@@ -29,17 +50,75 @@ type (
 			Message      GPTChatMsg/*   */ `json:"message"`
 			FinishReason string/*       */ `json:"finish_reason"`
 		}/*                            	*/ `json:"choices"`
-		Usage struct {
-			InPrompt     int/*          */ `json:"prompt_tokens"`
-			InCompletion int/*          */ `json:"completion_tokens"`
-			InTotal      int/*          */ `json:"total_tokens"`
-		}/*                            	*/ `json:"usage"`
+		Usage   GPTChatUsage/*          */ `json:"usage"`
 		Created int64/*                 */ `json:"created"`
 	}
 
+	GPTChatUsage struct {
+		InPrompt          int `json:"prompt_tokens"`
+		InCompletion      int `json:"completion_tokens"`
+		InTotal           int `json:"total_tokens"`
+		CompletionDetails struct {
+			InReasoning int `json:"reasoning_tokens"` // Already counted on InCompletion.
+		} `json:"completion_tokens_details"`
+	}
+
+	// What SendRequestToGPT returns: the reply, plus what it cost.
+	GPTChatResult struct {
+		Content   string
+		ToolCalls []GPTToolCall // When set, the model wants these run before answering, and Content may be empty.
+		Provider  LLMProviderName
+		Model     GPTs
+		Usage     GPTChatUsage
+		Cached    bool // Reused from an earlier call, so it used no tokens.
+	}
+
+	// When streaming, the response is a series of Server-Sent Events with one of these each.
+	// Usage is only set on the last one.
+	GPTChatStreamChunk struct {
+		ID      string/*                */ `json:"id"`
+		Choices []struct {
+			Delta        GPTChatMsg/*   */ `json:"delta"`
+			FinishReason string/*       */ `json:"finish_reason"`
+		}/*                            	*/ `json:"choices"`
+		Usage *GPTChatUsage/*           */ `json:"usage"`
+	}
+
 	GPTChatMsg struct {
 		Role    string `json:"role"`
 		Content string `json:"content"`
+
+		// Assistant messages can ask for tools instead of answering, each result goes back on its own tool message.
+		ToolCalls  []GPTToolCall `json:"tool_calls,omitempty"`
+		ToolCallID string        `json:"tool_call_id,omitempty"`
+	}
+
+	GPTToolCall struct {
+		ID       string              `json:"id"`
+		Type     string              `json:"type"` // Always "function".
+		Function GPTToolCallFunction `json:"function"`
+	}
+
+	GPTToolCallFunction struct {
+		Name      string `json:"name"`
+		Arguments string `json:"arguments"` // A JSON object, as the model wrote it.
+	}
+
+	GPTToolDef struct {
+		Type     string `json:"type"` // Always "function".
+		Function struct {
+			Name        string          `json:"name"`
+			Description string          `json:"description"`
+			Parameters  json.RawMessage `json:"parameters"`
+		} `json:"function"`
+	}
+
+	// OpenAI-compatible servers return this on non 2xx responses.
+	GPTErrorResponse struct {
+		Error struct {
+			Message string `json:"message"`
+			Type    string `json:"type"`
+		} `json:"error"`
 	}
 )
 
@@ -64,5 +143,45 @@ type (
 	GPTImageMsg struct {
 		URL           string `json:"url"`
 		RevisedPrompt string `json:"revised_prompt"`
+
+		// Not part of the API's response, we fill them to know what the image cost.
+		Model GPTs   `json:"-"`
+		Size  string `json:"-"`
+	}
+)
+
+// Data Models for the Embeddings API
+type (
+	GPTEmbeddingsRequest struct {
+		Model GPTs     `json:"model"`
+		Input []string `json:"input"`
+	}
+
+	GPTEmbeddingsResponse struct {
+		Data  []GPTEmbeddingData `json:"data"`
+		Model string             `json:"model"`
+		Usage GPTChatUsage       `json:"usage"` // Only prompt_tokens and total_tokens.
+	}
+
+	GPTEmbeddingData struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	}
+
+	// Vectors are in the same order as the texts they were made from.
+	EmbeddingsResult struct {
+		Vectors  [][]float32
+		Provider LLMProviderName
+		Model    GPTs
+		Usage    GPTChatUsage
 	}
 )
+
+// Adds up the usage of several calls, like the ones made while running tools.
+func (u GPTChatUsage) Add(other GPTChatUsage) GPTChatUsage {
+	u.InPrompt += other.InPrompt
+	u.InCompletion += other.InCompletion
+	u.InTotal += other.InTotal
+	u.CompletionDetails.InReasoning += other.CompletionDetails.InReasoning
+	return u
+}
@@ -0,0 +1,37 @@
+package apimodels
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - LLM API Pricing -        */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Prices in USD, from OpenAI's and Anthropic's pricing pages. They're used to estimate what each call costs,
+// so update them when they do. Models that aren't here, like the ones on local servers, cost 0.
+var (
+	gptTokenPrices = map[GPTs]struct{ InputPerMillion, OutputPerMillion float64 }{
+		GPT_O1_PREVIEW: {15.00, 60.00},
+		GPT_O1_MINI:    {3.00, 12.00},
+		GPT_4O:         {2.50, 10.00},
+		GPT_4O_MINI:    {0.15, 0.60},
+
+		CLAUDE_35_SONNET: {3.00, 15.00},
+		CLAUDE_35_HAIKU:  {0.80, 4.00},
+
+		TEXT_EMBEDDING_3_SMALL: {0.02, 0},
+		TEXT_EMBEDDING_3_LARGE: {0.13, 0},
+	}
+
+	gptImagePrices = map[GPTs]map[string]float64{
+		DALL_E3: {"1024x1024": 0.040, "1792x1024": 0.080, "1024x1792": 0.080},
+		DALL_E2: {"1024x1024": 0.020, "512x512": 0.018, "256x256": 0.016},
+	}
+)
+
+// Reasoning tokens are billed as completion tokens, and they're already counted on InCompletion.
+func (u GPTChatUsage) CostUSD(model GPTs) float64 {
+	prices := gptTokenPrices[model]
+	return (float64(u.InPrompt)*prices.InputPerMillion + float64(u.InCompletion)*prices.OutputPerMillion) / 1_000_000
+}
+
+func (img GPTImageMsg) CostUSD() float64 {
+	return gptImagePrices[img.Model][img.Size]
+}
@@ -0,0 +1,49 @@
+package apimodels
+
+import "encoding/json"
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*        - LLM Provider Models -      */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+type LLMProviderName string
+
+const (
+	LLMOpenAI    LLMProviderName = "openai" // Also any OpenAI-compatible server, like llama.cpp or Ollama.
+	LLMAnthropic LLMProviderName = "anthropic"
+	LLMFake      LLMProviderName = "fake" // Deterministic and in-process, for tests and local development.
+)
+
+// Normalized roles. Each provider translates them to whatever it expects.
+const (
+	LLMRoleSystem    = "system"
+	LLMRoleUser      = "user"
+	LLMRoleAssistant = "assistant"
+	LLMRoleTool      = "tool" // A tool's result, answering one of the assistant's ToolCalls.
+)
+
+// LLMChatRequest is what we ask any provider for, regardless of which one it is.
+type LLMChatRequest struct {
+	Provider LLMProviderName // Empty means the configured default.
+	Model    GPTs            // Empty means the provider's default model.
+	System   string          // System prompt. Empty means our default instructions on new chats.
+	Messages []GPTChatMsg    // The whole conversation, the new prompt being the last one.
+
+	// Nil means the provider's default.
+	Temperature *float64
+	MaxTokens   *int
+
+	// Tools the model can ask us to run. See GPTChatResult.ToolCalls.
+	Tools []LLMTool
+}
+
+// A function the model can call. Parameters is the JSON schema of its arguments, which are always an object.
+type LLMTool struct {
+	Name        string
+	Description string
+	Parameters  json.RawMessage
+}
+
+// Our instructions for chats that don't use a system prompt template.
+const DefaultSystemPrompt = `You are a highly intelligent and useful AI, showing expertise and excellence in all fields. 
+Keep your answers concise and to the point, without repetition. At the beginning of each message, write Title: and a short title for it.`
@@ -0,0 +1,56 @@
+package apimodels
+
+import "strings"
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*       - LLM Models Registry -       */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// What we need to know about a model to fit a conversation in it.
+type LLMModelInfo struct {
+	ContextTokens   int     // Input and output together.
+	MaxOutputTokens int     // Reserved for the answer when the request doesn't set its MaxTokens.
+	CharsPerToken   float64 // Roughly, for the token counter.
+}
+
+var llmModels = map[GPTs]LLMModelInfo{
+	GPT_O1_PREVIEW: {128_000, 32_768, 4},
+	GPT_O1_MINI:    {128_000, 65_536, 4},
+	GPT_4O:         {128_000, 16_384, 4},
+	GPT_4O_MINI:    {128_000, 16_384, 4},
+
+	CLAUDE_35_SONNET: {200_000, 8_192, 3.5},
+	CLAUDE_35_HAIKU:  {200_000, 8_192, 3.5},
+
+	FAKE_LLM: {2_048, 256, 4}, // Small, so long chats can be tried out quickly.
+}
+
+// Models we don't know, like the ones on local servers, usually run with small contexts.
+var unknownLLMModel = LLMModelInfo{8_192, 2_048, 4}
+
+// Dated versions, like gpt-4o-2024-08-06, use what we have for their base model.
+func GetLLMModelInfo(model GPTs) LLMModelInfo {
+	if info, ok := llmModels[model]; ok {
+		return info
+	}
+
+	var bestMatch GPTs
+	for known := range llmModels {
+		if strings.HasPrefix(string(model), string(known)+"-") && len(known) > len(bestMatch) {
+			bestMatch = known
+		}
+	}
+	if bestMatch != "" {
+		return llmModels[bestMatch]
+	}
+	return unknownLLMModel
+}
+
+// How many tokens can be sent, leaving room for the answer.
+func (info LLMModelInfo) InputTokens(maxTokens *int) int {
+	reserved := info.MaxOutputTokens
+	if maxTokens != nil {
+		reserved = *maxTokens
+	}
+	return max(info.ContextTokens-reserved, 0)
+}
@@ -0,0 +1,72 @@
+package apimodels
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*         - Moderation Models -       */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+type ModerationAction string
+
+// From least to most strict. When several rules match, the strictest wins.
+const (
+	ModerationAllow  ModerationAction = "allow"
+	ModerationRedact ModerationAction = "redact" // The matches are replaced by [REDACTED_<CATEGORY>].
+	ModerationBlock  ModerationAction = "block"
+)
+
+func (a ModerationAction) IsStricterThan(other ModerationAction) bool {
+	strictness := map[ModerationAction]int{ModerationAllow: 0, ModerationRedact: 1, ModerationBlock: 2}
+	return strictness[a] > strictness[other]
+}
+
+// A rule set of our own, as written on the rules file. Keywords match whole words, ignoring case,
+// and patterns are Go regular expressions.
+type ModerationRuleSet struct {
+	Category string           `json:"category"`
+	Action   ModerationAction `json:"action"`
+	Keywords []string         `json:"keywords"`
+	Patterns []string         `json:"patterns"`
+}
+
+// What a moderation check decided about a text.
+type ModerationResult struct {
+	Action  ModerationAction
+	Text    string // With the redactions applied, or the text as it was.
+	Matches []ModerationMatch
+}
+
+type ModerationMatch struct {
+	Category string
+	Rule     string // The keyword, the pattern's name, or the provider.
+	Action   ModerationAction
+}
+
+// Categories of the matches with the result's action, without repeating them.
+func (r ModerationResult) Categories() []string {
+	var categories []string
+	seen := map[string]bool{}
+	for _, match := range r.Matches {
+		if match.Action == r.Action && !seen[match.Category] {
+			seen[match.Category] = true
+			categories = append(categories, match.Category)
+		}
+	}
+	return categories
+}
+
+/* -~-~-~- OpenAI Moderation Endpoint -~-~-~- */
+
+type (
+	GPTModerationRequest struct {
+		Model string `json:"model"`
+		Input string `json:"input"`
+	}
+
+	GPTModerationResponse struct {
+		ID      string `json:"id"`
+		Model   string `json:"model"`
+		Results []struct {
+			Flagged    bool            `json:"flagged"`
+			Categories map[string]bool `json:"categories"`
+		} `json:"results"`
+	}
+)
@@ -57,3 +57,47 @@ type (
 		Sunset  int    `json:"sunset"`
 	}
 )
+
+// The 5 day forecast, every 3 hours.
+type (
+	GetForecastResponse struct {
+		Cod  string          `json:"cod"`
+		Cnt  int             `json:"cnt"`
+		List []ForecastEntry `json:"list"`
+		City ForecastCity    `json:"city"`
+	}
+	ForecastEntry struct {
+		Dt         int          `json:"dt"`
+		Main       Main         `json:"main"`
+		Weather    []Weather    `json:"weather"`
+		Clouds     Clouds       `json:"clouds"`
+		Wind       Wind         `json:"wind"`
+		Visibility int          `json:"visibility"`
+		Pop        float64      `json:"pop"` // Probability of precipitation, from 0 to 1.
+		Rain       ForecastRain `json:"rain"`
+		DtTxt      string       `json:"dt_txt"`
+	}
+	ForecastRain struct {
+		ThreeH float64 `json:"3h"`
+	}
+	ForecastCity struct {
+		ID         int    `json:"id"`
+		Name       string `json:"name"`
+		Coord      Coord  `json:"coord"`
+		Country    string `json:"country"`
+		Population int    `json:"population"`
+		Timezone   int    `json:"timezone"`
+		Sunrise    int    `json:"sunrise"`
+		Sunset     int    `json:"sunset"`
+	}
+)
+
+// A place found by its name on the geocoding API.
+type GeocodingResult struct {
+	Name       string            `json:"name"`
+	LocalNames map[string]string `json:"local_names,omitempty"`
+	Lat        float64           `json:"lat"`
+	Lon        float64           `json:"lon"`
+	Country    string            `json:"country"`
+	State      string            `json:"state,omitempty"`
+}
@@ -12,6 +12,8 @@ import (
 )
 
 // 🔑 RouteAuthPublic can be accessed by anyone.
+// 🔑 RouteAuthUser can be accessed by any authenticated user, permissions over
+// each resource (like being a group's owner) are checked later by the Service.
 // 🔑 RouteAuthSelf can only be accessed by the user with the same ID as the one specified on the request URL.
 // The PB auto-generated requests for these routes MUST include a UserId int32 field, to do that on
 // the .proto request definition we just add
@@ -27,6 +29,11 @@ func AccessRoute(route Route, claims *JWTClaims, req any) error {
 		return nil
 	}
 
+	if authNeeded == RouteAuthUser {
+		// The token was already validated, so we have a user.
+		return nil
+	}
+
 	if authNeeded == RouteAuthSelf {
 		// Compare the UserID from the request URL with the one from the claims.
 		// They should match.
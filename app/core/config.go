@@ -6,6 +6,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"go.uber.org/zap"
@@ -28,14 +29,18 @@ func init() {
 // ⭐️ Our App holds a reference to one of this, which contains all the config values
 // to be passed from the App to the different services and tools.
 type Config struct {
-	APIsCfg      // —► API URLs, keys, etc
-	DBCfg        // —► DB Credentials and such
-	JWTCfg       // —► JWT Secret
-	TLSCfg       // —► TLS Certs paths
-	LoggerCfg    // —► Logger settings
-	PwdHasherCfg // —► Salt
-	RetrierCfg   // —► N° Retries
-	RLimiterCfg  // —► Rate settings
+	APIsCfg       // —► API URLs, keys, etc
+	DBCfg         // —► DB Credentials and such
+	JWTCfg        // —► JWT Secret
+	TLSCfg        // —► TLS Certs paths
+	LoggerCfg     // —► Logger settings
+	PwdHasherCfg  // —► Salt
+	RetrierCfg    // —► N° Retries
+	RLimiterCfg   // —► Rate settings
+	ModerationCfg // —► Prompt and answer filters
+	ImageJobsCfg  // —► Background image workers
+	DocumentsCfg  // —► Uploaded documents and their vector index
+	HealthCfg     // —► Background health checks
 }
 
 // As on the init func we load the .env file, in here we already
@@ -52,14 +57,18 @@ func LoadConfig() *Config {
 	}()
 
 	return &Config{
-		APIsCfg:      loadAPIsConfig(),
-		DBCfg:        loadDBConfig(),
-		JWTCfg:       loadJWTConfig(),
-		TLSCfg:       loadTLSConfig(),
-		LoggerCfg:    loadLoggerConfig(),
-		PwdHasherCfg: loadPwdHasherConfig(),
-		RetrierCfg:   loadRetrierConfig(),
-		RLimiterCfg:  loadRateLimiterConfig(),
+		APIsCfg:       loadAPIsConfig(),
+		DBCfg:         loadDBConfig(),
+		JWTCfg:        loadJWTConfig(),
+		TLSCfg:        loadTLSConfig(),
+		LoggerCfg:     loadLoggerConfig(),
+		PwdHasherCfg:  loadPwdHasherConfig(),
+		RetrierCfg:    loadRetrierConfig(),
+		RLimiterCfg:   loadRateLimiterConfig(),
+		ModerationCfg: loadModerationConfig(),
+		ImageJobsCfg:  loadImageJobsConfig(),
+		DocumentsCfg:  loadDocumentsConfig(),
+		HealthCfg:     loadHealthConfig(),
 	}
 }
 
@@ -101,19 +110,64 @@ func setGlobals() {
 
 type (
 	APIsCfg struct {
-		Weather OpenWeatherMapAPICfg
-		GPT     ChatGptAPICfg
+		Weather   OpenWeatherMapAPICfg
+		GPT       ChatGptAPICfg
+		Anthropic AnthropicAPICfg
+
+		// Default LLM provider for chats: openai, anthropic or fake. Requests can pick another one.
+		LLMProvider string
+
+		GPTCache   GPTCacheCfg
+		Fixtures   FixturesCfg
+		Embeddings EmbeddingsCfg
 
 		MockCalls bool
 		MockData  map[string]string
 	}
+	// Provider is openweathermap, or fake to answer from the fixtures on the weather package, like for tests.
+	// Answers are kept for CacheTTL, by their coordinates rounded to CacheDecimals decimals. 2 are about 1 km.
+	// Only the last CacheMaxEntries used are kept.
 	OpenWeatherMapAPICfg struct {
-		BaseURL string
-		AppID   string
+		Provider        string
+		BaseURL         string
+		AppID           string
+		CacheTTL        time.Duration
+		CacheDecimals   int
+		CacheMaxEntries int
 	}
+	// Works with any OpenAI-compatible server, like llama.cpp or Ollama, by changing its BaseURL.
+	// Those don't moderate, so moderation has its own base URL and key, which stay on OpenAI's.
 	ChatGptAPICfg struct {
-		BaseURL string
-		APIKey  string
+		BaseURL      string
+		APIKey       string
+		DefaultModel string
+
+		ModerationBaseURL string
+		ModerationAPIKey  string // If empty, APIKey is used.
+	}
+	// Answers to chats we already sent are reused for a while, but only on these routes.
+	GPTCacheCfg struct {
+		Routes     map[string]time.Duration // Route name -> how long its answers are kept.
+		MaxEntries int                      // When full, the least recently used answer is dropped.
+	}
+	// Outgoing API calls can be recorded to fixture files and then replayed from them, to work offline.
+	// Mode is off, record or replay. When replaying, calls without a fixture fail if Strict, or are really made if not.
+	FixturesCfg struct {
+		Mode   string
+		Dir    string
+		Strict bool
+	}
+	// Embeddings are made by openai, or in-process by fake, which is deterministic but only matches the same words.
+	EmbeddingsCfg struct {
+		Provider string
+		Model    string
+	}
+	AnthropicAPICfg struct {
+		BaseURL      string
+		APIKey       string
+		Version      string
+		DefaultModel string
+		MaxTokens    int // Anthropic requires it on every request.
 	}
 )
 
@@ -122,12 +176,41 @@ func loadAPIsConfig() APIsCfg {
 
 	return APIsCfg{
 		Weather: OpenWeatherMapAPICfg{
-			BaseURL: envVar("API_OPENWEATHERMAP_BASE_URL", "https://api.weathermap.org/data/2.5/weather"),
-			AppID:   envVar("API_OPENWEATHERMAP_APP_ID", ""),
+			Provider:        envVar("API_OPENWEATHERMAP_PROVIDER", "openweathermap"),
+			BaseURL:         envVar("API_OPENWEATHERMAP_BASE_URL", "https://api.openweathermap.org"),
+			AppID:           envVar("API_OPENWEATHERMAP_APP_ID", ""),
+			CacheTTL:        time.Duration(envVar("API_OPENWEATHERMAP_CACHE_TTL_SECONDS", 600)) * time.Second,
+			CacheDecimals:   envVar("API_OPENWEATHERMAP_CACHE_DECIMALS", 2),
+			CacheMaxEntries: envVar("API_OPENWEATHERMAP_CACHE_MAX_ENTRIES", 1000),
 		},
 		GPT: ChatGptAPICfg{
-			BaseURL: envVar("API_CHATGPT_BASE_URL", "https://api.openai.com/v1"),
-			APIKey:  envVar("API_CHATGPT_API_KEY", ""),
+			BaseURL:      envVar("API_CHATGPT_BASE_URL", "https://api.openai.com/v1"),
+			APIKey:       envVar("API_CHATGPT_API_KEY", ""),
+			DefaultModel: envVar("API_CHATGPT_MODEL", "o1-mini"),
+
+			ModerationBaseURL: envVar("API_CHATGPT_MODERATION_BASE_URL", "https://api.openai.com/v1"),
+			ModerationAPIKey:  envVar("API_CHATGPT_MODERATION_API_KEY", ""),
+		},
+		Anthropic: AnthropicAPICfg{
+			BaseURL:      envVar("API_ANTHROPIC_BASE_URL", "https://api.anthropic.com/v1"),
+			APIKey:       envVar("API_ANTHROPIC_API_KEY", ""),
+			Version:      envVar("API_ANTHROPIC_VERSION", "2023-06-01"),
+			DefaultModel: envVar("API_ANTHROPIC_MODEL", "claude-3-5-sonnet-latest"),
+			MaxTokens:    envVar("API_ANTHROPIC_MAX_TOKENS", 4096),
+		},
+		LLMProvider: envVar("LLM_PROVIDER", "openai"),
+		GPTCache: GPTCacheCfg{
+			Routes:     parseCacheRoutes(envVar("API_GPT_CACHE_ROUTES", "")),
+			MaxEntries: envVar("API_GPT_CACHE_MAX_ENTRIES", 500),
+		},
+		Fixtures: FixturesCfg{
+			Mode:   envVar("API_FIXTURES_MODE", "off"),
+			Dir:    envVar("API_FIXTURES_DIR", "./etc/fixtures"),
+			Strict: envVar("API_FIXTURES_STRICT", false),
+		},
+		Embeddings: EmbeddingsCfg{
+			Provider: envVar("API_EMBEDDINGS_PROVIDER", "openai"),
+			Model:    envVar("API_EMBEDDINGS_MODEL", "text-embedding-3-small"),
 		},
 		MockCalls: mockAPICalls,
 		MockData: func() map[string]string {
@@ -136,8 +219,9 @@ func loadAPIsConfig() APIsCfg {
 			}
 
 			// Keys must match a substring of the actual API URL to trigger the mock response.
+			// They're only the paths, so they also match when the base URLs are changed.
 			mockData := make(map[string]string)
-			mockData["https://api.openai.com/v1/chat/completions"] = `
+			mockData["/chat/completions"] = `
 {
     "id": "chatcmpl-...",
     "object": "chat.completion",
@@ -157,7 +241,18 @@ func loadAPIsConfig() APIsCfg {
     "system_fingerprint": "fp_0..."
 }`
 
-			mockData["https://api.openai.com/v1/images/generations"] = `
+			mockData["/messages"] = `
+{
+    "id": "msg_...",
+    "type": "message",
+    "role": "assistant",
+    "model": "claude-3-5-sonnet-20241022",
+    "content": [{"type": "text", "text": "Mock response gotten"}],
+    "stop_reason": "end_turn",
+    "usage": {"input_tokens": 31, "output_tokens": 604}
+}`
+
+			mockData["/images/generations"] = `
 {
     "created": 175033,
     "data": [
@@ -166,6 +261,23 @@ func loadAPIsConfig() APIsCfg {
             "url": "https://oaidalleapiprodscus.blob.core.windows.net/private/org-QSC7lVI62AyfPgP7NyIh4OTS/user-T2w0qXeRmFDqwUgIrWWRSiZv/img-VeoC7pt5ZGHUgVQsCxg3daD8.png?st=2025-06-25T00%3A27%3A50Z&se=2025-06-25T02%3A27%3A50Z&sp=r&sv=2024-08-04&sr=b&rscd=inline&rsct=image/png&skoid=cc612491-d948-4d2e-9821-2683df3719f5&sktid=a48cca56-e6da-484e-a814-9c849652bcb3&skt=2025-06-24T18%3A52%3A08Z&ske=2025-06-25T18%3A52%3A08Z&sks=b&skv=2024-08-04&sig=9X6F7IJgLO%2BghFPa/0kZ4FiE55y0j0/mXTNU%2BHxUbak%3D"
         }
     ]
+}`
+			// Edits and variations are made by DALL-E 2, which answers the same way.
+			mockData["/images/edits"] = `
+{
+    "created": 175033,
+    "data": [{"url": "https://oaidalleapiprodscus.blob.core.windows.net/private/org-QSC7lVI62AyfPgP7NyIh4OTS/img-edit.png"}]
+}`
+			mockData["/images/variations"] = `
+{
+    "created": 175033,
+    "data": [{"url": "https://oaidalleapiprodscus.blob.core.windows.net/private/org-QSC7lVI62AyfPgP7NyIh4OTS/img-variation.png"}]
+}`
+			mockData["/moderations"] = `
+{
+    "id": "modr-...",
+    "model": "omni-moderation-latest",
+    "results": [{"flagged": false, "categories": {"harassment": false, "violence": false}}]
 }`
 			return mockData
 		}(),
@@ -187,6 +299,7 @@ type DBCfg struct {
 	InsertAdmin    bool
 	InsertAdminPwd string // hashed with our PwdHasher
 	LogLevel       int
+	SQLiteFile     string // If set, the DB is SQLite on this file instead, like for tests. It can have params, as a DSN.
 }
 
 func (c *DBCfg) IsPostgres() bool {
@@ -207,6 +320,7 @@ func loadDBConfig() DBCfg {
 		InsertAdmin:    envVar("DB_INSERT_ADMIN", true),
 		InsertAdminPwd: envVar("DB_INSERT_ADMIN_PWD", ""),
 		LogLevel:       int(DBLogLevels[envVar("DB_LOG_LEVEL", "error")]),
+		SQLiteFile:     envVar("DB_SQLITE_FILE", ""),
 	}
 }
 
@@ -289,6 +403,121 @@ func loadRateLimiterConfig() RLimiterCfg {
 	}
 }
 
+// Routes are written as Name=seconds, separated by commas. Invalid ones are skipped with a warning.
+func parseCacheRoutes(routes string) map[string]time.Duration {
+	ttls := make(map[string]time.Duration)
+	for _, route := range strings.Split(routes, ",") {
+		if route = strings.TrimSpace(route); route == "" {
+			continue
+		}
+		name, seconds, _ := strings.Cut(route, "=")
+		ttl, err := strconv.Atoi(strings.TrimSpace(seconds))
+		if err != nil || ttl <= 0 {
+			log.Printf("🚨 WARNING: Invalid GPT cache route %s, it should be Name=seconds", route)
+			continue
+		}
+		ttls[strings.TrimSpace(name)] = time.Duration(ttl) * time.Second
+	}
+	return ttls
+}
+
+/* -~-~-~-~ Moderation Config ~-~-~-~- */
+
+// Prompts and answers go through our own rules and, optionally, through OpenAI's moderation endpoint.
+type ModerationCfg struct {
+	Enabled        bool
+	RulesFile      string   // JSON file with more rule sets, optional. See apimodels.ModerationRuleSet.
+	BlockedWords   []string // Blocked under the blocked_words category.
+	PIIAction      string   // What to do with emails, card numbers and tokens: redact, block or allow.
+	UseProviderAPI bool     // Also ask OpenAI's moderation endpoint, which is slower.
+}
+
+func loadModerationConfig() ModerationCfg {
+	var blockedWords []string
+	for _, word := range strings.Split(envVar("MODERATION_BLOCKED_WORDS", ""), ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			blockedWords = append(blockedWords, word)
+		}
+	}
+
+	return ModerationCfg{
+		Enabled:        envVar("MODERATION_ENABLED", true),
+		RulesFile:      envVar("MODERATION_RULES_FILE", ""),
+		BlockedWords:   blockedWords,
+		PIIAction:      envVar("MODERATION_PII_ACTION", "redact"),
+		UseProviderAPI: envVar("MODERATION_USE_PROVIDER_API", false),
+	}
+}
+
+/* -~-~-~-~ Image Jobs Config ~-~-~-~- */
+
+// Async images are made by a pool of workers, which is also how many we ask DALL-E for at the same time.
+// Failed calls are retried after RetryDelay, doubling it on each attempt.
+type ImageJobsCfg struct {
+	Workers      int
+	MaxAttempts  int
+	RetryDelay   time.Duration
+	PollInterval time.Duration // How often idle workers look for new jobs.
+
+	BatchInterval time.Duration // Between each of a user's batch images, so they don't go over DALL-E's rate limits.
+	MaxBatchSize  int
+}
+
+func loadImageJobsConfig() ImageJobsCfg {
+	return ImageJobsCfg{
+		Workers:      envVar("IMAGE_JOBS_WORKERS", 2),
+		MaxAttempts:  envVar("IMAGE_JOBS_MAX_ATTEMPTS", 4),
+		RetryDelay:   time.Duration(envVar("IMAGE_JOBS_RETRY_DELAY_SECONDS", 15)) * time.Second,
+		PollInterval: time.Duration(envVar("IMAGE_JOBS_POLL_INTERVAL_MS", 1000)) * time.Millisecond,
+
+		BatchInterval: time.Duration(envVar("IMAGE_BATCH_INTERVAL_SECONDS", 12)) * time.Second,
+		MaxBatchSize:  envVar("IMAGE_BATCH_MAX_SIZE", 50),
+	}
+}
+
+/* -~-~-~-~ Documents Config ~-~-~-~- */
+
+// Uploaded documents are split in chunks of about ChunkSize characters, each one repeating
+// the last ChunkOverlap characters of the one before, so no sentence is only seen cut in half.
+// Their embeddings are kept on one file per collection, under IndexDir.
+//
+// PDFs are compressed, and a small one can expand to gigabytes. Reading one fails once its streams
+// have expanded to more than MaxDecodedSize bytes, all of them together.
+type DocumentsCfg struct {
+	IndexDir       string
+	ChunkSize      int
+	ChunkOverlap   int
+	MaxDecodedSize int
+}
+
+func loadDocumentsConfig() DocumentsCfg {
+	return DocumentsCfg{
+		IndexDir:     envVar("DOCUMENTS_INDEX_DIR", "./etc/data/vector_index"),
+		ChunkSize:    envVar("DOCUMENTS_CHUNK_SIZE", 1200),
+		ChunkOverlap: envVar("DOCUMENTS_CHUNK_OVERLAP", 200),
+
+		MaxDecodedSize: envVar("DOCUMENTS_MAX_DECODED_MB", 100) << 20,
+	}
+}
+
+/* -~-~-~-~ Health Config ~-~-~-~- */
+
+// Our dependencies are checked every CheckInterval in the background, and the probes answer with the last results.
+// Each check can take up to CheckTimeout. The upstream APIs are only checked with CheckUpstreams, as each check is a call.
+type HealthCfg struct {
+	CheckInterval  time.Duration
+	CheckTimeout   time.Duration
+	CheckUpstreams bool
+}
+
+func loadHealthConfig() HealthCfg {
+	return HealthCfg{
+		CheckInterval:  time.Duration(envVar("HEALTH_CHECK_INTERVAL_SECONDS", 15)) * time.Second,
+		CheckTimeout:   time.Duration(envVar("HEALTH_CHECK_TIMEOUT_SECONDS", 3)) * time.Second,
+		CheckUpstreams: envVar("HEALTH_CHECK_UPSTREAMS", false),
+	}
+}
+
 /* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
 
 func envVar[T string | bool | int](key string, fallback T) T {
@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"time"
 
 	"github.com/gilperopiola/god"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
@@ -21,16 +22,24 @@ type DBOperations interface {
 	SaveError(value any) error
 	DeleteError(value any, where ...any) error
 	CountError(value *int64) error
+	Exec(sql string, values ...any) (rowsAffected int64, err error)
 
 	WithContext(ctx context.Context) DBOperations
 	Transaction(fn func(tx DBOperations) error) error
 	CloseDB() error
+	PingDB(ctx context.Context) error
 
 	Count(value *int64) error
 	Model(value any) DBOperations
+	Where(query any, args ...any) DBOperations
+	Order(value any) DBOperations
+	Omit(columns ...string) DBOperations
 	Offset(value int) DBOperations
 	Limit(value int) DBOperations
 	Preload(query string, args ...any) DBOperations
+	Select(query any, args ...any) DBOperations
+	Group(name string) DBOperations
+	ScanError(dest any) error
 }
 
 // UserRepository handles user-related database operations
@@ -39,6 +48,7 @@ type UserRepository interface {
 	GetUserByID(ctx god.Ctx, id int) (*models.User, error)
 	GetUserByUsername(ctx god.Ctx, username string) (*models.User, error)
 	GetUsers(ctx god.Ctx, page, pageSize int) ([]*models.User, int, error)
+	SearchUsers(ctx god.Ctx, query string, limit int) ([]*models.User, error)
 }
 
 // GroupRepository handles group-related database operations
@@ -46,11 +56,136 @@ type GroupRepository interface {
 	CreateGroup(ctx god.Ctx, name string, ownerID int, invitedUserIDs []int) (*models.Group, error)
 	GetGroupByID(ctx god.Ctx, id int) (*models.Group, error)
 	GetGroupsByUserID(ctx god.Ctx, userID int) ([]*models.Group, error)
+
+	UpdateGroup(ctx god.Ctx, group *models.Group) error
+
+	AddGroupMember(ctx god.Ctx, groupID, userID int, role models.GroupRole) error
+	GetGroupMember(ctx god.Ctx, groupID, userID int) (*models.UsersInGroup, error)
+	IsGroupMember(ctx god.Ctx, groupID, userID int) (bool, error)
+	UpdateGroupMember(ctx god.Ctx, member *models.UsersInGroup) error
+	RemoveGroupMember(ctx god.Ctx, groupID, userID int) error
+
+	CreateGroupInvites(ctx god.Ctx, invites []*models.GroupInvite) error
+	GetLastGroupInvite(ctx god.Ctx, groupID, userID int) (*models.GroupInvite, error)
+	GetPendingGroupInvitesByUserID(ctx god.Ctx, userID int) ([]*models.GroupInvite, error)
+	UpdateGroupInvite(ctx god.Ctx, invite *models.GroupInvite) error
+
+	CreateGroupInviteLink(ctx god.Ctx, link *models.GroupInviteLink) error
+	GetGroupInviteLink(ctx god.Ctx, groupID, linkID int) (*models.GroupInviteLink, error)
+	GetGroupInviteLinkByTokenHash(ctx god.Ctx, tokenHash string) (*models.GroupInviteLink, error)
+	GetGroupInviteLinks(ctx god.Ctx, groupID int, onlyActive bool) ([]*models.GroupInviteLink, error)
+	UpdateGroupInviteLink(ctx god.Ctx, link *models.GroupInviteLink) error
+	UseGroupInviteLink(ctx god.Ctx, linkID, userID int) (bool, error)
+
+	CreateGroupActivity(ctx god.Ctx, activity *models.GroupActivity) error
+	GetGroupActivities(ctx god.Ctx, groupID, beforeID int, types []models.GroupActivityType, limit int) ([]*models.GroupActivity, error)
+
+	GetUsageBudgets(ctx god.Ctx, groupID int) ([]*models.UsageBudget, error)
+	GetUsageBudgetsForUser(ctx god.Ctx, groupID, userID int, unit models.BudgetUnit) ([]*models.UsageBudget, error)
+	GetMemberUsageBudgets(ctx god.Ctx, userID int, unit models.BudgetUnit) ([]*models.UsageBudget, error)
+	GetUsageBudget(ctx god.Ctx, groupID, budgetID int) (*models.UsageBudget, error)
+	SaveUsageBudget(ctx god.Ctx, budget *models.UsageBudget) error
+	DeleteUsageBudget(ctx god.Ctx, budget *models.UsageBudget) error
+	ConsumeUsageBudgets(ctx god.Ctx, budgetIDs []int, amount int64, period string) error
+
+	// InTransaction runs fn with a GroupRepository bound to a single DB transaction.
+	InTransaction(ctx god.Ctx, fn func(txRepo GroupRepository) error) error
 }
 
 // GPTChatRepository handles GPT chat-related database operations
 type GPTChatRepository interface {
 	GetChatByID(ctx god.Ctx, id int) (*models.GPTChat, error)
-	CreateChat(ctx god.Ctx, title string) (*models.GPTChat, error)
+	GetChatsByGroupID(ctx god.Ctx, groupID, page, pageSize int) ([]*models.GPTChat, int, error)
+	GetChatsByOwnerID(ctx god.Ctx, ownerID, page, pageSize int) ([]*models.GPTChat, int, error)
+	GetFullChatsByOwnerID(ctx god.Ctx, ownerID int) ([]*models.GPTChat, error)
+	CreateChat(ctx god.Ctx, chat *models.GPTChat) (*models.GPTChat, error)
+	UpdateChat(ctx god.Ctx, chat *models.GPTChat) error
+	ShareChatWithGroup(ctx god.Ctx, chat *models.GPTChat, activity *models.GroupActivity) error
 	CreateMessage(ctx god.Ctx, message *models.GPTMessage) (*models.GPTMessage, error)
+	UpdateMessage(ctx god.Ctx, message *models.GPTMessage) error
+	DeleteHeadMessage(ctx god.Ctx, message *models.GPTMessage) error
+	SetChatHead(ctx god.Ctx, chatID, messageID int) error
+
+	CreateMedia(ctx god.Ctx, media *models.GPTMedia) error
+	UpdateMedia(ctx god.Ctx, media *models.GPTMedia) error
+	GetMediaByID(ctx god.Ctx, id int) (*models.GPTMedia, error)
+
+	CreateSystemPrompt(ctx god.Ctx, prompt *models.SystemPrompt) error
+	GetSystemPromptByID(ctx god.Ctx, id int) (*models.SystemPrompt, error)
+	GetSystemPrompts(ctx god.Ctx, name string) ([]*models.SystemPrompt, error)
+}
+
+// UsageRepository handles the LLM usage records and their reports
+type UsageRepository interface {
+	CreateUsage(ctx god.Ctx, usage *models.LLMUsage) error
+	GetUsageReport(ctx god.Ctx, filter UsageReportFilter) ([]*UsageReportRow, error)
+}
+
+// ModerationRepository handles the moderation decisions kept for review
+type ModerationRepository interface {
+	CreateModerationEvent(ctx god.Ctx, event *models.ModerationEvent) error
+	GetModerationEvents(ctx god.Ctx, filter ModerationEventFilter, page, pageSize int) ([]*models.ModerationEvent, int, error)
+}
+
+// ImageJobRepository handles the queue of images made in the background
+type ImageJobRepository interface {
+	CreateImageJob(ctx god.Ctx, job *models.ImageJob) error
+	GetImageJob(ctx god.Ctx, operationID string) (*models.ImageJob, error)
+	ClaimImageJob(ctx god.Ctx) (*models.ImageJob, error)
+	UpdateRunningImageJob(ctx god.Ctx, job *models.ImageJob) (bool, error)
+	CancelImageJob(ctx god.Ctx, operationID string) (bool, error)
+	RequeueRunningImageJobs(ctx god.Ctx) (int, error)
+	LastScheduledImageJobAt(ctx god.Ctx, userID int) (*time.Time, error)
+
+	CreateImageBatch(ctx god.Ctx, batch *models.ImageBatch, jobs []*models.ImageJob) error
+	GetImageBatch(ctx god.Ctx, batchID int) (*models.ImageBatch, error)
+	GetImageBatchJobs(ctx god.Ctx, batchID int) ([]*models.ImageJob, error)
+	CancelImageBatch(ctx god.Ctx, batchID int) (int, error)
+}
+
+// DocumentRepository handles the uploaded documents, their collections and their chunks
+type DocumentRepository interface {
+	CreateDocumentCollection(ctx god.Ctx, collection *models.DocumentCollection) error
+	GetDocumentCollection(ctx god.Ctx, collectionID int) (*models.DocumentCollection, error)
+	GetDocumentCollectionsByOwnerID(ctx god.Ctx, ownerID int) ([]*models.DocumentCollection, error)
+	DeleteDocumentCollection(ctx god.Ctx, collectionID int) error
+
+	CreateDocument(ctx god.Ctx, document *models.Document, chunks []*models.DocumentChunk) error
+	GetDocument(ctx god.Ctx, documentID int) (*models.Document, error)
+	GetDocumentsByCollectionID(ctx god.Ctx, collectionID int) ([]*models.Document, error)
+	DeleteDocument(ctx god.Ctx, documentID int) error
+	GetDocumentChunksByIDs(ctx god.Ctx, chunkIDs []int) ([]*models.DocumentChunk, error)
+}
+
+// ModerationEventFilter narrows the moderation events. Zero values mean all of them.
+type ModerationEventFilter struct {
+	UserID int
+	Action string
+	Stage  string
+}
+
+// UsageReportFilter narrows a usage report. From is inclusive and To exclusive,
+// a zero UserID or an empty Model means all of them.
+type UsageReportFilter struct {
+	From   time.Time
+	To     time.Time
+	UserID int
+	Model  string
+}
+
+// UsageReportRow holds the usage of a user with a model on a single day.
+// It's not a model, it's scanned from an aggregation over models.LLMUsage.
+type UsageReportRow struct {
+	UserID           int
+	Model            string
+	Day              time.Time
+	Calls            int64
+	PromptTokens     int64
+	CompletionTokens int64
+	ReasoningTokens  int64
+	TotalTokens      int64
+	Images           int64
+	CostUSD          float64
+	AvgLatencyMs     float64
+	CachedCalls      int64
 }
@@ -37,6 +37,33 @@ const (
 	GroupNotFound          = "Group not found: %v"
 	FailedToFetchGroups    = "Failed to fetch groups: %v"
 	FailedToAddUserToGroup = "Failed to add user to group: %v"
+	FailedToUpdateGroup    = "Failed to update group: %v"
+	GroupMemberNotFound    = "Group member not found: %v"
+	FailedToUpdateMember   = "Failed to update group member: %v"
+	FailedToRemoveMember   = "Failed to remove group member: %v"
+
+	// Group invite repository errors
+	FailedToCreateGroupInvite = "Failed to create group invite: %v"
+	GroupInviteNotFound       = "Group invite not found: %v"
+	FailedToFetchGroupInvites = "Failed to fetch group invites: %v"
+	FailedToUpdateGroupInvite = "Failed to update group invite: %v"
+
+	// Group invite link repository errors
+	FailedToCreateInviteLink = "Failed to create group invite link: %v"
+	InviteLinkNotFound       = "Group invite link not found: %v"
+	FailedToFetchInviteLinks = "Failed to fetch group invite links: %v"
+	FailedToUpdateInviteLink = "Failed to update group invite link: %v"
+
+	// Group activity repository errors
+	FailedToCreateGroupActivity = "Failed to create group activity: %v"
+	FailedToFetchGroupActivity  = "Failed to fetch group activity: %v"
+
+	// Group usage budget repository errors
+	UsageBudgetNotFound     = "Usage budget not found: %v"
+	FailedToFetchBudgets    = "Failed to fetch usage budgets: %v"
+	FailedToSaveUsageBudget = "Failed to save usage budget: %v"
+	FailedToDeleteBudget    = "Failed to delete usage budget: %v"
+	FailedToConsumeBudgets  = "Failed to consume usage budgets: %v"
 
 	// User repository errors
 	FailedToCreateUser = "Failed to create user: %v"
@@ -47,6 +74,43 @@ const (
 	FailedToCreateChat    = "Failed to create chat: %v"
 	ChatNotFound          = "Chat not found: %v"
 	FailedToCreateMessage = "Failed to create message: %v"
+	FailedToUpdateChat    = "Failed to update chat: %v"
+	FailedToUpdateMessage = "Failed to update message: %v"
+	FailedToDeleteMessage = "Failed to delete message: %v"
+	FailedToFetchChats    = "Failed to fetch chats: %v"
+	FailedToCreateMedia   = "Failed to create media: %v"
+	FailedToUpdateMedia   = "Failed to update media: %v"
+	MediaNotFound         = "Media not found: %v"
+
+	// System prompt repository errors
+	FailedToCreateSystemPrompt = "Failed to create system prompt: %v"
+	SystemPromptNotFound       = "System prompt not found: %v"
+	FailedToFetchSystemPrompts = "Failed to fetch system prompts: %v"
+
+	// Usage repository errors
+	FailedToCreateUsage      = "Failed to create usage record: %v"
+	FailedToFetchUsageReport = "Failed to fetch usage report: %v"
+
+	// Moderation repository errors
+	FailedToCreateModerationEvent = "Failed to create moderation event: %v"
+	FailedToFetchModerationEvents = "Failed to fetch moderation events: %v"
+
+	// Image job repository errors
+	FailedToCreateImageJob   = "Failed to create image job: %v"
+	FailedToUpdateImageJob   = "Failed to update image job: %v"
+	FailedToClaimImageJob    = "Failed to claim image job: %v"
+	ImageJobNotFound         = "Image job not found: %v"
+	FailedToCreateImageBatch = "Failed to create image batch: %v"
+	FailedToFetchImageJobs   = "Failed to fetch image jobs: %v"
+	ImageBatchNotFound       = "Image batch not found: %v"
+
+	// Document repository errors
+	FailedToCreateDocumentCollection = "Failed to create document collection: %v"
+	DocumentCollectionNotFound       = "Document collection not found: %v"
+	FailedToCreateDocument           = "Failed to create document: %v"
+	DocumentNotFound                 = "Document not found: %v"
+	FailedToFetchDocuments           = "Failed to fetch documents: %v"
+	FailedToDeleteDocument           = "Failed to delete document: %v"
 )
 
 const (
@@ -0,0 +1,90 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*       - LLM Provider Errors -       */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Every LLM provider fails in its own way, they all translate their errors to one of these.
+type LLMErrKind string
+
+const (
+	LLMInvalidRequest LLMErrKind = "invalid_request" // Our request was wrong, like an unknown model.
+	LLMUnauthorized   LLMErrKind = "unauthorized"    // Our API key is wrong or can't do that.
+	LLMRateLimited    LLMErrKind = "rate_limited"
+	LLMUnavailable    LLMErrKind = "unavailable" // Overloaded, down or timing out.
+	LLMBadResponse    LLMErrKind = "bad_response"
+)
+
+type LLMErr struct {
+	Provider string
+	Kind     LLMErrKind
+	Status   int // HTTP status of the provider's response, 0 if there was none.
+	Err      error
+}
+
+func (llmErr LLMErr) Error() string {
+	return fmt.Sprintf("llm error -> %s %s (%d): %v", llmErr.Provider, llmErr.Kind, llmErr.Status, llmErr.Err)
+}
+
+func (llmErr LLMErr) Unwrap() error {
+	return llmErr.Err
+}
+
+// Used by providers that return regular HTTP statuses on their errors.
+func LLMErrKindFromStatus(status int) LLMErrKind {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return LLMUnauthorized
+	case status == http.StatusTooManyRequests:
+		return LLMRateLimited
+	case status >= 500:
+		return LLMUnavailable
+	case status >= 400:
+		return LLMInvalidRequest
+	}
+	return LLMBadResponse
+}
+
+// We return this on errors coming from the LLM providers.
+// Our own credentials failing is our problem, so the user gets an Internal for those.
+func GRPCFromLLM(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return NewGRPCError(codes.Canceled, err)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return NewGRPCError(codes.DeadlineExceeded, err)
+	}
+
+	var llmErr *LLMErr
+	if !errors.As(err, &llmErr) {
+		return NewGRPCError(codes.Unknown, err, "error calling LLM")
+	}
+
+	switch llmErr.Kind {
+	case LLMInvalidRequest:
+		return NewGRPCError(codes.InvalidArgument, err)
+	case LLMRateLimited:
+		return NewGRPCError(codes.ResourceExhausted, err)
+	case LLMUnavailable:
+		return NewGRPCError(codes.Unavailable, err)
+	}
+	return NewGRPCError(codes.Internal, err)
+}
+
+// Whether the same call could work if it's made again later.
+func IsRetryableLLMErr(err error) bool {
+	var llmErr *LLMErr
+	if errors.As(err, &llmErr) {
+		return llmErr.Kind == LLMRateLimited || llmErr.Kind == LLMUnavailable
+	}
+	return errors.Is(err, context.DeadlineExceeded)
+}
@@ -6,6 +6,7 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
 )
 
 // Returns a GRPC Status error with our custom ServiceErr inside.
@@ -56,6 +57,40 @@ func GRPCAlreadyExists(resource string) error {
 	return NewGRPCError(codes.AlreadyExists, errors.New(resource+" already exists"))
 }
 
+// Translates to HTTP 403 Forbidden Error.
+// Used when the user is authenticated but can't do that to this resource.
+func GRPCPermissionDenied(reason string) error {
+	return NewGRPCError(codes.PermissionDenied, errors.New(reason))
+}
+
+// Translates to HTTP 400 Bad Request Error.
+// Used when the resource is not in the state the request needs, like answering an expired invite.
+func GRPCFailedPrecondition(reason string) error {
+	return NewGRPCError(codes.FailedPrecondition, errors.New(reason))
+}
+
+// Translates to HTTP 429 Too Many Requests.
+// Used when a usage budget runs out. The details let clients know which budget and how much is left.
+func GRPCResourceExhausted(reason string, details ...protoadapt.MessageV1) error {
+	serviceErr := ServiceErr{errors.New(reason), codes.ResourceExhausted, nil}
+	grpcStatus := status.New(codes.ResourceExhausted, serviceErr.Error())
+	if withDetails, err := grpcStatus.WithDetails(details...); err == nil {
+		grpcStatus = withDetails
+	}
+	return grpcStatus.Err()
+}
+
+// Translates to HTTP 400 Bad Request Error.
+// Used when we won't process a valid request, like a blocked prompt. The details let clients know why.
+func GRPCInvalidArgument(reason string, details ...protoadapt.MessageV1) error {
+	serviceErr := ServiceErr{errors.New(reason), codes.InvalidArgument, nil}
+	grpcStatus := status.New(codes.InvalidArgument, serviceErr.Error())
+	if withDetails, err := grpcStatus.WithDetails(details...); err == nil {
+		grpcStatus = withDetails
+	}
+	return grpcStatus.Err()
+}
+
 // We return this on username or password mismatch on the Auth Service's Login.
 func GRPCWrongLoginInfo() error {
 	return NewGRPCError(codes.Unauthenticated, errors.New("wrong username or password"))
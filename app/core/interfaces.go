@@ -8,6 +8,7 @@ package core
 
 import (
 	"context"
+	"image"
 	"net/http"
 	"time"
 
@@ -36,11 +37,16 @@ type (
 		// Database access
 		GetDB() any
 		CloseDB() error
+		PingDB(ctx context.Context) error
 
 		// Repositories
 		UserRepository() UserRepository
 		GroupRepository() GroupRepository
 		GPTChatRepository() GPTChatRepository
+		UsageRepository() UsageRepository
+		ModerationRepository() ModerationRepository
+		ImageJobRepository() ImageJobRepository
+		DocumentRepository() DocumentRepository
 
 		// API clients
 		APIClients
@@ -82,11 +88,31 @@ type (
 
 type (
 	GPTAPI interface {
-		SendRequestToGPT(ctx context.Context, prompt string, prevMsgs ...apimodels.GPTChatMsg) (string, error)
+		SendRequestToGPT(ctx context.Context, req apimodels.LLMChatRequest) (apimodels.GPTChatResult, error)
+		StreamRequestToGPT(ctx context.Context, req apimodels.LLMChatRequest, onDelta func(delta string) error) (apimodels.GPTChatResult, error)
 		SendRequestToDallE(ctx context.Context, prompt string, size pbs.GPTImageSize) (apimodels.GPTImageMsg, error)
+		SendEditToDallE(ctx context.Context, img, mask image.Image, prompt string, size pbs.GPTImageSize) (apimodels.GPTImageMsg, error) // mask can be nil.
+		SendVariationToDallE(ctx context.Context, img image.Image, size pbs.GPTImageSize) (apimodels.GPTImageMsg, error)
+		SendToModeration(ctx context.Context, text string) (apimodels.ModerationResult, error)
+		SendToEmbeddings(ctx context.Context, texts []string) (apimodels.EmbeddingsResult, error)
+		DefaultLLMModel(provider apimodels.LLMProviderName) apimodels.GPTs // Empty provider means the default one.
 	}
+
+	// Each LLM backend implements this. The GPTAPI picks one for each request and normalizes
+	// the request before passing it, so providers only see the system prompt and user, assistant and tool roles.
+	// Their errors should be *errs.LLMErr.
+	LLMProvider interface {
+		Chat(ctx context.Context, req apimodels.LLMChatRequest) (apimodels.GPTChatResult, error)
+		StreamChat(ctx context.Context, req apimodels.LLMChatRequest, onDelta func(delta string) error) (apimodels.GPTChatResult, error)
+		DefaultModel() apimodels.GPTs // Used on requests that don't pick a model.
+	}
+	// Everything is in standard units: Kelvin and meters per second.
+	// Answers are cached by their rounded coordinates, and by the name on geocoding, so they must not be changed.
 	WeatherAPI interface {
 		GetCurrentWeather(ctx god.Ctx, lat, lon float64) (*apimodels.GetWeatherResponse, error)
+		GetWeatherForecast(ctx god.Ctx, lat, lon float64) (*apimodels.GetForecastResponse, error)
+		GeocodeCity(ctx god.Ctx, name string, limit int) ([]apimodels.GeocodingResult, error)
+		PingWeather(ctx god.Ctx) error
 	}
 )
 
@@ -102,6 +128,7 @@ type Tools interface {
 	ShutdownJanitor
 	RateLimiter
 	PwdHasher
+	SecretGenerator
 	TLSManager
 	FileManager
 	ContextManager
@@ -109,6 +136,11 @@ type Tools interface {
 	ImageLoader
 	IDGenerator[string]
 	FileDownloader
+	MediaStore
+	TokenCounter
+	Moderator
+	DocumentReader
+	VectorIndex
 }
 
 /* -~-~-~-~- Other -~-~-~-~- */
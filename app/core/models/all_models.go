@@ -15,9 +15,23 @@ type Model interface {
 // DO NOT EDIT this slice manually, just run go generate ./...
 // and any model defined in this package should be added automatically.
 var AllModels = []any{
+	&DocumentCollection{},
+	&Document{},
+	&DocumentChunk{},
 	&GPTChat{},
 	&GPTMessage{},
+	&SystemPrompt{},
+	&GPTMedia{},
 	&Group{},
+	&GroupInvite{},
+	&GroupInviteLink{},
+	&GroupInviteLinkJoin{},
+	&GroupActivity{},
+	&UsageBudget{},
+	&ImageJob{},
+	&ImageBatch{},
+	&LLMUsage{},
+	&ModerationEvent{},
 	&User{},
 	&UsersInGroup{},
 }
@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+// DocumentCollection groups the documents a user uploaded, to be searched together.
+// Their chunks' vectors live on the vector index, one file per collection.
+type DocumentCollection struct {
+	ID          int        `gorm:"primaryKey" bson:"id"`
+	OwnerID     int        `gorm:"index;not null" bson:"owner_id"`
+	Name        string     `gorm:"size:128;not null" bson:"name"`
+	Description string     `gorm:"type:text" bson:"description"`
+	Documents   []Document `gorm:"foreignKey:CollectionID" bson:"documents"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime" bson:"created_at"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime" bson:"updated_at"`
+}
+
+func (DocumentCollection) TableName() string {
+	return "document_collections"
+}
+
+// Document is an uploaded file, already split into chunks.
+// We only keep its text, not the file.
+type Document struct {
+	ID           int       `gorm:"primaryKey" bson:"id"`
+	CollectionID int       `gorm:"index;not null" bson:"collection_id"`
+	OwnerID      int       `gorm:"index;not null" bson:"owner_id"`
+	Name         string    `gorm:"size:255;not null" bson:"name"`
+	ContentType  string    `gorm:"size:64;not null" bson:"content_type"`
+	SizeBytes    int64     `gorm:"not null;default:0" bson:"size_bytes"`
+	SHA256       string    `gorm:"index;not null;default:''" bson:"sha256"`
+	Pages        int       `gorm:"not null;default:0" bson:"pages"` // 1 for anything that's not a PDF.
+	Chunks       int       `gorm:"not null;default:0" bson:"chunks"`
+	CreatedAt    time.Time `gorm:"autoCreateTime" bson:"created_at"`
+}
+
+func (Document) TableName() string {
+	return "documents"
+}
+
+// DocumentChunk is a piece of a Document's text, what we embed, search and cite.
+type DocumentChunk struct {
+	ID           int    `gorm:"primaryKey" bson:"id"`
+	DocumentID   int    `gorm:"index;not null" bson:"document_id"`
+	CollectionID int    `gorm:"index;not null" bson:"collection_id"`
+	Index        int    `gorm:"not null" bson:"index"` // Its position on the document, from 0.
+	Page         int    `gorm:"not null;default:1" bson:"page"`
+	Content      string `gorm:"type:text;not null" bson:"content"`
+}
+
+func (DocumentChunk) TableName() string {
+	return "document_chunks"
+}
@@ -1,29 +1,234 @@
 package models
 
-import "time"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"text/template"
+	"time"
+)
 
 type GPTChat struct {
-	ID        int          `gorm:"primaryKey" bson:"id"`
-	Title     string       `gorm:"not null" bson:"title"`
-	Messages  []GPTMessage `gorm:"foreignKey:ChatID" bson:"messages"`
-	CreatedAt time.Time    `gorm:"autoCreateTime" bson:"created_at"`
-	UpdatedAt time.Time    `gorm:"autoUpdateTime" bson:"updated_at"`
+	ID            int               `gorm:"primaryKey" bson:"id"`
+	Title         string            `gorm:"not null" bson:"title"`
+	OwnerID       int               `gorm:"index;not null;default:0" bson:"owner_id"`
+	Owner         *User             `gorm:"foreignKey:OwnerID" bson:"owner"`
+	Visibility    GPTChatVisibility `gorm:"not null;default:'private'" bson:"visibility"`
+	GroupID       *int              `gorm:"index" bson:"group_id"` // Only set when shared with a group.
+	GroupCanReply bool              `gorm:"not null;default:false" bson:"group_can_reply"`
+	Messages      []GPTMessage      `gorm:"foreignKey:ChatID" bson:"messages"`
+	CreatedAt     time.Time         `gorm:"autoCreateTime" bson:"created_at"`
+	UpdatedAt     time.Time         `gorm:"autoUpdateTime" bson:"updated_at"`
+	Deleted       bool              `gorm:"not null;default:false" bson:"deleted"`
+
+	// What the chat was started with, so its answers can be reproduced. Replies use them too.
+	Provider        string   `gorm:"not null;default:''" bson:"provider"`
+	Model           string   `gorm:"not null;default:''" bson:"model"`
+	Temperature     *float64 `bson:"temperature"`
+	MaxTokens       *int     `bson:"max_tokens"`
+	SystemPromptID  *int     `bson:"system_prompt_id"`                                   // Template it was rendered from, if any.
+	SystemPrompt    string   `gorm:"type:text;not null;default:''" bson:"system_prompt"` // As it was sent.
+	PromptVariables string   `gorm:"type:text" bson:"prompt_variables"`                  // JSON object of string values.
+
+	// Messages up to this one are no longer sent, their summary is sent instead. See GPTMessageSummary.
+	SummaryUpToID int `gorm:"not null;default:0" bson:"summary_up_to_id"`
+
+	// The last message of the branch being shown and continued. Nil on chats from before branches, see BranchMessages.
+	HeadMessageID *int `bson:"head_message_id"`
 }
 
 func (GPTChat) TableName() string {
 	return "gpt_chats"
 }
 
+type GPTChatVisibility string
+
+const (
+	GPTChatPrivate GPTChatVisibility = "private"
+	GPTChatGroup   GPTChatVisibility = "group"
+)
+
+func (c *GPTChat) IsSharedWithGroup() bool {
+	return c.Visibility == GPTChatGroup && c.GroupID != nil
+}
+
+func (c *GPTChat) SetPromptVariables(variables map[string]string) {
+	c.PromptVariables = "{}"
+	if len(variables) > 0 {
+		if variablesJSON, err := json.Marshal(variables); err == nil {
+			c.PromptVariables = string(variablesJSON)
+		}
+	}
+}
+
 type GPTMessage struct {
-	ID        int       `gorm:"primaryKey" bson:"id"`
-	ChatID    int       `gorm:"index;not null" bson:"chat_id"`
-	Title     string    `gorm:"not null" bson:"title"`
-	From      string    `gorm:"not null" bson:"from"`
-	Content   string    `gorm:"type:text;not null" bson:"content"`
-	CreatedAt time.Time `gorm:"autoCreateTime" bson:"created_at"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime" bson:"updated_at"`
+	ID        int        `gorm:"primaryKey" bson:"id"`
+	ChatID    int        `gorm:"index;not null" bson:"chat_id"`
+	Title     string     `gorm:"not null" bson:"title"`
+	From      string     `gorm:"not null" bson:"from"`
+	SenderID  *int       `gorm:"index" bson:"sender_id"` // The user who sent it, nil for GPT's messages.
+	Sender    *User      `gorm:"foreignKey:SenderID" bson:"sender"`
+	Content   string     `gorm:"type:text;not null" bson:"content"`
+	Media     []GPTMedia `gorm:"foreignKey:MessageID" bson:"media"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" bson:"created_at"`
+	UpdatedAt time.Time  `gorm:"autoUpdateTime" bson:"updated_at"`
+
+	// Only on tool calls and results, see GPTMessageToolCall.
+	ToolName   string `gorm:"not null;default:''" bson:"tool_name"`
+	ToolCallID string `gorm:"not null;default:''" bson:"tool_call_id"`
+
+	// The message this one follows. Messages with the same parent are alternatives of each other, like
+	// regenerated answers or edited prompts, so a chat is a tree of them. Nil on the first message and on summaries.
+	ParentID *int `gorm:"index" bson:"parent_id"`
 }
 
 func (GPTMessage) TableName() string {
 	return "gpt_messages"
 }
+
+// Messages From this hold the summary of the chat's older messages, which are sent
+// as part of the system prompt once the chat doesn't fit in its model's context.
+// Each summary includes the previous one, so only the latest is used.
+const GPTMessageSummary = "summary"
+
+// Messages From these are a tool GPT asked us to run, with its arguments as the content, and what it returned.
+// Both have the tool's name and the ID of the call, which pairs them. They come before the answer that used them.
+const (
+	GPTMessageToolCall   = "tool_call"
+	GPTMessageToolResult = "tool"
+)
+
+/* -~-~-~- Branches -~-~-~- */
+
+// BranchMessages returns the messages from the first one to the chat's head, following their parents.
+// Summaries are never on it. Messages must be sorted by ID, as they're loaded.
+//
+// Chats from before branches have no head and their messages have no parents. On them each message
+// follows the one before it, so the branch is all of their messages.
+func (c *GPTChat) BranchMessages() []GPTMessage {
+	if c.HeadMessageID != nil {
+		return c.MessagesUpTo(*c.HeadMessageID)
+	}
+	for i := len(c.Messages) - 1; i >= 0; i-- {
+		if c.Messages[i].From != GPTMessageSummary {
+			return c.MessagesUpTo(c.Messages[i].ID)
+		}
+	}
+	return nil
+}
+
+// MessagesUpTo returns the messages from the first one to the given one, following their parents.
+// It's empty if the message isn't on the chat or is a summary.
+func (c *GPTChat) MessagesUpTo(messageID int) []GPTMessage {
+	parents := c.parentIDs()
+
+	var path []GPTMessage
+	for id := messageID; id != 0 && len(path) < len(c.Messages); id = parents[id] {
+		i := slices.IndexFunc(c.Messages, func(msg GPTMessage) bool { return msg.ID == id })
+		if _, ok := parents[id]; !ok || i < 0 {
+			return nil
+		}
+		path = append(path, c.Messages[i])
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// ParentOf returns the ID of the message the given one follows, or 0 if it's the first one or a summary.
+func (c *GPTChat) ParentOf(messageID int) int {
+	return c.parentIDs()[messageID]
+}
+
+// Alternatives returns the IDs of the messages with the same parent as the given one, itself included, oldest first.
+func (c *GPTChat) Alternatives(messageID int) []int {
+	parents := c.parentIDs()
+	parentID, ok := parents[messageID]
+	if !ok {
+		return nil
+	}
+
+	var ids []int
+	for _, msg := range c.Messages {
+		if id, ok := parents[msg.ID]; ok && id == parentID {
+			ids = append(ids, msg.ID)
+		}
+	}
+	return ids
+}
+
+// LatestLeaf returns the ID of the newest message under the given one, following the newest
+// of its children each time. It's the message itself if it has none.
+func (c *GPTChat) LatestLeaf(messageID int) int {
+	newestChild := map[int]int{}
+	for id, parentID := range c.parentIDs() {
+		newestChild[parentID] = max(newestChild[parentID], id)
+	}
+	for newestChild[messageID] != 0 {
+		messageID = newestChild[messageID]
+	}
+	return messageID
+}
+
+// Maps each message but the summaries to the one it follows, 0 for the first one.
+// Messages without a parent follow the one before them, see BranchMessages.
+func (c *GPTChat) parentIDs() map[int]int {
+	parents := make(map[int]int, len(c.Messages))
+	previousID := 0
+	for _, msg := range c.Messages {
+		if msg.From == GPTMessageSummary {
+			continue
+		}
+		parents[msg.ID] = previousID
+		if msg.ParentID != nil {
+			parents[msg.ID] = *msg.ParentID
+		}
+		previousID = msg.ID
+	}
+	return parents
+}
+
+// SystemPrompt is a named template for the system prompt of new chats. Templates are never changed:
+// saving one with an existing name stores its next version, so chats can point to the exact one they used.
+//
+// Variables are written as {{.name}}, and rendering fails if one is missing.
+type SystemPrompt struct {
+	ID          int       `gorm:"primaryKey" bson:"id"`
+	Name        string    `gorm:"not null;uniqueIndex:idx_system_prompt_version" bson:"name"`
+	Version     int       `gorm:"not null;uniqueIndex:idx_system_prompt_version" bson:"version"`
+	Description string    `gorm:"not null;default:''" bson:"description"`
+	Template    string    `gorm:"type:text;not null" bson:"template"`
+	CreatedByID int       `gorm:"not null" bson:"created_by_id"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" bson:"created_at"`
+}
+
+func (SystemPrompt) TableName() string {
+	return "system_prompts"
+}
+
+func (sp *SystemPrompt) Render(variables map[string]string) (string, error) {
+	tmpl, err := parseSystemPrompt(sp.Name, sp.Template)
+	if err != nil {
+		return "", err
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, variables); err != nil {
+		return "", fmt.Errorf("error rendering system prompt %s v%d: %w", sp.Name, sp.Version, err)
+	}
+	return rendered.String(), nil
+}
+
+// Returns an error if the template can't be parsed, used to check them before storing them.
+func (sp *SystemPrompt) Validate() error {
+	_, err := parseSystemPrompt(sp.Name, sp.Template)
+	return err
+}
+
+func parseSystemPrompt(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid system prompt template: %w", err)
+	}
+	return tmpl, nil
+}
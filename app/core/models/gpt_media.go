@@ -0,0 +1,45 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// GPTMedia is a file attached to a GPTMessage, like the images DALL-E generates.
+// The providers' URLs expire, so we download the files and serve them ourselves.
+//
+// Files are stored by their content's hash, so the same file is only stored once.
+type GPTMedia struct {
+	ID          int            `gorm:"primaryKey" bson:"id"`
+	MessageID   int            `gorm:"index;not null" bson:"message_id"`
+	ChatID      int            `gorm:"index;not null" bson:"chat_id"`
+	Status      GPTMediaStatus `gorm:"not null;default:'pending'" bson:"status"`
+	SourceURL   string         `gorm:"type:text;not null" bson:"source_url"` // Where we downloaded it from.
+	Path        string         `gorm:"not null;default:''" bson:"path"`      // Where we stored it.
+	ContentType string         `gorm:"not null;default:''" bson:"content_type"`
+	SHA256      string         `gorm:"index;not null;default:''" bson:"sha256"`
+	SizeBytes   int64          `gorm:"not null;default:0" bson:"size_bytes"`
+	Width       int            `gorm:"not null;default:0" bson:"width"`
+	Height      int            `gorm:"not null;default:0" bson:"height"`
+	Attempts    int            `gorm:"not null;default:0" bson:"attempts"`
+	LastError   string         `gorm:"type:text" bson:"last_error"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime" bson:"created_at"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime" bson:"updated_at"`
+}
+
+func (GPTMedia) TableName() string {
+	return "gpt_media"
+}
+
+type GPTMediaStatus string
+
+const (
+	GPTMediaPending GPTMediaStatus = "pending" // Still downloading, or waiting to be retried.
+	GPTMediaStored  GPTMediaStatus = "stored"
+	GPTMediaFailed  GPTMediaStatus = "failed" // We gave up, LastError says why.
+)
+
+// Our stable URL for the file, served by the GetGPTImage endpoint.
+func (m *GPTMedia) URL() string {
+	return fmt.Sprintf("/v1/gpt/images/%d", m.ID)
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -9,16 +10,257 @@ import (
 /* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
 
 type Group struct {
-	ID        int       `gorm:"primaryKey" bson:"id"`
-	OwnerID   int       `gorm:"not null" bson:"owner_id"`
-	Name      string    `gorm:"not null" bson:"name"`
-	Members   []User    `gorm:"many2many:users_in_groups" bson:"members"`
-	Invited   []User    `gorm:"many2many:users_in_groups" bson:"invited"`
-	CreatedAt time.Time `bson:"created_at"`
-	UpdatedAt time.Time `bson:"updated_at"`
-	Deleted   bool      `bson:"deleted"`
+	ID          int            `gorm:"primaryKey" bson:"id"`
+	OwnerID     int            `gorm:"not null" bson:"owner_id"`
+	Name        string         `gorm:"not null" bson:"name"`
+	Members     []User         `gorm:"many2many:users_in_groups" bson:"members"`
+	Memberships []UsersInGroup `gorm:"foreignKey:GroupID" bson:"memberships"`
+	CreatedAt   time.Time      `bson:"created_at"`
+	UpdatedAt   time.Time      `bson:"updated_at"`
+	Deleted     bool           `bson:"deleted"`
 }
 
 func (Group) TableName() string {
 	return "groups"
 }
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*        - Group Invite Model -       */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Invites live on their own table, a user only becomes a member
+// of the group (UsersInGroup) after accepting one of these.
+type GroupInvite struct {
+	ID         int               `gorm:"primaryKey" bson:"id"`
+	GroupID    int               `gorm:"not null;index" bson:"group_id"`
+	Group      *Group            `gorm:"foreignKey:GroupID" bson:"group"`
+	UserID     int               `gorm:"not null;index" bson:"user_id"`
+	User       *User             `gorm:"foreignKey:UserID" bson:"user"`
+	InviterID  int               `gorm:"not null" bson:"inviter_id"`
+	Inviter    *User             `gorm:"foreignKey:InviterID" bson:"inviter"`
+	Status     GroupInviteStatus `gorm:"not null;default:'pending';index" bson:"status"`
+	ExpiresAt  time.Time         `gorm:"not null" bson:"expires_at"`
+	AnsweredAt *time.Time        `bson:"answered_at"`
+	CreatedAt  time.Time         `bson:"created_at"`
+	UpdatedAt  time.Time         `bson:"updated_at"`
+}
+
+func (GroupInvite) TableName() string {
+	return "group_invites"
+}
+
+type GroupInviteStatus string
+
+const (
+	GroupInvitePending  GroupInviteStatus = "pending"
+	GroupInviteAccepted GroupInviteStatus = "accepted"
+	GroupInviteDeclined GroupInviteStatus = "declined"
+	GroupInviteRevoked  GroupInviteStatus = "revoked"
+	GroupInviteExpired  GroupInviteStatus = "expired"
+)
+
+// Invites are valid for a week after being sent.
+const GroupInviteTTL = 7 * 24 * time.Hour
+
+func NewGroupInvite(groupID, userID, inviterID int) *GroupInvite {
+	return &GroupInvite{
+		GroupID:   groupID,
+		UserID:    userID,
+		InviterID: inviterID,
+		Status:    GroupInvitePending,
+		ExpiresAt: time.Now().Add(GroupInviteTTL),
+	}
+}
+
+// Expired invites are only marked as such when someone touches them,
+// so a pending invite can also be an expired one.
+func (gi *GroupInvite) IsExpired() bool {
+	return gi.Status == GroupInviteExpired ||
+		(gi.Status == GroupInvitePending && time.Now().After(gi.ExpiresAt))
+}
+
+func (gi *GroupInvite) IsPending() bool {
+	return gi.Status == GroupInvitePending && !gi.IsExpired()
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*      - Group Invite Link Model -    */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Shareable links to join a group. We only store the hash of the link's token,
+// the plain token is shown once to the owner who created it.
+type GroupInviteLink struct {
+	ID        int                   `gorm:"primaryKey" bson:"id"`
+	GroupID   int                   `gorm:"not null;index" bson:"group_id"`
+	CreatorID int                   `gorm:"not null" bson:"creator_id"`
+	Creator   *User                 `gorm:"foreignKey:CreatorID" bson:"creator"`
+	TokenHash string                `gorm:"not null;uniqueIndex;size:64" bson:"token_hash"`
+	Role      GroupRole             `gorm:"not null;default:'member'" bson:"role"`
+	MaxUses   int                   `gorm:"not null;default:0" bson:"max_uses"` // 0 means unlimited.
+	Uses      int                   `gorm:"not null;default:0" bson:"uses"`
+	ExpiresAt *time.Time            `bson:"expires_at"` // nil means it never expires.
+	RevokedAt *time.Time            `bson:"revoked_at"`
+	Joins     []GroupInviteLinkJoin `gorm:"foreignKey:LinkID" bson:"joins"`
+	CreatedAt time.Time             `bson:"created_at"`
+	UpdatedAt time.Time             `bson:"updated_at"`
+}
+
+func (GroupInviteLink) TableName() string {
+	return "group_invite_links"
+}
+
+// A link can be used while it's not revoked, expired or used up.
+func (gil *GroupInviteLink) IsActive() bool {
+	if gil.RevokedAt != nil {
+		return false
+	}
+	if gil.ExpiresAt != nil && time.Now().After(*gil.ExpiresAt) {
+		return false
+	}
+	return gil.MaxUses == 0 || gil.Uses < gil.MaxUses
+}
+
+// Returns why the link can't be used anymore, or an empty string if it's active.
+func (gil *GroupInviteLink) InactiveReason() string {
+	switch {
+	case gil.RevokedAt != nil:
+		return "revoked"
+	case gil.ExpiresAt != nil && time.Now().After(*gil.ExpiresAt):
+		return "expired"
+	case gil.MaxUses != 0 && gil.Uses >= gil.MaxUses:
+		return "used up"
+	}
+	return ""
+}
+
+// Each user that joined a group through an invite link.
+type GroupInviteLinkJoin struct {
+	ID        int       `gorm:"primaryKey" bson:"id"`
+	LinkID    int       `gorm:"not null;index" bson:"link_id"`
+	GroupID   int       `gorm:"not null;index" bson:"group_id"`
+	UserID    int       `gorm:"not null" bson:"user_id"`
+	User      *User     `gorm:"foreignKey:UserID" bson:"user"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+func (GroupInviteLinkJoin) TableName() string {
+	return "group_invite_link_joins"
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*      - Group Activity Model -       */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Something that happened in a group. These are written on the same
+// transaction as the change they describe, so the feed never lies.
+type GroupActivity struct {
+	ID           int               `gorm:"primaryKey" bson:"id"`
+	GroupID      int               `gorm:"not null;index" bson:"group_id"`
+	Type         GroupActivityType `gorm:"not null;index" bson:"type"`
+	ActorID      int               `gorm:"not null" bson:"actor_id"`
+	Actor        *User             `gorm:"foreignKey:ActorID" bson:"actor"`
+	TargetUserID *int              `bson:"target_user_id"`
+	TargetUser   *User             `gorm:"foreignKey:TargetUserID" bson:"target_user"`
+	Details      string            `gorm:"type:text" bson:"details"` // JSON object of string values.
+	CreatedAt    time.Time         `bson:"created_at"`
+}
+
+func (GroupActivity) TableName() string {
+	return "group_activities"
+}
+
+type GroupActivityType string
+
+const (
+	GroupCreatedActivity         GroupActivityType = "group_created"
+	GroupRenamedActivity         GroupActivityType = "group_renamed"
+	MemberInvitedActivity        GroupActivityType = "member_invited"
+	MemberJoinedActivity         GroupActivityType = "member_joined"
+	MemberLeftActivity           GroupActivityType = "member_left"
+	MemberRemovedActivity        GroupActivityType = "member_removed"
+	RoleChangedActivity          GroupActivityType = "role_changed"
+	OwnershipTransferredActivity GroupActivityType = "ownership_transferred"
+	ChatSharedActivity           GroupActivityType = "chat_shared"
+)
+
+// Pass 0 as targetUserID when the activity isn't about another user.
+func NewGroupActivity(groupID, actorID int, activityType GroupActivityType, targetUserID int, details map[string]string) *GroupActivity {
+	activity := &GroupActivity{
+		GroupID: groupID,
+		Type:    activityType,
+		ActorID: actorID,
+		Details: "{}",
+	}
+	if targetUserID != 0 {
+		activity.TargetUserID = &targetUserID
+	}
+	if len(details) > 0 {
+		if detailsJSON, err := json.Marshal(details); err == nil {
+			activity.Details = string(detailsJSON)
+		}
+	}
+	return activity
+}
+
+func (ga *GroupActivity) GetDetails() map[string]string {
+	details := map[string]string{}
+	_ = json.Unmarshal([]byte(ga.Details), &details)
+	return details
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*      - Group Usage Budget Model -   */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Monthly cap on how much GPT the group can use, either as a whole (UserID 0)
+// or per member. Used only counts for the month in Period, so budgets reset by
+// themselves when a new month starts.
+type UsageBudget struct {
+	ID           int        `gorm:"primaryKey" bson:"id"`
+	GroupID      int        `gorm:"not null;uniqueIndex:idx_usage_budget" bson:"group_id"`
+	UserID       int        `gorm:"not null;default:0;uniqueIndex:idx_usage_budget" bson:"user_id"`
+	Unit         BudgetUnit `gorm:"not null;uniqueIndex:idx_usage_budget" bson:"unit"`
+	MonthlyLimit int64      `gorm:"not null" bson:"monthly_limit"`
+	Used         int64      `gorm:"not null;default:0" bson:"used"`
+	Period       string     `gorm:"not null" bson:"period"`
+	CreatedAt    time.Time  `bson:"created_at"`
+	UpdatedAt    time.Time  `bson:"updated_at"`
+}
+
+func (UsageBudget) TableName() string {
+	return "usage_budgets"
+}
+
+type BudgetUnit string
+
+const (
+	BudgetTokens BudgetUnit = "tokens"
+	BudgetImages BudgetUnit = "images"
+)
+
+// Returns the month usage is being counted for, like "2024-09".
+func BudgetPeriod(now time.Time) string {
+	return now.UTC().Format("2006-01")
+}
+
+// Returns when the current month's budgets reset.
+func BudgetResetsAt(now time.Time) time.Time {
+	year, month, _ := now.UTC().Date()
+	return time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (b *UsageBudget) IsGroupWide() bool {
+	return b.UserID == 0
+}
+
+// Usage from past months doesn't count.
+func (b *UsageBudget) UsedIn(period string) int64 {
+	if b.Period != period {
+		return 0
+	}
+	return b.Used
+}
+
+func (b *UsageBudget) RemainingIn(period string) int64 {
+	return max(b.MonthlyLimit-b.UsedIn(period), 0)
+}
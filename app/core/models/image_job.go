@@ -0,0 +1,69 @@
+package models
+
+import "time"
+
+// ImageJob is an image being made in the background, shown to its user as a GPTOperation.
+// The workers pick the queued ones whose NextAttemptAt has passed, oldest first.
+type ImageJob struct {
+	ID            int            `gorm:"primaryKey" bson:"id"`
+	OperationID   string         `gorm:"uniqueIndex;size:64;not null" bson:"operation_id"`
+	UserID        int            `gorm:"index;not null" bson:"user_id"`
+	GroupID       *int           `bson:"group_id"` // The group it's charged to, if any.
+	ChatID        int            `gorm:"index;not null" bson:"chat_id"`
+	Prompt        string         `gorm:"type:text;not null" bson:"prompt"`
+	Size          int32          `gorm:"not null;default:0" bson:"size"` // A pbs.GPTImageSize.
+	Status        ImageJobStatus `gorm:"index;not null;default:'queued'" bson:"status"`
+	Attempts      int            `gorm:"not null;default:0" bson:"attempts"`
+	NextAttemptAt time.Time      `gorm:"index" bson:"next_attempt_at"`
+	ErrorCode     int32          `gorm:"not null;default:0" bson:"error_code"` // A codes.Code, when it failed.
+	LastError     string         `gorm:"type:text" bson:"last_error"`
+	MediaID       *int           `bson:"media_id"`              // The image, when it succeeded.
+	BatchID       *int           `gorm:"index" bson:"batch_id"` // If it's part of an ImageBatch.
+	BatchIndex    int            `gorm:"not null;default:0" bson:"batch_index"`
+	Variables     string         `gorm:"type:text" bson:"variables"` // JSON, the batch's values used on the prompt.
+	CreatedAt     time.Time      `gorm:"autoCreateTime" bson:"created_at"`
+	UpdatedAt     time.Time      `gorm:"autoUpdateTime" bson:"updated_at"`
+	FinishedAt    *time.Time     `bson:"finished_at"`
+}
+
+func (ImageJob) TableName() string {
+	return "image_jobs"
+}
+
+type ImageJobStatus string
+
+const (
+	ImageJobQueued    ImageJobStatus = "queued" // Also while waiting to be retried.
+	ImageJobRunning   ImageJobStatus = "running"
+	ImageJobSucceeded ImageJobStatus = "succeeded"
+	ImageJobFailed    ImageJobStatus = "failed"
+	ImageJobCancelled ImageJobStatus = "cancelled"
+)
+
+func (job *ImageJob) IsDone() bool {
+	return job.Status == ImageJobSucceeded || job.Status == ImageJobFailed || job.Status == ImageJobCancelled
+}
+
+// ImageBatch is a prompt template made into many images, one ImageJob for each combination of its variables' values.
+// All of them go to the same chat.
+type ImageBatch struct {
+	ID        int            `gorm:"primaryKey" bson:"id"`
+	UserID    int            `gorm:"index;not null" bson:"user_id"`
+	GroupID   *int           `bson:"group_id"`
+	ChatID    int            `gorm:"index;not null" bson:"chat_id"`
+	Template  string         `gorm:"type:text;not null" bson:"template"`
+	Mode      ImageBatchMode `gorm:"size:16;not null" bson:"mode"`
+	Total     int            `gorm:"not null" bson:"total"`
+	CreatedAt time.Time      `gorm:"autoCreateTime" bson:"created_at"`
+}
+
+func (ImageBatch) TableName() string {
+	return "image_batches"
+}
+
+type ImageBatchMode string
+
+const (
+	ImageBatchProduct ImageBatchMode = "product" // Every combination.
+	ImageBatchSample  ImageBatchMode = "sample"  // Some random ones.
+)
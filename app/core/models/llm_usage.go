@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// LLMUsage is one call to an LLM provider: what it used, how long it took and what it cost.
+// We only store it for calls that succeeded, so it always points to the message with the answer.
+type LLMUsage struct {
+	ID               int          `gorm:"primaryKey" bson:"id"`
+	UserID           int          `gorm:"index;not null" bson:"user_id"`
+	GroupID          *int         `gorm:"index" bson:"group_id"` // The group it was charged to, if any.
+	ChatID           int          `gorm:"index;not null" bson:"chat_id"`
+	MessageID        int          `gorm:"not null" bson:"message_id"`
+	Operation        LLMOperation `gorm:"not null" bson:"operation"`
+	Provider         string       `gorm:"not null;default:''" bson:"provider"`
+	Model            string       `gorm:"index;not null" bson:"model"`
+	PromptTokens     int          `gorm:"not null;default:0" bson:"prompt_tokens"`
+	CompletionTokens int          `gorm:"not null;default:0" bson:"completion_tokens"`
+	ReasoningTokens  int          `gorm:"not null;default:0" bson:"reasoning_tokens"` // Already counted on CompletionTokens.
+	TotalTokens      int          `gorm:"not null;default:0" bson:"total_tokens"`
+	Images           int          `gorm:"not null;default:0" bson:"images"`
+	LatencyMs        int64        `gorm:"not null;default:0" bson:"latency_ms"`
+	CostUSD          float64      `gorm:"not null;default:0" bson:"cost_usd"`
+	Cached           bool         `gorm:"not null;default:false" bson:"cached"` // The answer was reused, so it used no tokens.
+	CreatedAt        time.Time    `gorm:"index;autoCreateTime" bson:"created_at"`
+}
+
+func (LLMUsage) TableName() string {
+	return "llm_usages"
+}
+
+type LLMOperation string
+
+const (
+	LLMChat           LLMOperation = "chat"
+	LLMChatStream     LLMOperation = "chat_stream"
+	LLMImage          LLMOperation = "image"
+	LLMImageEdit      LLMOperation = "image_edit"
+	LLMImageVariation LLMOperation = "image_variation"
+	LLMSummary        LLMOperation = "summary"
+	LLMEmbedding      LLMOperation = "embedding" // Not on any chat when embedding uploaded documents.
+	LLMTitle          LLMOperation = "title"
+)
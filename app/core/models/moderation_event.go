@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// ModerationEvent is a moderation decision that didn't let a prompt or an answer through as it was, kept for review.
+// The text itself isn't stored, as what was redacted from it shouldn't be stored anywhere.
+type ModerationEvent struct {
+	ID         int             `gorm:"primaryKey" bson:"id"`
+	UserID     int             `gorm:"index;not null" bson:"user_id"`
+	Route      string          `gorm:"not null" bson:"route"`
+	Stage      ModerationStage `gorm:"not null" bson:"stage"`
+	Action     string          `gorm:"index;not null" bson:"action"`          // redact or block.
+	Source     string          `gorm:"not null" bson:"source"`                // local or provider.
+	Categories string          `gorm:"not null;default:''" bson:"categories"` // Comma separated.
+	Rules      string          `gorm:"type:text;not null" bson:"rules"`       // Comma separated.
+	CreatedAt  time.Time       `gorm:"index;autoCreateTime" bson:"created_at"`
+}
+
+func (ModerationEvent) TableName() string {
+	return "moderation_events"
+}
+
+// Prompts are moderated before being sent, answers before being stored and returned.
+type ModerationStage string
+
+const (
+	ModerationPrompt ModerationStage = "prompt"
+	ModerationAnswer ModerationStage = "answer"
+)
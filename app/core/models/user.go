@@ -32,10 +32,28 @@ const (
 
 type UsersInGroup struct {
 	UserID    int       `gorm:"primaryKey;column:user_id;index;" bson:"user_id"`
+	User      *User     `gorm:"foreignKey:UserID" bson:"user"`
 	GroupID   int       `gorm:"primaryKey;column:group_id;index;" bson:"group_id"`
+	Role      GroupRole `gorm:"not null;default:'member'" bson:"role"`
 	CreatedAt time.Time `gorm:"autoCreateTime" bson:"created_at"`
 }
 
 func (UsersInGroup) TableName() string {
 	return "users_in_groups"
 }
+
+// Each group has exactly one owner, which is also the Group's OwnerID.
+type GroupRole string
+
+const (
+	GroupOwnerRole  GroupRole = "owner"
+	GroupAdminRole  GroupRole = "admin"
+	GroupMemberRole GroupRole = "member"
+)
+
+// Returns true if a member with this role can kick out or manage a member with the other one.
+// Owners outrank admins, admins outrank members.
+func (gr GroupRole) Outranks(other GroupRole) bool {
+	rank := map[GroupRole]int{GroupOwnerRole: 3, GroupAdminRole: 2, GroupMemberRole: 1}
+	return rank[gr] > rank[other]
+}
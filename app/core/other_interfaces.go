@@ -5,6 +5,7 @@ import (
 	"image"
 
 	"github.com/gilperopiola/god"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
 
@@ -89,10 +90,45 @@ type (
 		GetUsernameFromCtx(ctx god.Ctx) string
 	}
 
+	// Estimates how many tokens a text or some chat messages take on a model.
+	TokenCounter interface {
+		CountTokens(model apimodels.GPTs, text string) int
+		CountChatTokens(model apimodels.GPTs, msgs ...apimodels.GPTChatMsg) int
+	}
+
+	// Checks prompts and answers against our local rules, which block them or redact parts of them.
+	// Whether the provider's moderation endpoint should be asked too is part of the same config.
+	Moderator interface {
+		ModerateText(text string) apimodels.ModerationResult
+		ModerationEnabled() bool
+		ModeratesWithProvider() bool
+	}
+
 	FileDownloader interface {
+		DownloadFile(url string, extraHeaders map[string]string) ([]byte, error)
 		DownloadFileToDisk(url, fileExt string, extraHeaders map[string]string) (string, int, error)
 	}
 
+	// Stores files attached to messages, like generated images, and reads them back.
+	MediaStore interface {
+		StoreMedia(media *models.GPTMedia, content []byte) error
+		ReadMedia(media *models.GPTMedia) ([]byte, error)
+	}
+
+	// Gets the text out of uploaded documents, and cuts it into chunks small enough to embed.
+	DocumentReader interface {
+		ExtractText(name, contentType string, content []byte) (apimodels.DocumentText, error)
+		ChunkPages(pages []apimodels.DocumentPage) []apimodels.DocumentChunkText
+	}
+
+	// Saves the chunks' vectors, one index for each collection, and finds the closest ones to a vector.
+	VectorIndex interface {
+		AddVectors(collectionID int, entries []apimodels.VectorEntry) error
+		SearchVectors(collectionID int, vector []float32, topK int) ([]apimodels.VectorMatch, error)
+		RemoveDocumentVectors(collectionID, documentID int) error
+		DeleteVectorIndex(collectionID int) error
+	}
+
 	// File system operations.
 	FileManager interface {
 		CreateFolder(path string) error
@@ -117,6 +153,41 @@ type (
 
 		GroupToGroupInfoPB(*models.Group) *pbs.GroupInfo
 		GroupsToGroupsInfoPB([]*models.Group) []*pbs.GroupInfo
+		GroupMemberToGroupMemberInfoPB(*models.UsersInGroup) *pbs.GroupMemberInfo
+		GroupRoleFromPB(pbs.GroupRole) models.GroupRole
+
+		GroupInviteToGroupInviteInfoPB(*models.GroupInvite) *pbs.GroupInviteInfo
+		GroupInvitesToGroupInvitesInfoPB([]*models.GroupInvite) []*pbs.GroupInviteInfo
+
+		GroupInviteLinkToGroupInviteLinkInfoPB(*models.GroupInviteLink) *pbs.GroupInviteLinkInfo
+		GroupInviteLinksToGroupInviteLinksInfoPB([]*models.GroupInviteLink) []*pbs.GroupInviteLinkInfo
+
+		GroupActivitiesToGroupActivitiesInfoPB([]*models.GroupActivity) []*pbs.GroupActivityInfo
+		GroupActivityTypeFromPB(pbs.GroupActivityType) models.GroupActivityType
+
+		UsageBudgetToUsageBudgetInfoPB(*models.UsageBudget) *pbs.UsageBudgetInfo
+		UsageBudgetsToUsageBudgetsInfoPB([]*models.UsageBudget) []*pbs.UsageBudgetInfo
+		BudgetUnitFromPB(pbs.BudgetUnit) models.BudgetUnit
+
+		GPTChatToGPTChatInfoPB(*models.GPTChat) *pbs.GPTChatInfo
+		GPTChatsToGPTChatsInfoPB([]*models.GPTChat) []*pbs.GPTChatInfo
+		GPTMessagesToGPTMessagesInfoPB([]models.GPTMessage) []*pbs.GPTMessageInfo
+		GPTMediaToGPTMediaInfoPB(*models.GPTMedia) *pbs.GPTMediaInfo
+
+		SystemPromptToSystemPromptInfoPB(*models.SystemPrompt) *pbs.SystemPromptInfo
+		SystemPromptsToSystemPromptsInfoPB([]*models.SystemPrompt) []*pbs.SystemPromptInfo
+
+		ModerationEventsToModerationEventsInfoPB([]*models.ModerationEvent) []*pbs.ModerationEventInfo
+
+		ImageJobToGPTOperationPB(*models.ImageJob) *pbs.GPTOperation
+		ImageBatchToImageBatchInfoPB(*models.ImageBatch, *models.GPTChat, []*models.ImageJob) *pbs.ImageBatchInfo
+
+		DocumentCollectionToDocumentCollectionInfoPB(*models.DocumentCollection) *pbs.DocumentCollectionInfo
+		DocumentCollectionsToDocumentCollectionsInfoPB([]*models.DocumentCollection) []*pbs.DocumentCollectionInfo
+		DocumentToDocumentInfoPB(*models.Document) *pbs.DocumentInfo
+		DocumentsToDocumentsInfoPB([]*models.Document) []*pbs.DocumentInfo
+
+		UsageReportToUsageReportPB([]*UsageReportRow) ([]*pbs.UsageReportRow, *pbs.UsageReportRow)
 	}
 
 	// Hashes and compares passwords.
@@ -125,6 +196,12 @@ type (
 		PasswordsMatch(plainPwd, hashedPwd string) bool
 	}
 
+	// Generates random secrets and hashes them, so we only store the hashes.
+	SecretGenerator interface {
+		GenerateSecret() (secret string, hash string, err error)
+		HashSecret(secret string) string
+	}
+
 	// Used to limit the rate of incoming requests.
 	// GRPC Interceptor.
 	RateLimiter interface {
@@ -7,8 +7,10 @@
 package pbs
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -77,12 +79,72 @@ func (GPTImageSize) EnumDescriptor() ([]byte, []int) {
 	return file_gpt_proto_rawDescGZIP(), []int{0}
 }
 
+type ImageBatchMode int32
+
+const (
+	ImageBatchMode_IMAGE_BATCH_MODE_PRODUCT ImageBatchMode = 0 // Every combination of the variables' values.
+	ImageBatchMode_IMAGE_BATCH_MODE_SAMPLE  ImageBatchMode = 1 // sample_size random combinations, none repeated.
+)
+
+// Enum value maps for ImageBatchMode.
+var (
+	ImageBatchMode_name = map[int32]string{
+		0: "IMAGE_BATCH_MODE_PRODUCT",
+		1: "IMAGE_BATCH_MODE_SAMPLE",
+	}
+	ImageBatchMode_value = map[string]int32{
+		"IMAGE_BATCH_MODE_PRODUCT": 0,
+		"IMAGE_BATCH_MODE_SAMPLE":  1,
+	}
+)
+
+func (x ImageBatchMode) Enum() *ImageBatchMode {
+	p := new(ImageBatchMode)
+	*p = x
+	return p
+}
+
+func (x ImageBatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImageBatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_gpt_proto_enumTypes[1].Descriptor()
+}
+
+func (ImageBatchMode) Type() protoreflect.EnumType {
+	return &file_gpt_proto_enumTypes[1]
+}
+
+func (x ImageBatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImageBatchMode.Descriptor instead.
+func (ImageBatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{1}
+}
+
 type NewGPTChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// If set, the usage is charged to this group's budgets. The caller must be a member.
+	GroupId *int32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	// LLM provider and model to answer with. If not set, the configured ones are used.
+	Provider *string `protobuf:"bytes,3,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
+	Model    *string `protobuf:"bytes,4,opt,name=model,proto3,oneof" json:"model,omitempty"`
+	// Only for new chats, replies use the ones the chat was started with.
+	Temperature     *float64          `protobuf:"fixed64,5,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	MaxTokens       *int32            `protobuf:"varint,6,opt,name=max_tokens,json=maxTokens,proto3,oneof" json:"max_tokens,omitempty"`
+	SystemPromptId  *int32            `protobuf:"varint,7,opt,name=system_prompt_id,json=systemPromptId,proto3,oneof" json:"system_prompt_id,omitempty"`                                                                                   // Without it, our default instructions are used.
+	PromptVariables map[string]string `protobuf:"bytes,8,rep,name=prompt_variables,json=promptVariables,proto3" json:"prompt_variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Rendered into the system prompt's template.
+	// Names of the tools GPT can use to answer. See ListGPTTools.
+	Tools []string `protobuf:"bytes,9,rep,name=tools,proto3" json:"tools,omitempty"`
+	// If set, the most relevant excerpts of the collection's documents are sent along with the message.
+	Retrieval *DocumentRetrieval `protobuf:"bytes,10,opt,name=retrieval,proto3" json:"retrieval,omitempty"`
 }
 
 func (x *NewGPTChatRequest) Reset() {
@@ -124,13 +186,80 @@ func (x *NewGPTChatRequest) GetMessage() string {
 	return ""
 }
 
+func (x *NewGPTChatRequest) GetGroupId() int32 {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return 0
+}
+
+func (x *NewGPTChatRequest) GetProvider() string {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ""
+}
+
+func (x *NewGPTChatRequest) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
+func (x *NewGPTChatRequest) GetTemperature() float64 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *NewGPTChatRequest) GetMaxTokens() int32 {
+	if x != nil && x.MaxTokens != nil {
+		return *x.MaxTokens
+	}
+	return 0
+}
+
+func (x *NewGPTChatRequest) GetSystemPromptId() int32 {
+	if x != nil && x.SystemPromptId != nil {
+		return *x.SystemPromptId
+	}
+	return 0
+}
+
+func (x *NewGPTChatRequest) GetPromptVariables() map[string]string {
+	if x != nil {
+		return x.PromptVariables
+	}
+	return nil
+}
+
+func (x *NewGPTChatRequest) GetTools() []string {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+func (x *NewGPTChatRequest) GetRetrieval() *DocumentRetrieval {
+	if x != nil {
+		return x.Retrieval
+	}
+	return nil
+}
+
 type NewGPTChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Chat       *GPTChatInfo `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
-	GptMessage string       `protobuf:"bytes,2,opt,name=gpt_message,json=gptMessage,proto3" json:"gpt_message,omitempty"`
+	Chat         *GPTChatInfo        `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+	GptMessage   string              `protobuf:"bytes,2,opt,name=gpt_message,json=gptMessage,proto3" json:"gpt_message,omitempty"`
+	Context      *GPTContextInfo     `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	ToolMessages []*GPTMessageInfo   `protobuf:"bytes,4,rep,name=tool_messages,json=toolMessages,proto3" json:"tool_messages,omitempty"` // The tools GPT called and what they returned, in order.
+	Cached       bool                `protobuf:"varint,5,opt,name=cached,proto3" json:"cached,omitempty"`                                // The answer was reused from an identical chat sent before.
+	Citations    []*DocumentCitation `protobuf:"bytes,6,rep,name=citations,proto3" json:"citations,omitempty"`                           // The excerpts sent with retrieval, GPT cites them by number.
 }
 
 func (x *NewGPTChatResponse) Reset() {
@@ -179,6 +308,34 @@ func (x *NewGPTChatResponse) GetGptMessage() string {
 	return ""
 }
 
+func (x *NewGPTChatResponse) GetContext() *GPTContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *NewGPTChatResponse) GetToolMessages() []*GPTMessageInfo {
+	if x != nil {
+		return x.ToolMessages
+	}
+	return nil
+}
+
+func (x *NewGPTChatResponse) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *NewGPTChatResponse) GetCitations() []*DocumentCitation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
 type ReplyToGPTChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -186,6 +343,16 @@ type ReplyToGPTChatRequest struct {
 
 	ChatId  int32  `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// If set, the usage is charged to this group's budgets. The caller must be a member.
+	// If not, chats shared with a group are charged to that group.
+	GroupId *int32 `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	// LLM provider and model to answer with. If not set, the configured ones are used.
+	Provider *string `protobuf:"bytes,4,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
+	Model    *string `protobuf:"bytes,5,opt,name=model,proto3,oneof" json:"model,omitempty"`
+	// Names of the tools GPT can use to answer. See ListGPTTools.
+	Tools []string `protobuf:"bytes,6,rep,name=tools,proto3" json:"tools,omitempty"`
+	// If set, the most relevant excerpts of the collection's documents are sent along with the message.
+	Retrieval *DocumentRetrieval `protobuf:"bytes,7,opt,name=retrieval,proto3" json:"retrieval,omitempty"`
 }
 
 func (x *ReplyToGPTChatRequest) Reset() {
@@ -234,13 +401,52 @@ func (x *ReplyToGPTChatRequest) GetMessage() string {
 	return ""
 }
 
+func (x *ReplyToGPTChatRequest) GetGroupId() int32 {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return 0
+}
+
+func (x *ReplyToGPTChatRequest) GetProvider() string {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ""
+}
+
+func (x *ReplyToGPTChatRequest) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
+func (x *ReplyToGPTChatRequest) GetTools() []string {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+func (x *ReplyToGPTChatRequest) GetRetrieval() *DocumentRetrieval {
+	if x != nil {
+		return x.Retrieval
+	}
+	return nil
+}
+
 type ReplyToGPTChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Chat       *GPTChatInfo `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
-	GptMessage string       `protobuf:"bytes,2,opt,name=gpt_message,json=gptMessage,proto3" json:"gpt_message,omitempty"`
+	Chat         *GPTChatInfo        `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+	GptMessage   string              `protobuf:"bytes,2,opt,name=gpt_message,json=gptMessage,proto3" json:"gpt_message,omitempty"`
+	Context      *GPTContextInfo     `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	ToolMessages []*GPTMessageInfo   `protobuf:"bytes,4,rep,name=tool_messages,json=toolMessages,proto3" json:"tool_messages,omitempty"` // The tools GPT called and what they returned, in order.
+	Cached       bool                `protobuf:"varint,5,opt,name=cached,proto3" json:"cached,omitempty"`                                // The answer was reused from an identical chat sent before.
+	Citations    []*DocumentCitation `protobuf:"bytes,6,rep,name=citations,proto3" json:"citations,omitempty"`                           // The excerpts sent with retrieval, GPT cites them by number.
 }
 
 func (x *ReplyToGPTChatResponse) Reset() {
@@ -289,17 +495,57 @@ func (x *ReplyToGPTChatResponse) GetGptMessage() string {
 	return ""
 }
 
-type NewGPTImageRequest struct {
+func (x *ReplyToGPTChatResponse) GetContext() *GPTContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ReplyToGPTChatResponse) GetToolMessages() []*GPTMessageInfo {
+	if x != nil {
+		return x.ToolMessages
+	}
+	return nil
+}
+
+func (x *ReplyToGPTChatResponse) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *ReplyToGPTChatResponse) GetCitations() []*DocumentCitation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
+type StreamGPTChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Size    GPTImageSize `protobuf:"varint,2,opt,name=size,proto3,enum=pbs.GPTImageSize" json:"size,omitempty"`
+	// If set, the message is a reply on this chat. If not, a new chat is started.
+	ChatId  *int32 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3,oneof" json:"chat_id,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// If set, the usage is charged to this group's budgets. The caller must be a member.
+	// If not, chats shared with a group are charged to that group.
+	GroupId *int32 `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	// LLM provider and model to answer with. If not set, the configured ones are used.
+	Provider *string `protobuf:"bytes,4,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
+	Model    *string `protobuf:"bytes,5,opt,name=model,proto3,oneof" json:"model,omitempty"`
+	// Only for new chats, replies use the ones the chat was started with.
+	Temperature     *float64          `protobuf:"fixed64,6,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	MaxTokens       *int32            `protobuf:"varint,7,opt,name=max_tokens,json=maxTokens,proto3,oneof" json:"max_tokens,omitempty"`
+	SystemPromptId  *int32            `protobuf:"varint,8,opt,name=system_prompt_id,json=systemPromptId,proto3,oneof" json:"system_prompt_id,omitempty"`                                                                                   // Without it, our default instructions are used.
+	PromptVariables map[string]string `protobuf:"bytes,9,rep,name=prompt_variables,json=promptVariables,proto3" json:"prompt_variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Rendered into the system prompt's template.
 }
 
-func (x *NewGPTImageRequest) Reset() {
-	*x = NewGPTImageRequest{}
+func (x *StreamGPTChatRequest) Reset() {
+	*x = StreamGPTChatRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gpt_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -307,13 +553,13 @@ func (x *NewGPTImageRequest) Reset() {
 	}
 }
 
-func (x *NewGPTImageRequest) String() string {
+func (x *StreamGPTChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewGPTImageRequest) ProtoMessage() {}
+func (*StreamGPTChatRequest) ProtoMessage() {}
 
-func (x *NewGPTImageRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamGPTChatRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gpt_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -325,36 +571,89 @@ func (x *NewGPTImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewGPTImageRequest.ProtoReflect.Descriptor instead.
-func (*NewGPTImageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamGPTChatRequest.ProtoReflect.Descriptor instead.
+func (*StreamGPTChatRequest) Descriptor() ([]byte, []int) {
 	return file_gpt_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *NewGPTImageRequest) GetMessage() string {
+func (x *StreamGPTChatRequest) GetChatId() int32 {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
+	}
+	return 0
+}
+
+func (x *StreamGPTChatRequest) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *NewGPTImageRequest) GetSize() GPTImageSize {
+func (x *StreamGPTChatRequest) GetGroupId() int32 {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return 0
+}
+
+func (x *StreamGPTChatRequest) GetProvider() string {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ""
+}
+
+func (x *StreamGPTChatRequest) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
+func (x *StreamGPTChatRequest) GetTemperature() float64 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *StreamGPTChatRequest) GetMaxTokens() int32 {
+	if x != nil && x.MaxTokens != nil {
+		return *x.MaxTokens
+	}
+	return 0
+}
+
+func (x *StreamGPTChatRequest) GetSystemPromptId() int32 {
+	if x != nil && x.SystemPromptId != nil {
+		return *x.SystemPromptId
+	}
+	return 0
+}
+
+func (x *StreamGPTChatRequest) GetPromptVariables() map[string]string {
 	if x != nil {
-		return x.Size
+		return x.PromptVariables
 	}
-	return GPTImageSize_DEFAULT
+	return nil
 }
 
-type NewGPTImageResponse struct {
+type StreamGPTChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Chat     *GPTChatInfo `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
-	ImageUrl string       `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	// Types that are assignable to Event:
+	//	*StreamGPTChatResponse_Chat
+	//	*StreamGPTChatResponse_Delta
+	//	*StreamGPTChatResponse_Message
+	//	*StreamGPTChatResponse_Context
+	Event isStreamGPTChatResponse_Event `protobuf_oneof:"event"`
 }
 
-func (x *NewGPTImageResponse) Reset() {
-	*x = NewGPTImageResponse{}
+func (x *StreamGPTChatResponse) Reset() {
+	*x = StreamGPTChatResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gpt_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -362,13 +661,13 @@ func (x *NewGPTImageResponse) Reset() {
 	}
 }
 
-func (x *NewGPTImageResponse) String() string {
+func (x *StreamGPTChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewGPTImageResponse) ProtoMessage() {}
+func (*StreamGPTChatResponse) ProtoMessage() {}
 
-func (x *NewGPTImageResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamGPTChatResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gpt_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -380,172 +679,6562 @@ func (x *NewGPTImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewGPTImageResponse.ProtoReflect.Descriptor instead.
-func (*NewGPTImageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamGPTChatResponse.ProtoReflect.Descriptor instead.
+func (*StreamGPTChatResponse) Descriptor() ([]byte, []int) {
 	return file_gpt_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *NewGPTImageResponse) GetChat() *GPTChatInfo {
-	if x != nil {
+func (m *StreamGPTChatResponse) GetEvent() isStreamGPTChatResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *StreamGPTChatResponse) GetChat() *GPTChatInfo {
+	if x, ok := x.GetEvent().(*StreamGPTChatResponse_Chat); ok {
 		return x.Chat
 	}
 	return nil
 }
 
-func (x *NewGPTImageResponse) GetImageUrl() string {
-	if x != nil {
-		return x.ImageUrl
+func (x *StreamGPTChatResponse) GetDelta() string {
+	if x, ok := x.GetEvent().(*StreamGPTChatResponse_Delta); ok {
+		return x.Delta
 	}
 	return ""
 }
 
-var File_gpt_proto protoreflect.FileDescriptor
+func (x *StreamGPTChatResponse) GetMessage() *GPTMessageInfo {
+	if x, ok := x.GetEvent().(*StreamGPTChatResponse_Message); ok {
+		return x.Message
+	}
+	return nil
+}
 
-var file_gpt_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x67, 0x70, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x70, 0x62, 0x73,
-	0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25,
-	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x37, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x32, 0x0a, 0x11, 0x4e, 0x65, 0x77, 0x47,
-	0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
-	0xe0, 0x41, 0x02, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x12,
-	0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x70, 0x74, 0x5f,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67,
-	0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x54, 0x0a, 0x15, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x54, 0x6f, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x5f, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x68, 0x61,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50,
-	0x54, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x67, 0x70, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x5a, 0x0a, 0x12, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x58, 0x0a, 0x13,
-	0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x2a, 0x44, 0x0a, 0x0c, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
-	0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x49, 0x44, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a,
-	0x04, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x49, 0x4e, 0x59, 0x10,
-	0x03, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x4d, 0x41, 0x4c, 0x4c, 0x10, 0x04, 0x32, 0xe6, 0x03, 0x0a,
-	0x0a, 0x47, 0x50, 0x54, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x0a,
-	0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x73,
-	0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x43,
-	0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x92, 0x41, 0x39,
-	0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x63,
-	0x68, 0x61, 0x74, 0x4a, 0x24, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x1d, 0x12, 0x1b, 0x0a, 0x19,
-	0x1a, 0x17, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x3a,
-	0x01, 0x2a, 0x22, 0x07, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x12, 0xac, 0x01, 0x0a, 0x0e,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x12, 0x1a,
-	0x2e, 0x70, 0x62, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x47, 0x50, 0x54, 0x43,
-	0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73,
-	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0x92, 0x41, 0x42, 0x0a, 0x03, 0x47, 0x50,
-	0x54, 0x2a, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x67, 0x70, 0x74, 0x5f,
-	0x63, 0x68, 0x61, 0x74, 0x4a, 0x28, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x21, 0x12, 0x1f, 0x0a,
-	0x1d, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x47,
-	0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74,
-	0x2f, 0x7b, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x0b, 0x4e,
-	0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x73,
-	0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x92,
-	0x41, 0x3f, 0x0a, 0x05, 0x44, 0x41, 0x4c, 0x4c, 0x45, 0x2a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x64,
-	0x61, 0x6c, 0x6c, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4a, 0x25, 0x0a, 0x03, 0x32, 0x30,
-	0x30, 0x12, 0x1e, 0x12, 0x1c, 0x0a, 0x1a, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4e, 0x65,
-	0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f,
-	0x64, 0x61, 0x6c, 0x6c, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x6c, 0x70, 0x65, 0x72, 0x6f, 0x70, 0x69, 0x6f, 0x6c, 0x61,
-	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2d, 0x69, 0x6d,
-	0x70, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x62, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *StreamGPTChatResponse) GetContext() *GPTContextInfo {
+	if x, ok := x.GetEvent().(*StreamGPTChatResponse_Context); ok {
+		return x.Context
+	}
+	return nil
 }
 
-var (
-	file_gpt_proto_rawDescOnce sync.Once
-	file_gpt_proto_rawDescData = file_gpt_proto_rawDesc
-)
+type isStreamGPTChatResponse_Event interface {
+	isStreamGPTChatResponse_Event()
+}
 
-func file_gpt_proto_rawDescGZIP() []byte {
-	file_gpt_proto_rawDescOnce.Do(func() {
-		file_gpt_proto_rawDescData = protoimpl.X.CompressGZIP(file_gpt_proto_rawDescData)
-	})
-	return file_gpt_proto_rawDescData
+type StreamGPTChatResponse_Chat struct {
+	Chat *GPTChatInfo `protobuf:"bytes,1,opt,name=chat,proto3,oneof"` // Always the first event. New chats send it again before the message, with their title.
 }
 
-var file_gpt_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_gpt_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_gpt_proto_goTypes = []interface{}{
-	(GPTImageSize)(0),              // 0: pbs.GPTImageSize
-	(*NewGPTChatRequest)(nil),      // 1: pbs.NewGPTChatRequest
-	(*NewGPTChatResponse)(nil),     // 2: pbs.NewGPTChatResponse
-	(*ReplyToGPTChatRequest)(nil),  // 3: pbs.ReplyToGPTChatRequest
-	(*ReplyToGPTChatResponse)(nil), // 4: pbs.ReplyToGPTChatResponse
-	(*NewGPTImageRequest)(nil),     // 5: pbs.NewGPTImageRequest
-	(*NewGPTImageResponse)(nil),    // 6: pbs.NewGPTImageResponse
-	(*GPTChatInfo)(nil),            // 7: pbs.GPTChatInfo
+type StreamGPTChatResponse_Delta struct {
+	Delta string `protobuf:"bytes,2,opt,name=delta,proto3,oneof"` // A piece of GPT's answer.
 }
-var file_gpt_proto_depIdxs = []int32{
-	7, // 0: pbs.NewGPTChatResponse.chat:type_name -> pbs.GPTChatInfo
-	7, // 1: pbs.ReplyToGPTChatResponse.chat:type_name -> pbs.GPTChatInfo
-	0, // 2: pbs.NewGPTImageRequest.size:type_name -> pbs.GPTImageSize
-	7, // 3: pbs.NewGPTImageResponse.chat:type_name -> pbs.GPTChatInfo
-	1, // 4: pbs.GPTService.NewGPTChat:input_type -> pbs.NewGPTChatRequest
-	3, // 5: pbs.GPTService.ReplyToGPTChat:input_type -> pbs.ReplyToGPTChatRequest
-	5, // 6: pbs.GPTService.NewGPTImage:input_type -> pbs.NewGPTImageRequest
-	2, // 7: pbs.GPTService.NewGPTChat:output_type -> pbs.NewGPTChatResponse
-	4, // 8: pbs.GPTService.ReplyToGPTChat:output_type -> pbs.ReplyToGPTChatResponse
-	6, // 9: pbs.GPTService.NewGPTImage:output_type -> pbs.NewGPTImageResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+
+type StreamGPTChatResponse_Message struct {
+	Message *GPTMessageInfo `protobuf:"bytes,3,opt,name=message,proto3,oneof"` // Always the last event, it's GPT's whole answer as it was stored.
 }
 
-func init() { file_gpt_proto_init() }
-func file_gpt_proto_init() {
-	if File_gpt_proto != nil {
-		return
+type StreamGPTChatResponse_Context struct {
+	Context *GPTContextInfo `protobuf:"bytes,4,opt,name=context,proto3,oneof"` // Right after the chat.
+}
+
+func (*StreamGPTChatResponse_Chat) isStreamGPTChatResponse_Event() {}
+
+func (*StreamGPTChatResponse_Delta) isStreamGPTChatResponse_Event() {}
+
+func (*StreamGPTChatResponse_Message) isStreamGPTChatResponse_Event() {}
+
+func (*StreamGPTChatResponse_Context) isStreamGPTChatResponse_Event() {}
+
+type RegenerateGPTReplyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId int32 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	// Same as on ReplyToGPTChat.
+	GroupId  *int32   `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	Provider *string  `protobuf:"bytes,3,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
+	Model    *string  `protobuf:"bytes,4,opt,name=model,proto3,oneof" json:"model,omitempty"`
+	Tools    []string `protobuf:"bytes,5,rep,name=tools,proto3" json:"tools,omitempty"`
+}
+
+func (x *RegenerateGPTReplyRequest) Reset() {
+	*x = RegenerateGPTReplyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	file_common_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_gpt_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewGPTChatRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *RegenerateGPTReplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateGPTReplyRequest) ProtoMessage() {}
+
+func (x *RegenerateGPTReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateGPTReplyRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateGPTReplyRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RegenerateGPTReplyRequest) GetChatId() int32 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *RegenerateGPTReplyRequest) GetGroupId() int32 {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return 0
+}
+
+func (x *RegenerateGPTReplyRequest) GetProvider() string {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ""
+}
+
+func (x *RegenerateGPTReplyRequest) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
+func (x *RegenerateGPTReplyRequest) GetTools() []string {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+type RegenerateGPTReplyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chat         *GPTChatInfo      `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+	GptMessage   string            `protobuf:"bytes,2,opt,name=gpt_message,json=gptMessage,proto3" json:"gpt_message,omitempty"`
+	Context      *GPTContextInfo   `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	ToolMessages []*GPTMessageInfo `protobuf:"bytes,4,rep,name=tool_messages,json=toolMessages,proto3" json:"tool_messages,omitempty"`
+	Cached       bool              `protobuf:"varint,5,opt,name=cached,proto3" json:"cached,omitempty"`
+	Messages     []*GPTMessageInfo `protobuf:"bytes,6,rep,name=messages,proto3" json:"messages,omitempty"` // The chat's new branch, like on GetGPTChat.
+}
+
+func (x *RegenerateGPTReplyResponse) Reset() {
+	*x = RegenerateGPTReplyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegenerateGPTReplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateGPTReplyResponse) ProtoMessage() {}
+
+func (x *RegenerateGPTReplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateGPTReplyResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateGPTReplyResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RegenerateGPTReplyResponse) GetChat() *GPTChatInfo {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *RegenerateGPTReplyResponse) GetGptMessage() string {
+	if x != nil {
+		return x.GptMessage
+	}
+	return ""
+}
+
+func (x *RegenerateGPTReplyResponse) GetContext() *GPTContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *RegenerateGPTReplyResponse) GetToolMessages() []*GPTMessageInfo {
+	if x != nil {
+		return x.ToolMessages
+	}
+	return nil
+}
+
+func (x *RegenerateGPTReplyResponse) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *RegenerateGPTReplyResponse) GetMessages() []*GPTMessageInfo {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type EditGPTMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId    int32  `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	MessageId int32  `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Same as on ReplyToGPTChat.
+	GroupId  *int32   `protobuf:"varint,4,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	Provider *string  `protobuf:"bytes,5,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
+	Model    *string  `protobuf:"bytes,6,opt,name=model,proto3,oneof" json:"model,omitempty"`
+	Tools    []string `protobuf:"bytes,7,rep,name=tools,proto3" json:"tools,omitempty"`
+}
+
+func (x *EditGPTMessageRequest) Reset() {
+	*x = EditGPTMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditGPTMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditGPTMessageRequest) ProtoMessage() {}
+
+func (x *EditGPTMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditGPTMessageRequest.ProtoReflect.Descriptor instead.
+func (*EditGPTMessageRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EditGPTMessageRequest) GetChatId() int32 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *EditGPTMessageRequest) GetMessageId() int32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *EditGPTMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EditGPTMessageRequest) GetGroupId() int32 {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return 0
+}
+
+func (x *EditGPTMessageRequest) GetProvider() string {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ""
+}
+
+func (x *EditGPTMessageRequest) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
+func (x *EditGPTMessageRequest) GetTools() []string {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+type EditGPTMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chat         *GPTChatInfo      `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+	GptMessage   string            `protobuf:"bytes,2,opt,name=gpt_message,json=gptMessage,proto3" json:"gpt_message,omitempty"`
+	Context      *GPTContextInfo   `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	ToolMessages []*GPTMessageInfo `protobuf:"bytes,4,rep,name=tool_messages,json=toolMessages,proto3" json:"tool_messages,omitempty"`
+	Cached       bool              `protobuf:"varint,5,opt,name=cached,proto3" json:"cached,omitempty"`
+	Messages     []*GPTMessageInfo `protobuf:"bytes,6,rep,name=messages,proto3" json:"messages,omitempty"` // The chat's new branch, like on GetGPTChat.
+}
+
+func (x *EditGPTMessageResponse) Reset() {
+	*x = EditGPTMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditGPTMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditGPTMessageResponse) ProtoMessage() {}
+
+func (x *EditGPTMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditGPTMessageResponse.ProtoReflect.Descriptor instead.
+func (*EditGPTMessageResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EditGPTMessageResponse) GetChat() *GPTChatInfo {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *EditGPTMessageResponse) GetGptMessage() string {
+	if x != nil {
+		return x.GptMessage
+	}
+	return ""
+}
+
+func (x *EditGPTMessageResponse) GetContext() *GPTContextInfo {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *EditGPTMessageResponse) GetToolMessages() []*GPTMessageInfo {
+	if x != nil {
+		return x.ToolMessages
+	}
+	return nil
+}
+
+func (x *EditGPTMessageResponse) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *EditGPTMessageResponse) GetMessages() []*GPTMessageInfo {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type SwitchGPTChatBranchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId    int32 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	MessageId int32 `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (x *SwitchGPTChatBranchRequest) Reset() {
+	*x = SwitchGPTChatBranchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwitchGPTChatBranchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchGPTChatBranchRequest) ProtoMessage() {}
+
+func (x *SwitchGPTChatBranchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchGPTChatBranchRequest.ProtoReflect.Descriptor instead.
+func (*SwitchGPTChatBranchRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SwitchGPTChatBranchRequest) GetChatId() int32 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *SwitchGPTChatBranchRequest) GetMessageId() int32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+type SwitchGPTChatBranchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chat     *GPTChatInfo      `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+	Messages []*GPTMessageInfo `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *SwitchGPTChatBranchResponse) Reset() {
+	*x = SwitchGPTChatBranchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwitchGPTChatBranchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchGPTChatBranchResponse) ProtoMessage() {}
+
+func (x *SwitchGPTChatBranchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchGPTChatBranchResponse.ProtoReflect.Descriptor instead.
+func (*SwitchGPTChatBranchResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SwitchGPTChatBranchResponse) GetChat() *GPTChatInfo {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *SwitchGPTChatBranchResponse) GetMessages() []*GPTMessageInfo {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// How the chat was fit in the model's context window. Token counts are estimates.
+// When it doesn't fit, older messages stop being sent. If they could be summarized,
+// their summary is sent instead and stored in the chat.
+type GPTContextInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Model           string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	ContextTokens   int32  `protobuf:"varint,2,opt,name=context_tokens,json=contextTokens,proto3" json:"context_tokens,omitempty"`       // The model's context window.
+	PromptTokens    int32  `protobuf:"varint,3,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`          // What was sent, with the system prompt and the summary.
+	Truncated       bool   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`                                    // Some of the chat's messages weren't sent.
+	DroppedMessages int32  `protobuf:"varint,5,opt,name=dropped_messages,json=droppedMessages,proto3" json:"dropped_messages,omitempty"` // How many, in this call.
+	Summarized      bool   `protobuf:"varint,6,opt,name=summarized,proto3" json:"summarized,omitempty"`                                  // They were added to the chat's summary.
+}
+
+func (x *GPTContextInfo) Reset() {
+	*x = GPTContextInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPTContextInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPTContextInfo) ProtoMessage() {}
+
+func (x *GPTContextInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPTContextInfo.ProtoReflect.Descriptor instead.
+func (*GPTContextInfo) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GPTContextInfo) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *GPTContextInfo) GetContextTokens() int32 {
+	if x != nil {
+		return x.ContextTokens
+	}
+	return 0
+}
+
+func (x *GPTContextInfo) GetPromptTokens() int32 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *GPTContextInfo) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *GPTContextInfo) GetDroppedMessages() int32 {
+	if x != nil {
+		return x.DroppedMessages
+	}
+	return 0
+}
+
+func (x *GPTContextInfo) GetSummarized() bool {
+	if x != nil {
+		return x.Summarized
+	}
+	return false
+}
+
+// Tools are our own functions that GPT can ask us to run while answering. They run as the caller,
+// and each call and its result are stored on the chat as messages from tool_call and tool.
+// Streamed answers can't use tools.
+type GPTToolInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Parameters  string `protobuf:"bytes,3,opt,name=parameters,proto3" json:"parameters,omitempty"` // JSON schema of the arguments object.
+}
+
+func (x *GPTToolInfo) Reset() {
+	*x = GPTToolInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPTToolInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPTToolInfo) ProtoMessage() {}
+
+func (x *GPTToolInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPTToolInfo.ProtoReflect.Descriptor instead.
+func (*GPTToolInfo) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GPTToolInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GPTToolInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GPTToolInfo) GetParameters() string {
+	if x != nil {
+		return x.Parameters
+	}
+	return ""
+}
+
+type ListGPTToolsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListGPTToolsRequest) Reset() {
+	*x = ListGPTToolsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGPTToolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGPTToolsRequest) ProtoMessage() {}
+
+func (x *ListGPTToolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGPTToolsRequest.ProtoReflect.Descriptor instead.
+func (*ListGPTToolsRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{14}
+}
+
+type ListGPTToolsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tools []*GPTToolInfo `protobuf:"bytes,1,rep,name=tools,proto3" json:"tools,omitempty"`
+}
+
+func (x *ListGPTToolsResponse) Reset() {
+	*x = ListGPTToolsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGPTToolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGPTToolsResponse) ProtoMessage() {}
+
+func (x *ListGPTToolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGPTToolsResponse.ProtoReflect.Descriptor instead.
+func (*ListGPTToolsResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListGPTToolsResponse) GetTools() []*GPTToolInfo {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+type ListMyGPTChatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+}
+
+func (x *ListMyGPTChatsRequest) Reset() {
+	*x = ListMyGPTChatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyGPTChatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyGPTChatsRequest) ProtoMessage() {}
+
+func (x *ListMyGPTChatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyGPTChatsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyGPTChatsRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListMyGPTChatsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListMyGPTChatsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListMyGPTChatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chats      []*GPTChatInfo  `protobuf:"bytes,1,rep,name=chats,proto3" json:"chats,omitempty"`
+	Pagination *PaginationInfo `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListMyGPTChatsResponse) Reset() {
+	*x = ListMyGPTChatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyGPTChatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyGPTChatsResponse) ProtoMessage() {}
+
+func (x *ListMyGPTChatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyGPTChatsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyGPTChatsResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListMyGPTChatsResponse) GetChats() []*GPTChatInfo {
+	if x != nil {
+		return x.Chats
+	}
+	return nil
+}
+
+func (x *ListMyGPTChatsResponse) GetPagination() *PaginationInfo {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type RenameGPTChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId int32  `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Title  string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *RenameGPTChatRequest) Reset() {
+	*x = RenameGPTChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameGPTChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameGPTChatRequest) ProtoMessage() {}
+
+func (x *RenameGPTChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameGPTChatRequest.ProtoReflect.Descriptor instead.
+func (*RenameGPTChatRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RenameGPTChatRequest) GetChatId() int32 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *RenameGPTChatRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type RenameGPTChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chat *GPTChatInfo `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+}
+
+func (x *RenameGPTChatResponse) Reset() {
+	*x = RenameGPTChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameGPTChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameGPTChatResponse) ProtoMessage() {}
+
+func (x *RenameGPTChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameGPTChatResponse.ProtoReflect.Descriptor instead.
+func (*RenameGPTChatResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RenameGPTChatResponse) GetChat() *GPTChatInfo {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+type DeleteGPTChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId int32 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+}
+
+func (x *DeleteGPTChatRequest) Reset() {
+	*x = DeleteGPTChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGPTChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGPTChatRequest) ProtoMessage() {}
+
+func (x *DeleteGPTChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGPTChatRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGPTChatRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteGPTChatRequest) GetChatId() int32 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+type DeleteGPTChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId int32 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+}
+
+func (x *DeleteGPTChatResponse) Reset() {
+	*x = DeleteGPTChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGPTChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGPTChatResponse) ProtoMessage() {}
+
+func (x *DeleteGPTChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGPTChatResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGPTChatResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteGPTChatResponse) GetChatId() int32 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+type GetGPTChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId int32 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+}
+
+func (x *GetGPTChatRequest) Reset() {
+	*x = GetGPTChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGPTChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGPTChatRequest) ProtoMessage() {}
+
+func (x *GetGPTChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGPTChatRequest.ProtoReflect.Descriptor instead.
+func (*GetGPTChatRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetGPTChatRequest) GetChatId() int32 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+type GetGPTChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chat     *GPTChatInfo      `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+	Messages []*GPTMessageInfo `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *GetGPTChatResponse) Reset() {
+	*x = GetGPTChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGPTChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGPTChatResponse) ProtoMessage() {}
+
+func (x *GetGPTChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGPTChatResponse.ProtoReflect.Descriptor instead.
+func (*GetGPTChatResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetGPTChatResponse) GetChat() *GPTChatInfo {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *GetGPTChatResponse) GetMessages() []*GPTMessageInfo {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type ExportGPTChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId int32  `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // Defaults to markdown.
+}
+
+func (x *ExportGPTChatRequest) Reset() {
+	*x = ExportGPTChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportGPTChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGPTChatRequest) ProtoMessage() {}
+
+func (x *ExportGPTChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGPTChatRequest.ProtoReflect.Descriptor instead.
+func (*ExportGPTChatRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportGPTChatRequest) GetChatId() int32 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *ExportGPTChatRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportMyGPTChatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // Defaults to markdown.
+}
+
+func (x *ExportMyGPTChatsRequest) Reset() {
+	*x = ExportMyGPTChatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportMyGPTChatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyGPTChatsRequest) ProtoMessage() {}
+
+func (x *ExportMyGPTChatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyGPTChatsRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyGPTChatsRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExportMyGPTChatsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ShareGPTChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId        int32 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	GroupId       int32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupCanReply bool  `protobuf:"varint,3,opt,name=group_can_reply,json=groupCanReply,proto3" json:"group_can_reply,omitempty"`
+}
+
+func (x *ShareGPTChatRequest) Reset() {
+	*x = ShareGPTChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareGPTChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareGPTChatRequest) ProtoMessage() {}
+
+func (x *ShareGPTChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareGPTChatRequest.ProtoReflect.Descriptor instead.
+func (*ShareGPTChatRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ShareGPTChatRequest) GetChatId() int32 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *ShareGPTChatRequest) GetGroupId() int32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ShareGPTChatRequest) GetGroupCanReply() bool {
+	if x != nil {
+		return x.GroupCanReply
+	}
+	return false
+}
+
+type ShareGPTChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chat *GPTChatInfo `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+}
+
+func (x *ShareGPTChatResponse) Reset() {
+	*x = ShareGPTChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareGPTChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareGPTChatResponse) ProtoMessage() {}
+
+func (x *ShareGPTChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareGPTChatResponse.ProtoReflect.Descriptor instead.
+func (*ShareGPTChatResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ShareGPTChatResponse) GetChat() *GPTChatInfo {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+type UnshareGPTChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId int32 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+}
+
+func (x *UnshareGPTChatRequest) Reset() {
+	*x = UnshareGPTChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnshareGPTChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareGPTChatRequest) ProtoMessage() {}
+
+func (x *UnshareGPTChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareGPTChatRequest.ProtoReflect.Descriptor instead.
+func (*UnshareGPTChatRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnshareGPTChatRequest) GetChatId() int32 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+type UnshareGPTChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chat *GPTChatInfo `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+}
+
+func (x *UnshareGPTChatResponse) Reset() {
+	*x = UnshareGPTChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnshareGPTChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareGPTChatResponse) ProtoMessage() {}
+
+func (x *UnshareGPTChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareGPTChatResponse.ProtoReflect.Descriptor instead.
+func (*UnshareGPTChatResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnshareGPTChatResponse) GetChat() *GPTChatInfo {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+type ListGroupChatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId  int32  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Page     *int32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+}
+
+func (x *ListGroupChatsRequest) Reset() {
+	*x = ListGroupChatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupChatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupChatsRequest) ProtoMessage() {}
+
+func (x *ListGroupChatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupChatsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupChatsRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListGroupChatsRequest) GetGroupId() int32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ListGroupChatsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListGroupChatsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListGroupChatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chats      []*GPTChatInfo  `protobuf:"bytes,1,rep,name=chats,proto3" json:"chats,omitempty"`
+	Pagination *PaginationInfo `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListGroupChatsResponse) Reset() {
+	*x = ListGroupChatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupChatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupChatsResponse) ProtoMessage() {}
+
+func (x *ListGroupChatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupChatsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupChatsResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListGroupChatsResponse) GetChats() []*GPTChatInfo {
+	if x != nil {
+		return x.Chats
+	}
+	return nil
+}
+
+func (x *ListGroupChatsResponse) GetPagination() *PaginationInfo {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type NewGPTImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Size    GPTImageSize `protobuf:"varint,2,opt,name=size,proto3,enum=pbs.GPTImageSize" json:"size,omitempty"`
+	// If set, the image is charged to this group's budgets. The caller must be a member.
+	GroupId *int32 `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+	// If set, the image is made in the background. The response comes right away with the chat and the operation,
+	// and the image is added to the chat when it's done. See GetGPTOperation.
+	Async bool `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`
+}
+
+func (x *NewGPTImageRequest) Reset() {
+	*x = NewGPTImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewGPTImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewGPTImageRequest) ProtoMessage() {}
+
+func (x *NewGPTImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewGPTImageRequest.ProtoReflect.Descriptor instead.
+func (*NewGPTImageRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *NewGPTImageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NewGPTImageRequest) GetSize() GPTImageSize {
+	if x != nil {
+		return x.Size
+	}
+	return GPTImageSize_DEFAULT
+}
+
+func (x *NewGPTImageRequest) GetGroupId() int32 {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return 0
+}
+
+func (x *NewGPTImageRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type NewGPTImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chat      *GPTChatInfo  `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+	ImageUrl  string        `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"` // Ours, see GetGPTImage. DALL-E's own URL expires in a couple of hours.
+	Image     *GPTMediaInfo `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Operation *GPTOperation `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"` // Only on async requests, which don't have an image yet.
+}
+
+func (x *NewGPTImageResponse) Reset() {
+	*x = NewGPTImageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewGPTImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewGPTImageResponse) ProtoMessage() {}
+
+func (x *NewGPTImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewGPTImageResponse.ProtoReflect.Descriptor instead.
+func (*NewGPTImageResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *NewGPTImageResponse) GetChat() *GPTChatInfo {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *NewGPTImageResponse) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *NewGPTImageResponse) GetImage() *GPTMediaInfo {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *NewGPTImageResponse) GetOperation() *GPTOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+// An image being made in the background, like google.longrunning.Operation.
+// When it's done, it has either an error or a response.
+type GPTOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // operations/{operation_id}
+	Done     bool                  `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	Metadata *GPTOperationMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Types that are assignable to Result:
+	//	*GPTOperation_Error
+	//	*GPTOperation_Response
+	Result isGPTOperation_Result `protobuf_oneof:"result"`
+}
+
+func (x *GPTOperation) Reset() {
+	*x = GPTOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPTOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPTOperation) ProtoMessage() {}
+
+func (x *GPTOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPTOperation.ProtoReflect.Descriptor instead.
+func (*GPTOperation) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GPTOperation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GPTOperation) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *GPTOperation) GetMetadata() *GPTOperationMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (m *GPTOperation) GetResult() isGPTOperation_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *GPTOperation) GetError() *GPTOperationError {
+	if x, ok := x.GetResult().(*GPTOperation_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GPTOperation) GetResponse() *NewGPTImageResponse {
+	if x, ok := x.GetResult().(*GPTOperation_Response); ok {
+		return x.Response
+	}
+	return nil
+}
+
+type isGPTOperation_Result interface {
+	isGPTOperation_Result()
+}
+
+type GPTOperation_Error struct {
+	Error *GPTOperationError `protobuf:"bytes,4,opt,name=error,proto3,oneof"`
+}
+
+type GPTOperation_Response struct {
+	Response *NewGPTImageResponse `protobuf:"bytes,5,opt,name=response,proto3,oneof"`
+}
+
+func (*GPTOperation_Error) isGPTOperation_Result() {}
+
+func (*GPTOperation_Response) isGPTOperation_Result() {}
+
+type GPTOperationMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId   string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	State         string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`                  // queued, running, succeeded, failed or cancelled.
+	Attempts      int32  `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`           // Failed calls to DALL-E are retried a few times, waiting longer each time.
+	ChatId        int32  `protobuf:"varint,4,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"` // Where the image goes.
+	CreatedAt     string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	NextAttemptAt string `protobuf:"bytes,7,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"` // Only when queued.
+}
+
+func (x *GPTOperationMetadata) Reset() {
+	*x = GPTOperationMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPTOperationMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPTOperationMetadata) ProtoMessage() {}
+
+func (x *GPTOperationMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPTOperationMetadata.ProtoReflect.Descriptor instead.
+func (*GPTOperationMetadata) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GPTOperationMetadata) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *GPTOperationMetadata) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *GPTOperationMetadata) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *GPTOperationMetadata) GetChatId() int32 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *GPTOperationMetadata) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GPTOperationMetadata) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *GPTOperationMetadata) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+type GPTOperationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"` // A google.rpc.Code.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *GPTOperationError) Reset() {
+	*x = GPTOperationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPTOperationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPTOperationError) ProtoMessage() {}
+
+func (x *GPTOperationError) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPTOperationError.ProtoReflect.Descriptor instead.
+func (*GPTOperationError) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GPTOperationError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GPTOperationError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetGPTOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *GetGPTOperationRequest) Reset() {
+	*x = GetGPTOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGPTOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGPTOperationRequest) ProtoMessage() {}
+
+func (x *GetGPTOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGPTOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetGPTOperationRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetGPTOperationRequest) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type WaitGPTOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId    string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	TimeoutSeconds int32  `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // Defaults to 30.
+}
+
+func (x *WaitGPTOperationRequest) Reset() {
+	*x = WaitGPTOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitGPTOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitGPTOperationRequest) ProtoMessage() {}
+
+func (x *WaitGPTOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitGPTOperationRequest.ProtoReflect.Descriptor instead.
+func (*WaitGPTOperationRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WaitGPTOperationRequest) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *WaitGPTOperationRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type CancelGPTOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *CancelGPTOperationRequest) Reset() {
+	*x = CancelGPTOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelGPTOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelGPTOperationRequest) ProtoMessage() {}
+
+func (x *CancelGPTOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelGPTOperationRequest.ProtoReflect.Descriptor instead.
+func (*CancelGPTOperationRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CancelGPTOperationRequest) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type ImageBatchVariable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *ImageBatchVariable) Reset() {
+	*x = ImageBatchVariable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageBatchVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageBatchVariable) ProtoMessage() {}
+
+func (x *ImageBatchVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageBatchVariable.ProtoReflect.Descriptor instead.
+func (*ImageBatchVariable) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ImageBatchVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImageBatchVariable) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type CreateImageBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Variables go between {{ }} with a dot, like "an illustration of a {{.mood}} {{.who}}, in ball-point-pen art style".
+	Template   string                `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Variables  []*ImageBatchVariable `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
+	Mode       ImageBatchMode        `protobuf:"varint,3,opt,name=mode,proto3,enum=pbs.ImageBatchMode" json:"mode,omitempty"`
+	SampleSize int32                 `protobuf:"varint,4,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"` // Required on SAMPLE.
+	Size       GPTImageSize          `protobuf:"varint,5,opt,name=size,proto3,enum=pbs.GPTImageSize" json:"size,omitempty"`
+	// If set, the images are charged to this group's budgets. The caller must be a member.
+	GroupId *int32 `protobuf:"varint,6,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+}
+
+func (x *CreateImageBatchRequest) Reset() {
+	*x = CreateImageBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateImageBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateImageBatchRequest) ProtoMessage() {}
+
+func (x *CreateImageBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateImageBatchRequest.ProtoReflect.Descriptor instead.
+func (*CreateImageBatchRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateImageBatchRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *CreateImageBatchRequest) GetVariables() []*ImageBatchVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *CreateImageBatchRequest) GetMode() ImageBatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ImageBatchMode_IMAGE_BATCH_MODE_PRODUCT
+}
+
+func (x *CreateImageBatchRequest) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+func (x *CreateImageBatchRequest) GetSize() GPTImageSize {
+	if x != nil {
+		return x.Size
+	}
+	return GPTImageSize_DEFAULT
+}
+
+func (x *CreateImageBatchRequest) GetGroupId() int32 {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return 0
+}
+
+type ImageBatchInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Chat      *GPTChatInfo        `protobuf:"bytes,2,opt,name=chat,proto3" json:"chat,omitempty"`
+	Template  string              `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	Mode      string              `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"` // product or sample.
+	Progress  *ImageBatchProgress `protobuf:"bytes,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	Items     []*ImageBatchItem   `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt string              `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ImageBatchInfo) Reset() {
+	*x = ImageBatchInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageBatchInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageBatchInfo) ProtoMessage() {}
+
+func (x *ImageBatchInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageBatchInfo.ProtoReflect.Descriptor instead.
+func (*ImageBatchInfo) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ImageBatchInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImageBatchInfo) GetChat() *GPTChatInfo {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *ImageBatchInfo) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *ImageBatchInfo) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ImageBatchInfo) GetProgress() *ImageBatchProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *ImageBatchInfo) GetItems() []*ImageBatchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ImageBatchInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ImageBatchProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       int32  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Queued      int32  `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
+	Running     int32  `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	Succeeded   int32  `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed      int32  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Cancelled   int32  `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Done        bool   `protobuf:"varint,7,opt,name=done,proto3" json:"done,omitempty"`
+	NextImageAt string `protobuf:"bytes,8,opt,name=next_image_at,json=nextImageAt,proto3" json:"next_image_at,omitempty"` // When the next queued image is due, if there's any.
+}
+
+func (x *ImageBatchProgress) Reset() {
+	*x = ImageBatchProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageBatchProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageBatchProgress) ProtoMessage() {}
+
+func (x *ImageBatchProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageBatchProgress.ProtoReflect.Descriptor instead.
+func (*ImageBatchProgress) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ImageBatchProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImageBatchProgress) GetQueued() int32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *ImageBatchProgress) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *ImageBatchProgress) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *ImageBatchProgress) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImageBatchProgress) GetCancelled() int32 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *ImageBatchProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ImageBatchProgress) GetNextImageAt() string {
+	if x != nil {
+		return x.NextImageAt
+	}
+	return ""
+}
+
+type ImageBatchItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index     int32             `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Prompt    string            `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Variables map[string]string `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Operation *GPTOperation     `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`               // Without its response, see image_url.
+	ImageUrl  string            `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"` // Only when it succeeded.
+}
+
+func (x *ImageBatchItem) Reset() {
+	*x = ImageBatchItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageBatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageBatchItem) ProtoMessage() {}
+
+func (x *ImageBatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageBatchItem.ProtoReflect.Descriptor instead.
+func (*ImageBatchItem) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ImageBatchItem) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ImageBatchItem) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *ImageBatchItem) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *ImageBatchItem) GetOperation() *GPTOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *ImageBatchItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+type GetImageBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchId int32 `protobuf:"varint,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+}
+
+func (x *GetImageBatchRequest) Reset() {
+	*x = GetImageBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetImageBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImageBatchRequest) ProtoMessage() {}
+
+func (x *GetImageBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImageBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetImageBatchRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetImageBatchRequest) GetBatchId() int32 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+type CancelImageBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchId int32 `protobuf:"varint,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+}
+
+func (x *CancelImageBatchRequest) Reset() {
+	*x = CancelImageBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelImageBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelImageBatchRequest) ProtoMessage() {}
+
+func (x *CancelImageBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelImageBatchRequest.ProtoReflect.Descriptor instead.
+func (*CancelImageBatchRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CancelImageBatchRequest) GetBatchId() int32 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+type DownloadImageBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchId int32 `protobuf:"varint,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+}
+
+func (x *DownloadImageBatchRequest) Reset() {
+	*x = DownloadImageBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadImageBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadImageBatchRequest) ProtoMessage() {}
+
+func (x *DownloadImageBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadImageBatchRequest.ProtoReflect.Descriptor instead.
+func (*DownloadImageBatchRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DownloadImageBatchRequest) GetBatchId() int32 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+// An image to edit or vary. DALL-E wants them square, and they're sent as PNG, which must be under 4 MB.
+type GPTImageSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Source:
+	//	*GPTImageSource_MediaId
+	//	*GPTImageSource_Upload
+	//	*GPTImageSource_Base64
+	Source isGPTImageSource_Source `protobuf_oneof:"source"`
+}
+
+func (x *GPTImageSource) Reset() {
+	*x = GPTImageSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPTImageSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPTImageSource) ProtoMessage() {}
+
+func (x *GPTImageSource) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPTImageSource.ProtoReflect.Descriptor instead.
+func (*GPTImageSource) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{48}
+}
+
+func (m *GPTImageSource) GetSource() isGPTImageSource_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *GPTImageSource) GetMediaId() int32 {
+	if x, ok := x.GetSource().(*GPTImageSource_MediaId); ok {
+		return x.MediaId
+	}
+	return 0
+}
+
+func (x *GPTImageSource) GetUpload() []byte {
+	if x, ok := x.GetSource().(*GPTImageSource_Upload); ok {
+		return x.Upload
+	}
+	return nil
+}
+
+func (x *GPTImageSource) GetBase64() string {
+	if x, ok := x.GetSource().(*GPTImageSource_Base64); ok {
+		return x.Base64
+	}
+	return ""
+}
+
+type isGPTImageSource_Source interface {
+	isGPTImageSource_Source()
+}
+
+type GPTImageSource_MediaId struct {
+	MediaId int32 `protobuf:"varint,1,opt,name=media_id,json=mediaId,proto3,oneof"` // An image we stored, from a chat the caller can see.
+}
+
+type GPTImageSource_Upload struct {
+	Upload []byte `protobuf:"bytes,2,opt,name=upload,proto3,oneof"` // The file as it is. On JSON it's base64 as well.
+}
+
+type GPTImageSource_Base64 struct {
+	Base64 string `protobuf:"bytes,3,opt,name=base64,proto3,oneof"` // Plain base64 or a data URL.
+}
+
+func (*GPTImageSource_MediaId) isGPTImageSource_Source() {}
+
+func (*GPTImageSource_Upload) isGPTImageSource_Source() {}
+
+func (*GPTImageSource_Base64) isGPTImageSource_Source() {}
+
+type EditGPTImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image  *GPTImageSource `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Mask   *GPTImageSource `protobuf:"bytes,2,opt,name=mask,proto3" json:"mask,omitempty"` // Same size as the image.
+	Prompt string          `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Size   GPTImageSize    `protobuf:"varint,4,opt,name=size,proto3,enum=pbs.GPTImageSize" json:"size,omitempty"` // DALL-E 2 can't make WIDE nor TALL images.
+	// The chat the result goes to. The caller must be able to reply to it.
+	// If not set, it's the source image's chat, or a new one for uploads.
+	ChatId  *int32 `protobuf:"varint,5,opt,name=chat_id,json=chatId,proto3,oneof" json:"chat_id,omitempty"`
+	GroupId *int32 `protobuf:"varint,6,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+}
+
+func (x *EditGPTImageRequest) Reset() {
+	*x = EditGPTImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditGPTImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditGPTImageRequest) ProtoMessage() {}
+
+func (x *EditGPTImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditGPTImageRequest.ProtoReflect.Descriptor instead.
+func (*EditGPTImageRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *EditGPTImageRequest) GetImage() *GPTImageSource {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *EditGPTImageRequest) GetMask() *GPTImageSource {
+	if x != nil {
+		return x.Mask
+	}
+	return nil
+}
+
+func (x *EditGPTImageRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *EditGPTImageRequest) GetSize() GPTImageSize {
+	if x != nil {
+		return x.Size
+	}
+	return GPTImageSize_DEFAULT
+}
+
+func (x *EditGPTImageRequest) GetChatId() int32 {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
+	}
+	return 0
+}
+
+func (x *EditGPTImageRequest) GetGroupId() int32 {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return 0
+}
+
+type NewGPTImageVariationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image   *GPTImageSource `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Size    GPTImageSize    `protobuf:"varint,2,opt,name=size,proto3,enum=pbs.GPTImageSize" json:"size,omitempty"`
+	ChatId  *int32          `protobuf:"varint,3,opt,name=chat_id,json=chatId,proto3,oneof" json:"chat_id,omitempty"`
+	GroupId *int32          `protobuf:"varint,4,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"`
+}
+
+func (x *NewGPTImageVariationRequest) Reset() {
+	*x = NewGPTImageVariationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewGPTImageVariationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewGPTImageVariationRequest) ProtoMessage() {}
+
+func (x *NewGPTImageVariationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewGPTImageVariationRequest.ProtoReflect.Descriptor instead.
+func (*NewGPTImageVariationRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *NewGPTImageVariationRequest) GetImage() *GPTImageSource {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *NewGPTImageVariationRequest) GetSize() GPTImageSize {
+	if x != nil {
+		return x.Size
+	}
+	return GPTImageSize_DEFAULT
+}
+
+func (x *NewGPTImageVariationRequest) GetChatId() int32 {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
+	}
+	return 0
+}
+
+func (x *NewGPTImageVariationRequest) GetGroupId() int32 {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return 0
+}
+
+type GetGPTImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MediaId int32 `protobuf:"varint,1,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+}
+
+func (x *GetGPTImageRequest) Reset() {
+	*x = GetGPTImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGPTImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGPTImageRequest) ProtoMessage() {}
+
+func (x *GetGPTImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGPTImageRequest.ProtoReflect.Descriptor instead.
+func (*GetGPTImageRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetGPTImageRequest) GetMediaId() int32 {
+	if x != nil {
+		return x.MediaId
+	}
+	return 0
+}
+
+type GetUsageReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Days as YYYY-MM-DD, both inclusive. They default to the current month.
+	From   *string `protobuf:"bytes,1,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To     *string `protobuf:"bytes,2,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	UserId *int32  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Model  *string `protobuf:"bytes,4,opt,name=model,proto3,oneof" json:"model,omitempty"`
+}
+
+func (x *GetUsageReportRequest) Reset() {
+	*x = GetUsageReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReportRequest) ProtoMessage() {}
+
+func (x *GetUsageReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReportRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageReportRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetUsageReportRequest) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetUserId() int32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *GetUsageReportRequest) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
+type GetUsageReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows  []*UsageReportRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Total *UsageReportRow   `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"` // Sum of all rows, without user, model nor day.
+}
+
+func (x *GetUsageReportResponse) Reset() {
+	*x = GetUsageReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReportResponse) ProtoMessage() {}
+
+func (x *GetUsageReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReportResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageReportResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetUsageReportResponse) GetRows() []*UsageReportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *GetUsageReportResponse) GetTotal() *UsageReportRow {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type UsageReportRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           int32   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Model            string  `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Day              string  `protobuf:"bytes,3,opt,name=day,proto3" json:"day,omitempty"`
+	Calls            int64   `protobuf:"varint,4,opt,name=calls,proto3" json:"calls,omitempty"`
+	PromptTokens     int64   `protobuf:"varint,5,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int64   `protobuf:"varint,6,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	ReasoningTokens  int64   `protobuf:"varint,7,opt,name=reasoning_tokens,json=reasoningTokens,proto3" json:"reasoning_tokens,omitempty"`
+	TotalTokens      int64   `protobuf:"varint,8,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	Images           int64   `protobuf:"varint,9,opt,name=images,proto3" json:"images,omitempty"`
+	CostUsd          float64 `protobuf:"fixed64,10,opt,name=cost_usd,json=costUsd,proto3" json:"cost_usd,omitempty"`
+	AvgLatencyMs     float64 `protobuf:"fixed64,11,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
+	CachedCalls      int64   `protobuf:"varint,12,opt,name=cached_calls,json=cachedCalls,proto3" json:"cached_calls,omitempty"` // Answered from our cache, without using any tokens.
+}
+
+func (x *UsageReportRow) Reset() {
+	*x = UsageReportRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageReportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageReportRow) ProtoMessage() {}
+
+func (x *UsageReportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageReportRow.ProtoReflect.Descriptor instead.
+func (*UsageReportRow) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UsageReportRow) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UsageReportRow) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *UsageReportRow) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *UsageReportRow) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *UsageReportRow) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *UsageReportRow) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *UsageReportRow) GetReasoningTokens() int64 {
+	if x != nil {
+		return x.ReasoningTokens
+	}
+	return 0
+}
+
+func (x *UsageReportRow) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *UsageReportRow) GetImages() int64 {
+	if x != nil {
+		return x.Images
+	}
+	return 0
+}
+
+func (x *UsageReportRow) GetCostUsd() float64 {
+	if x != nil {
+		return x.CostUsd
+	}
+	return 0
+}
+
+func (x *UsageReportRow) GetAvgLatencyMs() float64 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+func (x *UsageReportRow) GetCachedCalls() int64 {
+	if x != nil {
+		return x.CachedCalls
+	}
+	return 0
+}
+
+type SystemPromptInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version     int32  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Template    string `protobuf:"bytes,5,opt,name=template,proto3" json:"template,omitempty"` // Variables are written as {{.name}}.
+	CreatedById int32  `protobuf:"varint,6,opt,name=created_by_id,json=createdById,proto3" json:"created_by_id,omitempty"`
+	CreatedAt   string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *SystemPromptInfo) Reset() {
+	*x = SystemPromptInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemPromptInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPromptInfo) ProtoMessage() {}
+
+func (x *SystemPromptInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPromptInfo.ProtoReflect.Descriptor instead.
+func (*SystemPromptInfo) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SystemPromptInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SystemPromptInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemPromptInfo) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SystemPromptInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SystemPromptInfo) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *SystemPromptInfo) GetCreatedById() int32 {
+	if x != nil {
+		return x.CreatedById
+	}
+	return 0
+}
+
+func (x *SystemPromptInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateSystemPromptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Template    string `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *CreateSystemPromptRequest) Reset() {
+	*x = CreateSystemPromptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSystemPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSystemPromptRequest) ProtoMessage() {}
+
+func (x *CreateSystemPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSystemPromptRequest.ProtoReflect.Descriptor instead.
+func (*CreateSystemPromptRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateSystemPromptRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSystemPromptRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *CreateSystemPromptRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateSystemPromptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prompt *SystemPromptInfo `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+}
+
+func (x *CreateSystemPromptResponse) Reset() {
+	*x = CreateSystemPromptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSystemPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSystemPromptResponse) ProtoMessage() {}
+
+func (x *CreateSystemPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSystemPromptResponse.ProtoReflect.Descriptor instead.
+func (*CreateSystemPromptResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateSystemPromptResponse) GetPrompt() *SystemPromptInfo {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+type ListSystemPromptsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+}
+
+func (x *ListSystemPromptsRequest) Reset() {
+	*x = ListSystemPromptsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSystemPromptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSystemPromptsRequest) ProtoMessage() {}
+
+func (x *ListSystemPromptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSystemPromptsRequest.ProtoReflect.Descriptor instead.
+func (*ListSystemPromptsRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListSystemPromptsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type ListSystemPromptsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prompts []*SystemPromptInfo `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
+}
+
+func (x *ListSystemPromptsResponse) Reset() {
+	*x = ListSystemPromptsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSystemPromptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSystemPromptsResponse) ProtoMessage() {}
+
+func (x *ListSystemPromptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSystemPromptsResponse.ProtoReflect.Descriptor instead.
+func (*ListSystemPromptsResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListSystemPromptsResponse) GetPrompts() []*SystemPromptInfo {
+	if x != nil {
+		return x.Prompts
+	}
+	return nil
+}
+
+type GetSystemPromptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromptId int32 `protobuf:"varint,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+}
+
+func (x *GetSystemPromptRequest) Reset() {
+	*x = GetSystemPromptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSystemPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemPromptRequest) ProtoMessage() {}
+
+func (x *GetSystemPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemPromptRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemPromptRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetSystemPromptRequest) GetPromptId() int32 {
+	if x != nil {
+		return x.PromptId
+	}
+	return 0
+}
+
+type GetSystemPromptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prompt *SystemPromptInfo `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+}
+
+func (x *GetSystemPromptResponse) Reset() {
+	*x = GetSystemPromptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSystemPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemPromptResponse) ProtoMessage() {}
+
+func (x *GetSystemPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemPromptResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemPromptResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetSystemPromptResponse) GetPrompt() *SystemPromptInfo {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+// Prompts are moderated before being sent and answers before being stored. Blocked prompts fail with
+// INVALID_ARGUMENT and an ErrorInfo with reason CONTENT_BLOCKED and the categories, blocked answers are
+// replaced by a notice. Redacted parts are replaced by [REDACTED_<CATEGORY>] before anything is stored.
+type ModerationEventInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     int32    `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Route      string   `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	Stage      string   `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`   // prompt or answer.
+	Action     string   `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"` // redact or block.
+	Source     string   `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"` // local or provider.
+	Categories []string `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
+	Rules      []string `protobuf:"bytes,8,rep,name=rules,proto3" json:"rules,omitempty"`
+	CreatedAt  string   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ModerationEventInfo) Reset() {
+	*x = ModerationEventInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerationEventInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationEventInfo) ProtoMessage() {}
+
+func (x *ModerationEventInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationEventInfo.ProtoReflect.Descriptor instead.
+func (*ModerationEventInfo) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ModerationEventInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerationEventInfo) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ModerationEventInfo) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *ModerationEventInfo) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *ModerationEventInfo) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerationEventInfo) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ModerationEventInfo) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ModerationEventInfo) GetRules() []string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ModerationEventInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListModerationEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     *int32  `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	UserId   *int32  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Action   *string `protobuf:"bytes,4,opt,name=action,proto3,oneof" json:"action,omitempty"`
+	Stage    *string `protobuf:"bytes,5,opt,name=stage,proto3,oneof" json:"stage,omitempty"`
+}
+
+func (x *ListModerationEventsRequest) Reset() {
+	*x = ListModerationEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModerationEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationEventsRequest) ProtoMessage() {}
+
+func (x *ListModerationEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationEventsRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListModerationEventsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListModerationEventsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListModerationEventsRequest) GetUserId() int32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *ListModerationEventsRequest) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+func (x *ListModerationEventsRequest) GetStage() string {
+	if x != nil && x.Stage != nil {
+		return *x.Stage
+	}
+	return ""
+}
+
+type ListModerationEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events     []*ModerationEventInfo `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Pagination *PaginationInfo        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListModerationEventsResponse) Reset() {
+	*x = ListModerationEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModerationEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationEventsResponse) ProtoMessage() {}
+
+func (x *ListModerationEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationEventsResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListModerationEventsResponse) GetEvents() []*ModerationEventInfo {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListModerationEventsResponse) GetPagination() *PaginationInfo {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type DocumentRetrieval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int32  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	TopK         *int32 `protobuf:"varint,2,opt,name=top_k,json=topK,proto3,oneof" json:"top_k,omitempty"` // How many excerpts to send. Defaults to 4.
+}
+
+func (x *DocumentRetrieval) Reset() {
+	*x = DocumentRetrieval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocumentRetrieval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentRetrieval) ProtoMessage() {}
+
+func (x *DocumentRetrieval) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentRetrieval.ProtoReflect.Descriptor instead.
+func (*DocumentRetrieval) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DocumentRetrieval) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *DocumentRetrieval) GetTopK() int32 {
+	if x != nil && x.TopK != nil {
+		return *x.TopK
+	}
+	return 0
+}
+
+// An excerpt of a document. On chats, GPT is asked to cite it as [number].
+type DocumentCitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number       int32   `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	DocumentId   int32   `protobuf:"varint,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	DocumentName string  `protobuf:"bytes,3,opt,name=document_name,json=documentName,proto3" json:"document_name,omitempty"`
+	ChunkId      int32   `protobuf:"varint,4,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	ChunkIndex   int32   `protobuf:"varint,5,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"` // Its position on the document, from 0.
+	Page         int32   `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	Score        float32 `protobuf:"fixed32,7,opt,name=score,proto3" json:"score,omitempty"` // Cosine similarity with the message or query, up to 1.
+	Excerpt      string  `protobuf:"bytes,8,opt,name=excerpt,proto3" json:"excerpt,omitempty"`
+}
+
+func (x *DocumentCitation) Reset() {
+	*x = DocumentCitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocumentCitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentCitation) ProtoMessage() {}
+
+func (x *DocumentCitation) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentCitation.ProtoReflect.Descriptor instead.
+func (*DocumentCitation) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DocumentCitation) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *DocumentCitation) GetDocumentId() int32 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *DocumentCitation) GetDocumentName() string {
+	if x != nil {
+		return x.DocumentName
+	}
+	return ""
+}
+
+func (x *DocumentCitation) GetChunkId() int32 {
+	if x != nil {
+		return x.ChunkId
+	}
+	return 0
+}
+
+func (x *DocumentCitation) GetChunkIndex() int32 {
+	if x != nil {
+		return x.ChunkIndex
+	}
+	return 0
+}
+
+func (x *DocumentCitation) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *DocumentCitation) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DocumentCitation) GetExcerpt() string {
+	if x != nil {
+		return x.Excerpt
+	}
+	return ""
+}
+
+type DocumentCollectionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Documents   int32  `protobuf:"varint,4,opt,name=documents,proto3" json:"documents,omitempty"`
+	CreatedAt   string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DocumentCollectionInfo) Reset() {
+	*x = DocumentCollectionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocumentCollectionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentCollectionInfo) ProtoMessage() {}
+
+func (x *DocumentCollectionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentCollectionInfo.ProtoReflect.Descriptor instead.
+func (*DocumentCollectionInfo) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DocumentCollectionInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DocumentCollectionInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DocumentCollectionInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DocumentCollectionInfo) GetDocuments() int32 {
+	if x != nil {
+		return x.Documents
+	}
+	return 0
+}
+
+func (x *DocumentCollectionInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type DocumentInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CollectionId int32  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType  string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // text/plain, text/markdown or application/pdf.
+	SizeBytes    int64  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Pages        int32  `protobuf:"varint,6,opt,name=pages,proto3" json:"pages,omitempty"`
+	Chunks       int32  `protobuf:"varint,7,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	CreatedAt    string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DocumentInfo) Reset() {
+	*x = DocumentInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocumentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentInfo) ProtoMessage() {}
+
+func (x *DocumentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentInfo.ProtoReflect.Descriptor instead.
+func (*DocumentInfo) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DocumentInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DocumentInfo) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *DocumentInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DocumentInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DocumentInfo) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *DocumentInfo) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *DocumentInfo) GetChunks() int32 {
+	if x != nil {
+		return x.Chunks
+	}
+	return 0
+}
+
+func (x *DocumentInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateDocumentCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *CreateDocumentCollectionRequest) Reset() {
+	*x = CreateDocumentCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDocumentCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDocumentCollectionRequest) ProtoMessage() {}
+
+func (x *CreateDocumentCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDocumentCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateDocumentCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateDocumentCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDocumentCollectionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateDocumentCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection *DocumentCollectionInfo `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *CreateDocumentCollectionResponse) Reset() {
+	*x = CreateDocumentCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDocumentCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDocumentCollectionResponse) ProtoMessage() {}
+
+func (x *CreateDocumentCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDocumentCollectionResponse.ProtoReflect.Descriptor instead.
+func (*CreateDocumentCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateDocumentCollectionResponse) GetCollection() *DocumentCollectionInfo {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type ListDocumentCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListDocumentCollectionsRequest) Reset() {
+	*x = ListDocumentCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDocumentCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentCollectionsRequest) ProtoMessage() {}
+
+func (x *ListDocumentCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListDocumentCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{71}
+}
+
+type ListDocumentCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collections []*DocumentCollectionInfo `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+}
+
+func (x *ListDocumentCollectionsResponse) Reset() {
+	*x = ListDocumentCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDocumentCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentCollectionsResponse) ProtoMessage() {}
+
+func (x *ListDocumentCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListDocumentCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListDocumentCollectionsResponse) GetCollections() []*DocumentCollectionInfo {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type DeleteDocumentCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int32 `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *DeleteDocumentCollectionRequest) Reset() {
+	*x = DeleteDocumentCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDocumentCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteDocumentCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteDocumentCollectionRequest) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+type DeleteDocumentCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int32 `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *DeleteDocumentCollectionResponse) Reset() {
+	*x = DeleteDocumentCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDocumentCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentCollectionResponse) ProtoMessage() {}
+
+func (x *DeleteDocumentCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteDocumentCollectionResponse) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+type UploadDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int32  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType  string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // If not set, it's told by the name's extension or the content.
+	Content      []byte `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *UploadDocumentRequest) Reset() {
+	*x = UploadDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentRequest) ProtoMessage() {}
+
+func (x *UploadDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UploadDocumentRequest) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *UploadDocumentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadDocumentRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadDocumentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UploadDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Document *DocumentInfo `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+}
+
+func (x *UploadDocumentResponse) Reset() {
+	*x = UploadDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentResponse) ProtoMessage() {}
+
+func (x *UploadDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentResponse.ProtoReflect.Descriptor instead.
+func (*UploadDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UploadDocumentResponse) GetDocument() *DocumentInfo {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type ListDocumentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int32 `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *ListDocumentsRequest) Reset() {
+	*x = ListDocumentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsRequest) ProtoMessage() {}
+
+func (x *ListDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListDocumentsRequest) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+type ListDocumentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Documents []*DocumentInfo `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+}
+
+func (x *ListDocumentsResponse) Reset() {
+	*x = ListDocumentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsResponse) ProtoMessage() {}
+
+func (x *ListDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListDocumentsResponse) GetDocuments() []*DocumentInfo {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type DeleteDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentId int32 `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+}
+
+func (x *DeleteDocumentRequest) Reset() {
+	*x = DeleteDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentRequest) ProtoMessage() {}
+
+func (x *DeleteDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteDocumentRequest) GetDocumentId() int32 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+type DeleteDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentId int32 `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+}
+
+func (x *DeleteDocumentResponse) Reset() {
+	*x = DeleteDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentResponse) ProtoMessage() {}
+
+func (x *DeleteDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteDocumentResponse) GetDocumentId() int32 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+type SearchDocumentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int32  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Query        string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	TopK         *int32 `protobuf:"varint,3,opt,name=top_k,json=topK,proto3,oneof" json:"top_k,omitempty"` // Defaults to 4.
+}
+
+func (x *SearchDocumentsRequest) Reset() {
+	*x = SearchDocumentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchDocumentsRequest) ProtoMessage() {}
+
+func (x *SearchDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SearchDocumentsRequest) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *SearchDocumentsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchDocumentsRequest) GetTopK() int32 {
+	if x != nil && x.TopK != nil {
+		return *x.TopK
+	}
+	return 0
+}
+
+type SearchDocumentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*DocumentCitation `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchDocumentsResponse) Reset() {
+	*x = SearchDocumentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gpt_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchDocumentsResponse) ProtoMessage() {}
+
+func (x *SearchDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gpt_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*SearchDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_gpt_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SearchDocumentsResponse) GetResults() []*DocumentCitation {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_gpt_proto protoreflect.FileDescriptor
+
+var file_gpt_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x67, 0x70, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x70, 0x62, 0x73,
+	0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x62,
+	0x6f, 0x64, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x37, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xb7, 0x05, 0x0a, 0x11, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02,
+	0x20, 0x00, 0x48, 0x00, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x3f, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1e, 0xba, 0x48, 0x1b, 0x72, 0x19, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x69, 0x52, 0x09, 0x61, 0x6e, 0x74, 0x68, 0x72, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x04, 0x66, 0x61,
+	0x6b, 0x65, 0x48, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x24, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x48, 0x02, 0x52, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xba, 0x48,
+	0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x29, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x48, 0x03, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xba, 0x48, 0x08,
+	0x1a, 0x06, 0x18, 0xa0, 0x8d, 0x06, 0x20, 0x00, 0x48, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x10, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x05, 0x52, 0x0e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x56, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x10,
+	0x0a, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x61, 0x6c, 0x52, 0x09, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c,
+	0x1a, 0x42, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x91, 0x02, 0x0a,
+	0x12, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x70, 0x74,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x67, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x38, 0x0a, 0x0d, 0x74, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x74, 0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x09, 0x63,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x62, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xe0, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x47, 0x50, 0x54, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x02,
+	0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02,
+	0x20, 0x00, 0x48, 0x00, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x3f, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1e, 0xba, 0x48, 0x1b, 0x72, 0x19, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x69, 0x52, 0x09, 0x61, 0x6e, 0x74, 0x68, 0x72, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x04, 0x66, 0x61,
+	0x6b, 0x65, 0x48, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x24, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x48, 0x02, 0x52, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x10, 0x0a,
+	0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x62, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x61, 0x6c, 0x52, 0x09, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x22, 0x95, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x47,
+	0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x63, 0x68, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x70, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x70, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x38, 0x0a, 0x0d, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x47, 0x50, 0x54, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x74, 0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x09, 0x63, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x73, 0x2e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x63, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9f, 0x05, 0x0a, 0x14,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x00,
+	0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xe0, 0x41,
+	0x02, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x27, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x01, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x48,
+	0x1b, 0x72, 0x19, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x69, 0x52, 0x09, 0x61, 0x6e, 0x74,
+	0x68, 0x72, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x04, 0x66, 0x61, 0x6b, 0x65, 0x48, 0x02, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72,
+	0x04, 0x10, 0x01, 0x18, 0x64, 0x48, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x3e, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xba, 0x48, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x48,
+	0x04, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x1a, 0x06, 0x18, 0xa0, 0x8d, 0x06,
+	0x20, 0x00, 0x48, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x36, 0x0a, 0x10, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x06, 0x52, 0x0e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x59, 0x0a, 0x10, 0x70, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x42, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x68,
+	0x61, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xc2, 0x01,
+	0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x43,
+	0x68, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12,
+	0x16, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47,
+	0x50, 0x54, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e,
+	0x47, 0x50, 0x54, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x96, 0x02, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x47, 0x50, 0x54, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x63,
+	0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00,
+	0x48, 0x00, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3f,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1e, 0xba, 0x48, 0x1b, 0x72, 0x19, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x69, 0x52,
+	0x09, 0x61, 0x6e, 0x74, 0x68, 0x72, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x04, 0x66, 0x61, 0x6b, 0x65,
+	0x48, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x24, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x48, 0x02, 0x52, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x10, 0x0a, 0x18, 0x01,
+	0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x95, 0x02, 0x0a, 0x1a,
+	0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x50, 0x54, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x68,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47,
+	0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x70, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x2d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x38, 0x0a, 0x0d, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50,
+	0x54, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x74, 0x6f,
+	0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x22, 0xe3, 0x02, 0x0a, 0x15, 0x45, 0x64, 0x69, 0x74, 0x47, 0x50, 0x54, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74,
+	0x49, 0x64, 0x12, 0x29, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02,
+	0x20, 0x00, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x00,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
+	0xba, 0x48, 0x1b, 0x72, 0x19, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x69, 0x52, 0x09, 0x61,
+	0x6e, 0x74, 0x68, 0x72, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x04, 0x66, 0x61, 0x6b, 0x65, 0x48, 0x01,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48,
+	0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x48, 0x02, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x10, 0x0a, 0x18, 0x01, 0x52, 0x05,
+	0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x91, 0x02, 0x0a, 0x16, 0x45, 0x64,
+	0x69, 0x74, 0x47, 0x50, 0x54, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x70,
+	0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x67, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x38, 0x0a, 0x0d, 0x74, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x74, 0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x6c, 0x0a,
+	0x1a, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41,
+	0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64,
+	0x12, 0x29, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00,
+	0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x74, 0x0a, 0x1b, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x68,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47,
+	0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74,
+	0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x22, 0xdb, 0x01, 0x0a, 0x0e, 0x47, 0x50, 0x54, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x22,
+	0x63, 0x0a, 0x0b, 0x47, 0x50, 0x54, 0x54, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x50, 0x54, 0x54,
+	0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x50, 0x54, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x54, 0x6f, 0x6f, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x7d, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x79, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04,
+	0x18, 0x64, 0x20, 0x00, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x75, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x79, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x63, 0x68, 0x61, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x60, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba,
+	0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xe0,
+	0x41, 0x02, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x22, 0x3d, 0x0a, 0x15, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x47, 0x50, 0x54,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04,
+	0x63, 0x68, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x63, 0x68,
+	0x61, 0x74, 0x22, 0x3b, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x50, 0x54, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02,
+	0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x22,
+	0x30, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49,
+	0x64, 0x22, 0x38, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a,
+	0x02, 0x20, 0x00, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e,
+	0x47, 0x50, 0x54, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x63,
+	0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xba, 0x48, 0x1a, 0x72, 0x18, 0x52, 0x00, 0x52, 0x08,
+	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x04,
+	0x68, 0x74, 0x6d, 0x6c, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x50, 0x0a, 0x17,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xba, 0x48, 0x1a, 0x72, 0x18, 0x52, 0x00,
+	0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e,
+	0x52, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x89,
+	0x01, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a,
+	0x02, 0x20, 0x00, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0,
+	0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x61, 0x6e, 0x5f,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3c, 0x0a, 0x14, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x22, 0x3c, 0x0a, 0x15, 0x55, 0x6e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06,
+	0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x16, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x24, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x00,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48,
+	0x06, 0x1a, 0x04, 0x18, 0x64, 0x20, 0x00, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x75, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54,
+	0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x63, 0x68, 0x61, 0x74, 0x73, 0x12,
+	0x33, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
+	0x02, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47,
+	0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x27, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73,
+	0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x22, 0xb2, 0x01,
+	0x0a, 0x13, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50,
+	0x54, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x2f, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xdf, 0x01, 0x0a, 0x0c, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50,
+	0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0xea, 0x01, 0x0a, 0x14, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a,
+	0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41,
+	0x74, 0x22, 0x41, 0x0a, 0x11, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x50, 0x54, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f,
+	0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01,
+	0x18, 0x40, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0x7e, 0x0a, 0x17, 0x57, 0x61, 0x69, 0x74, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0c, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x0b,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x3c, 0x28, 0x00, 0x52,
+	0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+	0x4c, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0c,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40,
+	0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x7c, 0x0a,
+	0x12, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x22, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x1c, 0x72, 0x1a, 0x32, 0x18, 0x5e, 0x5b, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5f, 0x5d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5f, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x16, 0xe0, 0x41, 0x02,
+	0xba, 0x48, 0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10, 0x32, 0x22, 0x07, 0x72, 0x05, 0x10, 0x01,
+	0x18, 0xc8, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xbc, 0x02, 0x0a, 0x17,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xba, 0x48,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xe8, 0x07, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0d,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x05, 0x52, 0x09, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28,
+	0x00, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48,
+	0x00, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x22, 0xf5, 0x01, 0x0a, 0x0e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a,
+	0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x63,
+	0x68, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x12, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x41, 0x74, 0x22, 0x8c, 0x02,
+	0x0a, 0x0e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x40,
+	0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x12, 0x2f, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x1a, 0x3c,
+	0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02,
+	0x20, 0x00, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x17, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04,
+	0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x22, 0x42, 0x0a,
+	0x19, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41,
+	0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x64, 0x22, 0x8d, 0x01, 0x0a, 0x0e, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48,
+	0x00, 0x52, 0x07, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x07, 0xba, 0x48, 0x04, 0x7a,
+	0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a,
+	0x06, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34,
+	0x42, 0x0f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08,
+	0x01, 0x22, 0xab, 0x02, 0x0a, 0x13, 0x45, 0x64, 0x69, 0x74, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47,
+	0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x09, 0xe0,
+	0x41, 0x02, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x27, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x25, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0xe8, 0x07, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12,
+	0x25, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00,
+	0x48, 0x00, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x01, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f,
+	0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x22,
+	0xe3, 0x01, 0x0a, 0x1b, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x34, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x42, 0x09, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x01,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x50, 0x54, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0,
+	0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x49, 0x64, 0x22, 0x80, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xba, 0x48, 0x20, 0x72,
+	0x1e, 0x32, 0x1c, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x5b, 0x30, 0x2d,
+	0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x24, 0x48,
+	0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xba, 0x48, 0x20, 0x72, 0x1e, 0x32, 0x1c, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
+	0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x24, 0x48, 0x01, 0x52, 0x02, 0x74,
+	0x6f, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x02,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x48, 0x03, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x6f, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x6c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x27, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0x83, 0x03, 0x0a, 0x0e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x61, 0x76, 0x67, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x67, 0x4c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x10, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa4, 0x01,
+	0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xe0, 0x41, 0x02, 0xba, 0x48,
+	0x15, 0x72, 0x13, 0x10, 0x01, 0x18, 0x40, 0x32, 0x0d, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x08,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x08, 0x72, 0x06, 0x10, 0x01, 0x18, 0xa0, 0x9c, 0x01, 0x52, 0x08,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x22, 0x47, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06,
+	0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x19, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x41, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20,
+	0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x22, 0xef, 0x01, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb0, 0x02, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x00,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48,
+	0x06, 0x1a, 0x04, 0x18, 0x64, 0x20, 0x00, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00,
+	0x48, 0x02, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xba,
+	0x48, 0x11, 0x72, 0x0f, 0x52, 0x06, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x52, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x03, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x15, 0xba, 0x48, 0x12, 0x72, 0x10, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x06,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x48, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x88,
+	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x1c, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x14,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x6b, 0x22, 0xf0, 0x01, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x65, 0x72, 0x70, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x78, 0x63, 0x65, 0x72, 0x70, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe6, 0x01, 0x0a, 0x0c, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x70, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80,
+	0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x60, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x62, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x52, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0c,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x20,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2f, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02,
+	0x20, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02,
+	0x18, 0x64, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x29, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x0f, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x09, 0x7a, 0x07, 0x10, 0x01, 0x18, 0x80, 0x80, 0x80,
+	0x0a, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x16, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0c,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x44, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2b, 0x0a, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00,
+	0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x16,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xba, 0x48,
+	0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xd0,
+	0x0f, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x14,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x6b, 0x22, 0x4a, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x2a, 0x44, 0x0a, 0x0c, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x57, 0x49, 0x44, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x41,
+	0x4c, 0x4c, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x49, 0x4e, 0x59, 0x10, 0x03, 0x12, 0x09,
+	0x0a, 0x05, 0x53, 0x4d, 0x41, 0x4c, 0x4c, 0x10, 0x04, 0x2a, 0x4b, 0x0a, 0x0e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x49,
+	0x4d, 0x41, 0x47, 0x45, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4d, 0x41,
+	0x47, 0x45, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x41,
+	0x4d, 0x50, 0x4c, 0x45, 0x10, 0x01, 0x32, 0xc9, 0x38, 0x0a, 0x0a, 0x47, 0x50, 0x54, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54,
+	0x43, 0x68, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50,
+	0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70,
+	0x62, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x92, 0x41, 0x39, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a,
+	0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x4a, 0x24, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x1d, 0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x3a, 0x01, 0x2a, 0x22, 0x07, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x70, 0x74, 0x12, 0xac, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x6f, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x54, 0x6f, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x61, 0x92, 0x41, 0x42, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a, 0x11, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x4a, 0x28,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x21, 0x12, 0x1f, 0x0a, 0x1d, 0x1a, 0x1b, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01,
+	0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0xcb, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47,
+	0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x50,
+	0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x80, 0x01,
+	0x92, 0x41, 0x64, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a, 0x0f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x67, 0x70, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x3a, 0x11, 0x74, 0x65, 0x78, 0x74, 0x2f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x27,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x20, 0x12, 0x1e, 0x0a, 0x1c, 0x1a, 0x1a, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a,
+	0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x30, 0x01, 0x12, 0x98, 0x01, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x92, 0x41, 0x3f, 0x0a, 0x05, 0x44, 0x41, 0x4c, 0x4c,
+	0x45, 0x2a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x61, 0x6c, 0x6c, 0x65, 0x5f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x4a, 0x25, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x1e, 0x12, 0x1c, 0x0a, 0x1a, 0x1a,
+	0x18, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a,
+	0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x6c, 0x6c, 0x65, 0x12, 0xa1, 0x01,
+	0x0a, 0x0c, 0x45, 0x64, 0x69, 0x74, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x2e, 0x70, 0x62, 0x73, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4e,
+	0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x5d, 0x92, 0x41, 0x40, 0x0a, 0x05, 0x44, 0x41, 0x4c, 0x4c, 0x45, 0x2a, 0x10,
+	0x65, 0x64, 0x69, 0x74, 0x5f, 0x64, 0x61, 0x6c, 0x6c, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x4a, 0x25, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x1e, 0x12, 0x1c, 0x0a, 0x1a, 0x1a, 0x18, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a,
+	0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x61, 0x6c, 0x6c, 0x65, 0x2f, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x12, 0xbf, 0x01, 0x0a, 0x14, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
+	0x62, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6b, 0x92, 0x41, 0x49, 0x0a, 0x05, 0x44, 0x41, 0x4c,
+	0x4c, 0x45, 0x2a, 0x19, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x61, 0x6c, 0x6c, 0x65, 0x5f, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x25, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x1e, 0x12, 0x1c, 0x0a, 0x1a, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x4e, 0x65, 0x77, 0x47, 0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f,
+	0x76, 0x31, 0x2f, 0x64, 0x61, 0x6c, 0x6c, 0x65, 0x2f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0xa9, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x50, 0x54, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x66, 0x92, 0x41, 0x3a, 0x0a, 0x05, 0x44, 0x41,
+	0x4c, 0x4c, 0x45, 0x2a, 0x11, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x1e, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x17, 0x12,
+	0x15, 0x0a, 0x13, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0xb4, 0x01, 0x0a, 0x10, 0x57, 0x61, 0x69, 0x74, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x47,
+	0x50, 0x54, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x92, 0x41, 0x3b, 0x0a, 0x05, 0x44, 0x41, 0x4c, 0x4c,
+	0x45, 0x2a, 0x12, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x1e, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x17, 0x12, 0x15,
+	0x0a, 0x13, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x22, 0x26,
+	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x7d, 0x3a, 0x77, 0x61, 0x69, 0x74, 0x12, 0xbc, 0x01, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x73, 0x92, 0x41, 0x3d, 0x0a, 0x05, 0x44, 0x41, 0x4c, 0x4c, 0x45, 0x2a, 0x14, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4a, 0x1e, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x17, 0x12, 0x15, 0x0a, 0x13, 0x1a,
+	0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x50, 0x54, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x70, 0x74, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0xa7, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x60, 0x92,
+	0x41, 0x3d, 0x0a, 0x05, 0x44, 0x41, 0x4c, 0x4c, 0x45, 0x2a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x20, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x19, 0x12, 0x17, 0x0a, 0x15, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70,
+	0x74, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0xa6, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70,
+	0x62, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x65, 0x92, 0x41, 0x3a, 0x0a, 0x05, 0x44, 0x41, 0x4c, 0x4c, 0x45, 0x2a, 0x0f, 0x67,
+	0x65, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x20,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x19, 0x12, 0x17, 0x0a, 0x15, 0x1a, 0x13, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x2d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x2f, 0x7b, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xb9, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x72, 0x92, 0x41, 0x3d, 0x0a, 0x05, 0x44, 0x41, 0x4c, 0x4c, 0x45, 0x2a, 0x12, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x4a, 0x20, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x19, 0x12, 0x17, 0x0a, 0x15, 0x1a, 0x13, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e,
+	0x66, 0x6f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01, 0x2a, 0x22, 0x27, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x70, 0x74, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2d, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x2f, 0x7b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x12, 0xae, 0x01, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64,
+	0x79, 0x22, 0x62, 0x92, 0x41, 0x2e, 0x0a, 0x05, 0x44, 0x41, 0x4c, 0x4c, 0x45, 0x2a, 0x14, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x3a, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x7a, 0x69, 0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x70, 0x74, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x2f, 0x7b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x9b, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x47, 0x50, 0x54,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47,
+	0x50, 0x54, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70,
+	0x42, 0x6f, 0x64, 0x79, 0x22, 0x5d, 0x92, 0x41, 0x39, 0x0a, 0x05, 0x44, 0x41, 0x4c, 0x4c, 0x45,
+	0x2a, 0x0d, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x3a,
+	0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6e, 0x67, 0x3a, 0x0a, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x2f, 0x6a, 0x70, 0x65, 0x67, 0x3a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2f, 0x77, 0x65,
+	0x62, 0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70,
+	0x74, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0xca, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x47, 0x50, 0x54, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x50, 0x54, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x50, 0x54, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0x92, 0x41, 0x49,
+	0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a, 0x14, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x4a, 0x2c, 0x0a, 0x03, 0x32,
+	0x30, 0x30, 0x12, 0x25, 0x12, 0x23, 0x0a, 0x21, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x52,
+	0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x47, 0x50, 0x54, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a,
+	0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x7b, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0xc6, 0x01, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x47, 0x50, 0x54, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x47, 0x50,
+	0x54, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x47, 0x50, 0x54, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7b, 0x92, 0x41,
+	0x41, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a, 0x10, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x67, 0x70, 0x74,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4a, 0x28, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
+	0x21, 0x12, 0x1f, 0x0a, 0x1d, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x45, 0x64, 0x69, 0x74,
+	0x47, 0x50, 0x54, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a, 0x22, 0x2c, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x70, 0x74, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x12, 0xcc, 0x01, 0x0a, 0x13, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x47, 0x50,
+	0x54, 0x43, 0x68, 0x61, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x47,
+	0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x92, 0x41, 0x4c, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a, 0x16,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x4a, 0x2d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x26, 0x12,
+	0x24, 0x0a, 0x22, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18,
+	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0xa8, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x79, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x79, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x92, 0x41, 0x4b, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x79, 0x2a, 0x11, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6d, 0x79,
+	0x5f, 0x67, 0x70, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x73, 0x4a, 0x28, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x21, 0x12, 0x1f, 0x0a, 0x1d, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x79, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x70, 0x74, 0x12, 0xa6, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x47, 0x50,
+	0x54, 0x43, 0x68, 0x61, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x47, 0x50, 0x54,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x92, 0x41,
+	0x3f, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a, 0x0f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x67,
+	0x70, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x4a, 0x27, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x20,
+	0x12, 0x1e, 0x0a, 0x1c, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x32, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x70, 0x74, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa3, 0x01, 0x0a,
+	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x12, 0x19,
+	0x2e, 0x70, 0x62, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x73, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x92, 0x41, 0x3f, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a,
+	0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74,
+	0x4a, 0x27, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x20, 0x12, 0x1e, 0x0a, 0x1c, 0x1a, 0x1a, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a,
+	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x94, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61,
+	0x74, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x50, 0x54, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x55, 0x92, 0x41, 0x39, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a, 0x0c, 0x67, 0x65,
+	0x74, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x4a, 0x24, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x1d, 0x12, 0x1b, 0x0a, 0x19, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f,
+	0x7b, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa7, 0x01, 0x0a, 0x0d, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x65, 0x92, 0x41,
+	0x42, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x67,
+	0x70, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x3a, 0x0d, 0x74, 0x65, 0x78, 0x74, 0x2f, 0x6d, 0x61,
+	0x72, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x09, 0x74, 0x65, 0x78, 0x74, 0x2f, 0x68,
+	0x74, 0x6d, 0x6c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x70, 0x74, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79,
+	0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x4d, 0x92, 0x41,
+	0x34, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x79, 0x2a,
+	0x13, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x79, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x63,
+	0x68, 0x61, 0x74, 0x73, 0x3a, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x7a, 0x69, 0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x70, 0x74, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0xa7, 0x01, 0x0a, 0x0c,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x12, 0x18, 0x2e, 0x70,
+	0x62, 0x73, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x62, 0x92, 0x41, 0x3d, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a, 0x0e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x4a, 0x26, 0x0a, 0x03, 0x32,
+	0x30, 0x30, 0x12, 0x1f, 0x12, 0x1d, 0x0a, 0x1b, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0xb3, 0x01, 0x0a, 0x0e, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x55,
+	0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x68, 0x92, 0x41, 0x41, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a, 0x10, 0x75, 0x6e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x5f, 0x67, 0x70, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x4a, 0x28, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x21, 0x12, 0x1f, 0x0a, 0x1d, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x47, 0x50, 0x54, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a,
+	0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x74, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x75, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0xba, 0x01, 0x0a, 0x0e,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x74, 0x73, 0x12, 0x1a,
+	0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x92, 0x41, 0x49, 0x0a, 0x03, 0x47, 0x50,
+	0x54, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2a, 0x10, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x73, 0x4a, 0x28, 0x0a, 0x03, 0x32,
+	0x30, 0x30, 0x12, 0x21, 0x12, 0x1f, 0x0a, 0x1d, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x73, 0x12, 0xaf, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x92, 0x41, 0x4c, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x0a, 0x09,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4f, 0x6e, 0x6c, 0x79, 0x2a, 0x10, 0x67, 0x65, 0x74, 0x5f, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x28, 0x0a, 0x03, 0x32,
+	0x30, 0x30, 0x12, 0x21, 0x12, 0x1f, 0x0a, 0x1d, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x70, 0x74, 0x2f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0xc8, 0x01, 0x0a, 0x12, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x71, 0x92, 0x41, 0x54, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x0a, 0x09, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4f, 0x6e, 0x6c, 0x79, 0x2a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4a, 0x2c, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x25, 0x12, 0x23, 0x0a, 0x21, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x70, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x73, 0x12, 0xb5, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x62,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0x92, 0x41, 0x47, 0x0a,
+	0x03, 0x47, 0x50, 0x54, 0x2a, 0x13, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x4a, 0x2b, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x24, 0x12, 0x22, 0x0a, 0x20, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x12, 0xb7, 0x01,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x92, 0x41,
+	0x43, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a, 0x11, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4a, 0x29, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x22, 0x12, 0x20, 0x0a, 0x1e, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x70, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x9a, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x50, 0x54, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x50, 0x54, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x50, 0x54,
+	0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x92,
+	0x41, 0x3d, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x2a, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x67, 0x70,
+	0x74, 0x5f, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x4a, 0x26, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x1f,
+	0x12, 0x1d, 0x0a, 0x1b, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x50, 0x54, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x74,
+	0x6f, 0x6f, 0x6c, 0x73, 0x12, 0xd2, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x75, 0x92, 0x41, 0x58, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x0a, 0x09, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4f, 0x6e, 0x6c, 0x79, 0x2a, 0x16, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4a,
+	0x2e, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x27, 0x12, 0x25, 0x0a, 0x23, 0x1a, 0x21, 0x2e, 0x70,
+	0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xe5, 0x01, 0x0a, 0x18, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70,
+	0x62, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x7c, 0x92, 0x41, 0x5b, 0x0a, 0x09, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2a, 0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4a,
+	0x32, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x2b, 0x12, 0x29, 0x0a, 0x27, 0x1a, 0x25, 0x2e, 0x70,
+	0x62, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0xe7, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x80, 0x01, 0x92, 0x41, 0x62, 0x0a, 0x09,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x6e, 0x79, 0x2a, 0x19, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x31, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x2a, 0x12, 0x28, 0x0a, 0x26, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xf3, 0x01, 0x0a, 0x18,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x70, 0x62, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x89, 0x01, 0x92, 0x41, 0x5b, 0x0a, 0x09, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x1a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4a, 0x32, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x2b, 0x12, 0x29, 0x0a, 0x27, 0x1a,
+	0x25, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x2a, 0x23, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0xcd, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x81, 0x01,
+	0x92, 0x41, 0x46, 0x0a, 0x09, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x0f,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4a,
+	0x28, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x21, 0x12, 0x1f, 0x0a, 0x1d, 0x1a, 0x1b, 0x2e, 0x70,
+	0x62, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a,
+	0x01, 0x2a, 0x22, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0xce, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x92, 0x41, 0x4d,
+	0x0a, 0x09, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x0a, 0x07, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x6e, 0x79, 0x2a, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x4a, 0x27, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x20, 0x12, 0x1e, 0x0a,
+	0x1c, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x70,
+	0x92, 0x41, 0x46, 0x0a, 0x09, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x0f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4a,
+	0x28, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x21, 0x12, 0x1f, 0x0a, 0x1d, 0x1a, 0x1b, 0x2e, 0x70,
+	0x62, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x2a,
+	0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x7b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0xcf, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x80, 0x01, 0x92, 0x41, 0x48, 0x0a, 0x09, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2a, 0x10, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x4a, 0x29, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x22, 0x12, 0x20, 0x0a, 0x1e, 0x1a,
+	0x1c, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x70, 0x74, 0x2f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x67, 0x69, 0x6c, 0x70, 0x65, 0x72, 0x6f, 0x70, 0x69, 0x6f, 0x6c, 0x61, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2d, 0x69, 0x6d, 0x70, 0x6c, 0x2f,
+	0x61, 0x70, 0x70, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x62, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gpt_proto_rawDescOnce sync.Once
+	file_gpt_proto_rawDescData = file_gpt_proto_rawDesc
+)
+
+func file_gpt_proto_rawDescGZIP() []byte {
+	file_gpt_proto_rawDescOnce.Do(func() {
+		file_gpt_proto_rawDescData = protoimpl.X.CompressGZIP(file_gpt_proto_rawDescData)
+	})
+	return file_gpt_proto_rawDescData
+}
+
+var file_gpt_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_gpt_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
+var file_gpt_proto_goTypes = []interface{}{
+	(GPTImageSize)(0),                        // 0: pbs.GPTImageSize
+	(ImageBatchMode)(0),                      // 1: pbs.ImageBatchMode
+	(*NewGPTChatRequest)(nil),                // 2: pbs.NewGPTChatRequest
+	(*NewGPTChatResponse)(nil),               // 3: pbs.NewGPTChatResponse
+	(*ReplyToGPTChatRequest)(nil),            // 4: pbs.ReplyToGPTChatRequest
+	(*ReplyToGPTChatResponse)(nil),           // 5: pbs.ReplyToGPTChatResponse
+	(*StreamGPTChatRequest)(nil),             // 6: pbs.StreamGPTChatRequest
+	(*StreamGPTChatResponse)(nil),            // 7: pbs.StreamGPTChatResponse
+	(*RegenerateGPTReplyRequest)(nil),        // 8: pbs.RegenerateGPTReplyRequest
+	(*RegenerateGPTReplyResponse)(nil),       // 9: pbs.RegenerateGPTReplyResponse
+	(*EditGPTMessageRequest)(nil),            // 10: pbs.EditGPTMessageRequest
+	(*EditGPTMessageResponse)(nil),           // 11: pbs.EditGPTMessageResponse
+	(*SwitchGPTChatBranchRequest)(nil),       // 12: pbs.SwitchGPTChatBranchRequest
+	(*SwitchGPTChatBranchResponse)(nil),      // 13: pbs.SwitchGPTChatBranchResponse
+	(*GPTContextInfo)(nil),                   // 14: pbs.GPTContextInfo
+	(*GPTToolInfo)(nil),                      // 15: pbs.GPTToolInfo
+	(*ListGPTToolsRequest)(nil),              // 16: pbs.ListGPTToolsRequest
+	(*ListGPTToolsResponse)(nil),             // 17: pbs.ListGPTToolsResponse
+	(*ListMyGPTChatsRequest)(nil),            // 18: pbs.ListMyGPTChatsRequest
+	(*ListMyGPTChatsResponse)(nil),           // 19: pbs.ListMyGPTChatsResponse
+	(*RenameGPTChatRequest)(nil),             // 20: pbs.RenameGPTChatRequest
+	(*RenameGPTChatResponse)(nil),            // 21: pbs.RenameGPTChatResponse
+	(*DeleteGPTChatRequest)(nil),             // 22: pbs.DeleteGPTChatRequest
+	(*DeleteGPTChatResponse)(nil),            // 23: pbs.DeleteGPTChatResponse
+	(*GetGPTChatRequest)(nil),                // 24: pbs.GetGPTChatRequest
+	(*GetGPTChatResponse)(nil),               // 25: pbs.GetGPTChatResponse
+	(*ExportGPTChatRequest)(nil),             // 26: pbs.ExportGPTChatRequest
+	(*ExportMyGPTChatsRequest)(nil),          // 27: pbs.ExportMyGPTChatsRequest
+	(*ShareGPTChatRequest)(nil),              // 28: pbs.ShareGPTChatRequest
+	(*ShareGPTChatResponse)(nil),             // 29: pbs.ShareGPTChatResponse
+	(*UnshareGPTChatRequest)(nil),            // 30: pbs.UnshareGPTChatRequest
+	(*UnshareGPTChatResponse)(nil),           // 31: pbs.UnshareGPTChatResponse
+	(*ListGroupChatsRequest)(nil),            // 32: pbs.ListGroupChatsRequest
+	(*ListGroupChatsResponse)(nil),           // 33: pbs.ListGroupChatsResponse
+	(*NewGPTImageRequest)(nil),               // 34: pbs.NewGPTImageRequest
+	(*NewGPTImageResponse)(nil),              // 35: pbs.NewGPTImageResponse
+	(*GPTOperation)(nil),                     // 36: pbs.GPTOperation
+	(*GPTOperationMetadata)(nil),             // 37: pbs.GPTOperationMetadata
+	(*GPTOperationError)(nil),                // 38: pbs.GPTOperationError
+	(*GetGPTOperationRequest)(nil),           // 39: pbs.GetGPTOperationRequest
+	(*WaitGPTOperationRequest)(nil),          // 40: pbs.WaitGPTOperationRequest
+	(*CancelGPTOperationRequest)(nil),        // 41: pbs.CancelGPTOperationRequest
+	(*ImageBatchVariable)(nil),               // 42: pbs.ImageBatchVariable
+	(*CreateImageBatchRequest)(nil),          // 43: pbs.CreateImageBatchRequest
+	(*ImageBatchInfo)(nil),                   // 44: pbs.ImageBatchInfo
+	(*ImageBatchProgress)(nil),               // 45: pbs.ImageBatchProgress
+	(*ImageBatchItem)(nil),                   // 46: pbs.ImageBatchItem
+	(*GetImageBatchRequest)(nil),             // 47: pbs.GetImageBatchRequest
+	(*CancelImageBatchRequest)(nil),          // 48: pbs.CancelImageBatchRequest
+	(*DownloadImageBatchRequest)(nil),        // 49: pbs.DownloadImageBatchRequest
+	(*GPTImageSource)(nil),                   // 50: pbs.GPTImageSource
+	(*EditGPTImageRequest)(nil),              // 51: pbs.EditGPTImageRequest
+	(*NewGPTImageVariationRequest)(nil),      // 52: pbs.NewGPTImageVariationRequest
+	(*GetGPTImageRequest)(nil),               // 53: pbs.GetGPTImageRequest
+	(*GetUsageReportRequest)(nil),            // 54: pbs.GetUsageReportRequest
+	(*GetUsageReportResponse)(nil),           // 55: pbs.GetUsageReportResponse
+	(*UsageReportRow)(nil),                   // 56: pbs.UsageReportRow
+	(*SystemPromptInfo)(nil),                 // 57: pbs.SystemPromptInfo
+	(*CreateSystemPromptRequest)(nil),        // 58: pbs.CreateSystemPromptRequest
+	(*CreateSystemPromptResponse)(nil),       // 59: pbs.CreateSystemPromptResponse
+	(*ListSystemPromptsRequest)(nil),         // 60: pbs.ListSystemPromptsRequest
+	(*ListSystemPromptsResponse)(nil),        // 61: pbs.ListSystemPromptsResponse
+	(*GetSystemPromptRequest)(nil),           // 62: pbs.GetSystemPromptRequest
+	(*GetSystemPromptResponse)(nil),          // 63: pbs.GetSystemPromptResponse
+	(*ModerationEventInfo)(nil),              // 64: pbs.ModerationEventInfo
+	(*ListModerationEventsRequest)(nil),      // 65: pbs.ListModerationEventsRequest
+	(*ListModerationEventsResponse)(nil),     // 66: pbs.ListModerationEventsResponse
+	(*DocumentRetrieval)(nil),                // 67: pbs.DocumentRetrieval
+	(*DocumentCitation)(nil),                 // 68: pbs.DocumentCitation
+	(*DocumentCollectionInfo)(nil),           // 69: pbs.DocumentCollectionInfo
+	(*DocumentInfo)(nil),                     // 70: pbs.DocumentInfo
+	(*CreateDocumentCollectionRequest)(nil),  // 71: pbs.CreateDocumentCollectionRequest
+	(*CreateDocumentCollectionResponse)(nil), // 72: pbs.CreateDocumentCollectionResponse
+	(*ListDocumentCollectionsRequest)(nil),   // 73: pbs.ListDocumentCollectionsRequest
+	(*ListDocumentCollectionsResponse)(nil),  // 74: pbs.ListDocumentCollectionsResponse
+	(*DeleteDocumentCollectionRequest)(nil),  // 75: pbs.DeleteDocumentCollectionRequest
+	(*DeleteDocumentCollectionResponse)(nil), // 76: pbs.DeleteDocumentCollectionResponse
+	(*UploadDocumentRequest)(nil),            // 77: pbs.UploadDocumentRequest
+	(*UploadDocumentResponse)(nil),           // 78: pbs.UploadDocumentResponse
+	(*ListDocumentsRequest)(nil),             // 79: pbs.ListDocumentsRequest
+	(*ListDocumentsResponse)(nil),            // 80: pbs.ListDocumentsResponse
+	(*DeleteDocumentRequest)(nil),            // 81: pbs.DeleteDocumentRequest
+	(*DeleteDocumentResponse)(nil),           // 82: pbs.DeleteDocumentResponse
+	(*SearchDocumentsRequest)(nil),           // 83: pbs.SearchDocumentsRequest
+	(*SearchDocumentsResponse)(nil),          // 84: pbs.SearchDocumentsResponse
+	nil,                                      // 85: pbs.NewGPTChatRequest.PromptVariablesEntry
+	nil,                                      // 86: pbs.StreamGPTChatRequest.PromptVariablesEntry
+	nil,                                      // 87: pbs.ImageBatchItem.VariablesEntry
+	(*GPTChatInfo)(nil),                      // 88: pbs.GPTChatInfo
+	(*GPTMessageInfo)(nil),                   // 89: pbs.GPTMessageInfo
+	(*PaginationInfo)(nil),                   // 90: pbs.PaginationInfo
+	(*GPTMediaInfo)(nil),                     // 91: pbs.GPTMediaInfo
+	(*httpbody.HttpBody)(nil),                // 92: google.api.HttpBody
+}
+var file_gpt_proto_depIdxs = []int32{
+	85,  // 0: pbs.NewGPTChatRequest.prompt_variables:type_name -> pbs.NewGPTChatRequest.PromptVariablesEntry
+	67,  // 1: pbs.NewGPTChatRequest.retrieval:type_name -> pbs.DocumentRetrieval
+	88,  // 2: pbs.NewGPTChatResponse.chat:type_name -> pbs.GPTChatInfo
+	14,  // 3: pbs.NewGPTChatResponse.context:type_name -> pbs.GPTContextInfo
+	89,  // 4: pbs.NewGPTChatResponse.tool_messages:type_name -> pbs.GPTMessageInfo
+	68,  // 5: pbs.NewGPTChatResponse.citations:type_name -> pbs.DocumentCitation
+	67,  // 6: pbs.ReplyToGPTChatRequest.retrieval:type_name -> pbs.DocumentRetrieval
+	88,  // 7: pbs.ReplyToGPTChatResponse.chat:type_name -> pbs.GPTChatInfo
+	14,  // 8: pbs.ReplyToGPTChatResponse.context:type_name -> pbs.GPTContextInfo
+	89,  // 9: pbs.ReplyToGPTChatResponse.tool_messages:type_name -> pbs.GPTMessageInfo
+	68,  // 10: pbs.ReplyToGPTChatResponse.citations:type_name -> pbs.DocumentCitation
+	86,  // 11: pbs.StreamGPTChatRequest.prompt_variables:type_name -> pbs.StreamGPTChatRequest.PromptVariablesEntry
+	88,  // 12: pbs.StreamGPTChatResponse.chat:type_name -> pbs.GPTChatInfo
+	89,  // 13: pbs.StreamGPTChatResponse.message:type_name -> pbs.GPTMessageInfo
+	14,  // 14: pbs.StreamGPTChatResponse.context:type_name -> pbs.GPTContextInfo
+	88,  // 15: pbs.RegenerateGPTReplyResponse.chat:type_name -> pbs.GPTChatInfo
+	14,  // 16: pbs.RegenerateGPTReplyResponse.context:type_name -> pbs.GPTContextInfo
+	89,  // 17: pbs.RegenerateGPTReplyResponse.tool_messages:type_name -> pbs.GPTMessageInfo
+	89,  // 18: pbs.RegenerateGPTReplyResponse.messages:type_name -> pbs.GPTMessageInfo
+	88,  // 19: pbs.EditGPTMessageResponse.chat:type_name -> pbs.GPTChatInfo
+	14,  // 20: pbs.EditGPTMessageResponse.context:type_name -> pbs.GPTContextInfo
+	89,  // 21: pbs.EditGPTMessageResponse.tool_messages:type_name -> pbs.GPTMessageInfo
+	89,  // 22: pbs.EditGPTMessageResponse.messages:type_name -> pbs.GPTMessageInfo
+	88,  // 23: pbs.SwitchGPTChatBranchResponse.chat:type_name -> pbs.GPTChatInfo
+	89,  // 24: pbs.SwitchGPTChatBranchResponse.messages:type_name -> pbs.GPTMessageInfo
+	15,  // 25: pbs.ListGPTToolsResponse.tools:type_name -> pbs.GPTToolInfo
+	88,  // 26: pbs.ListMyGPTChatsResponse.chats:type_name -> pbs.GPTChatInfo
+	90,  // 27: pbs.ListMyGPTChatsResponse.pagination:type_name -> pbs.PaginationInfo
+	88,  // 28: pbs.RenameGPTChatResponse.chat:type_name -> pbs.GPTChatInfo
+	88,  // 29: pbs.GetGPTChatResponse.chat:type_name -> pbs.GPTChatInfo
+	89,  // 30: pbs.GetGPTChatResponse.messages:type_name -> pbs.GPTMessageInfo
+	88,  // 31: pbs.ShareGPTChatResponse.chat:type_name -> pbs.GPTChatInfo
+	88,  // 32: pbs.UnshareGPTChatResponse.chat:type_name -> pbs.GPTChatInfo
+	88,  // 33: pbs.ListGroupChatsResponse.chats:type_name -> pbs.GPTChatInfo
+	90,  // 34: pbs.ListGroupChatsResponse.pagination:type_name -> pbs.PaginationInfo
+	0,   // 35: pbs.NewGPTImageRequest.size:type_name -> pbs.GPTImageSize
+	88,  // 36: pbs.NewGPTImageResponse.chat:type_name -> pbs.GPTChatInfo
+	91,  // 37: pbs.NewGPTImageResponse.image:type_name -> pbs.GPTMediaInfo
+	36,  // 38: pbs.NewGPTImageResponse.operation:type_name -> pbs.GPTOperation
+	37,  // 39: pbs.GPTOperation.metadata:type_name -> pbs.GPTOperationMetadata
+	38,  // 40: pbs.GPTOperation.error:type_name -> pbs.GPTOperationError
+	35,  // 41: pbs.GPTOperation.response:type_name -> pbs.NewGPTImageResponse
+	42,  // 42: pbs.CreateImageBatchRequest.variables:type_name -> pbs.ImageBatchVariable
+	1,   // 43: pbs.CreateImageBatchRequest.mode:type_name -> pbs.ImageBatchMode
+	0,   // 44: pbs.CreateImageBatchRequest.size:type_name -> pbs.GPTImageSize
+	88,  // 45: pbs.ImageBatchInfo.chat:type_name -> pbs.GPTChatInfo
+	45,  // 46: pbs.ImageBatchInfo.progress:type_name -> pbs.ImageBatchProgress
+	46,  // 47: pbs.ImageBatchInfo.items:type_name -> pbs.ImageBatchItem
+	87,  // 48: pbs.ImageBatchItem.variables:type_name -> pbs.ImageBatchItem.VariablesEntry
+	36,  // 49: pbs.ImageBatchItem.operation:type_name -> pbs.GPTOperation
+	50,  // 50: pbs.EditGPTImageRequest.image:type_name -> pbs.GPTImageSource
+	50,  // 51: pbs.EditGPTImageRequest.mask:type_name -> pbs.GPTImageSource
+	0,   // 52: pbs.EditGPTImageRequest.size:type_name -> pbs.GPTImageSize
+	50,  // 53: pbs.NewGPTImageVariationRequest.image:type_name -> pbs.GPTImageSource
+	0,   // 54: pbs.NewGPTImageVariationRequest.size:type_name -> pbs.GPTImageSize
+	56,  // 55: pbs.GetUsageReportResponse.rows:type_name -> pbs.UsageReportRow
+	56,  // 56: pbs.GetUsageReportResponse.total:type_name -> pbs.UsageReportRow
+	57,  // 57: pbs.CreateSystemPromptResponse.prompt:type_name -> pbs.SystemPromptInfo
+	57,  // 58: pbs.ListSystemPromptsResponse.prompts:type_name -> pbs.SystemPromptInfo
+	57,  // 59: pbs.GetSystemPromptResponse.prompt:type_name -> pbs.SystemPromptInfo
+	64,  // 60: pbs.ListModerationEventsResponse.events:type_name -> pbs.ModerationEventInfo
+	90,  // 61: pbs.ListModerationEventsResponse.pagination:type_name -> pbs.PaginationInfo
+	69,  // 62: pbs.CreateDocumentCollectionResponse.collection:type_name -> pbs.DocumentCollectionInfo
+	69,  // 63: pbs.ListDocumentCollectionsResponse.collections:type_name -> pbs.DocumentCollectionInfo
+	70,  // 64: pbs.UploadDocumentResponse.document:type_name -> pbs.DocumentInfo
+	70,  // 65: pbs.ListDocumentsResponse.documents:type_name -> pbs.DocumentInfo
+	68,  // 66: pbs.SearchDocumentsResponse.results:type_name -> pbs.DocumentCitation
+	2,   // 67: pbs.GPTService.NewGPTChat:input_type -> pbs.NewGPTChatRequest
+	4,   // 68: pbs.GPTService.ReplyToGPTChat:input_type -> pbs.ReplyToGPTChatRequest
+	6,   // 69: pbs.GPTService.StreamGPTChat:input_type -> pbs.StreamGPTChatRequest
+	34,  // 70: pbs.GPTService.NewGPTImage:input_type -> pbs.NewGPTImageRequest
+	51,  // 71: pbs.GPTService.EditGPTImage:input_type -> pbs.EditGPTImageRequest
+	52,  // 72: pbs.GPTService.NewGPTImageVariation:input_type -> pbs.NewGPTImageVariationRequest
+	39,  // 73: pbs.GPTService.GetGPTOperation:input_type -> pbs.GetGPTOperationRequest
+	40,  // 74: pbs.GPTService.WaitGPTOperation:input_type -> pbs.WaitGPTOperationRequest
+	41,  // 75: pbs.GPTService.CancelGPTOperation:input_type -> pbs.CancelGPTOperationRequest
+	43,  // 76: pbs.GPTService.CreateImageBatch:input_type -> pbs.CreateImageBatchRequest
+	47,  // 77: pbs.GPTService.GetImageBatch:input_type -> pbs.GetImageBatchRequest
+	48,  // 78: pbs.GPTService.CancelImageBatch:input_type -> pbs.CancelImageBatchRequest
+	49,  // 79: pbs.GPTService.DownloadImageBatch:input_type -> pbs.DownloadImageBatchRequest
+	53,  // 80: pbs.GPTService.GetGPTImage:input_type -> pbs.GetGPTImageRequest
+	8,   // 81: pbs.GPTService.RegenerateGPTReply:input_type -> pbs.RegenerateGPTReplyRequest
+	10,  // 82: pbs.GPTService.EditGPTMessage:input_type -> pbs.EditGPTMessageRequest
+	12,  // 83: pbs.GPTService.SwitchGPTChatBranch:input_type -> pbs.SwitchGPTChatBranchRequest
+	18,  // 84: pbs.GPTService.ListMyGPTChats:input_type -> pbs.ListMyGPTChatsRequest
+	20,  // 85: pbs.GPTService.RenameGPTChat:input_type -> pbs.RenameGPTChatRequest
+	22,  // 86: pbs.GPTService.DeleteGPTChat:input_type -> pbs.DeleteGPTChatRequest
+	24,  // 87: pbs.GPTService.GetGPTChat:input_type -> pbs.GetGPTChatRequest
+	26,  // 88: pbs.GPTService.ExportGPTChat:input_type -> pbs.ExportGPTChatRequest
+	27,  // 89: pbs.GPTService.ExportMyGPTChats:input_type -> pbs.ExportMyGPTChatsRequest
+	28,  // 90: pbs.GPTService.ShareGPTChat:input_type -> pbs.ShareGPTChatRequest
+	30,  // 91: pbs.GPTService.UnshareGPTChat:input_type -> pbs.UnshareGPTChatRequest
+	32,  // 92: pbs.GPTService.ListGroupChats:input_type -> pbs.ListGroupChatsRequest
+	54,  // 93: pbs.GPTService.GetUsageReport:input_type -> pbs.GetUsageReportRequest
+	58,  // 94: pbs.GPTService.CreateSystemPrompt:input_type -> pbs.CreateSystemPromptRequest
+	60,  // 95: pbs.GPTService.ListSystemPrompts:input_type -> pbs.ListSystemPromptsRequest
+	62,  // 96: pbs.GPTService.GetSystemPrompt:input_type -> pbs.GetSystemPromptRequest
+	16,  // 97: pbs.GPTService.ListGPTTools:input_type -> pbs.ListGPTToolsRequest
+	65,  // 98: pbs.GPTService.ListModerationEvents:input_type -> pbs.ListModerationEventsRequest
+	71,  // 99: pbs.GPTService.CreateDocumentCollection:input_type -> pbs.CreateDocumentCollectionRequest
+	73,  // 100: pbs.GPTService.ListDocumentCollections:input_type -> pbs.ListDocumentCollectionsRequest
+	75,  // 101: pbs.GPTService.DeleteDocumentCollection:input_type -> pbs.DeleteDocumentCollectionRequest
+	77,  // 102: pbs.GPTService.UploadDocument:input_type -> pbs.UploadDocumentRequest
+	79,  // 103: pbs.GPTService.ListDocuments:input_type -> pbs.ListDocumentsRequest
+	81,  // 104: pbs.GPTService.DeleteDocument:input_type -> pbs.DeleteDocumentRequest
+	83,  // 105: pbs.GPTService.SearchDocuments:input_type -> pbs.SearchDocumentsRequest
+	3,   // 106: pbs.GPTService.NewGPTChat:output_type -> pbs.NewGPTChatResponse
+	5,   // 107: pbs.GPTService.ReplyToGPTChat:output_type -> pbs.ReplyToGPTChatResponse
+	7,   // 108: pbs.GPTService.StreamGPTChat:output_type -> pbs.StreamGPTChatResponse
+	35,  // 109: pbs.GPTService.NewGPTImage:output_type -> pbs.NewGPTImageResponse
+	35,  // 110: pbs.GPTService.EditGPTImage:output_type -> pbs.NewGPTImageResponse
+	35,  // 111: pbs.GPTService.NewGPTImageVariation:output_type -> pbs.NewGPTImageResponse
+	36,  // 112: pbs.GPTService.GetGPTOperation:output_type -> pbs.GPTOperation
+	36,  // 113: pbs.GPTService.WaitGPTOperation:output_type -> pbs.GPTOperation
+	36,  // 114: pbs.GPTService.CancelGPTOperation:output_type -> pbs.GPTOperation
+	44,  // 115: pbs.GPTService.CreateImageBatch:output_type -> pbs.ImageBatchInfo
+	44,  // 116: pbs.GPTService.GetImageBatch:output_type -> pbs.ImageBatchInfo
+	44,  // 117: pbs.GPTService.CancelImageBatch:output_type -> pbs.ImageBatchInfo
+	92,  // 118: pbs.GPTService.DownloadImageBatch:output_type -> google.api.HttpBody
+	92,  // 119: pbs.GPTService.GetGPTImage:output_type -> google.api.HttpBody
+	9,   // 120: pbs.GPTService.RegenerateGPTReply:output_type -> pbs.RegenerateGPTReplyResponse
+	11,  // 121: pbs.GPTService.EditGPTMessage:output_type -> pbs.EditGPTMessageResponse
+	13,  // 122: pbs.GPTService.SwitchGPTChatBranch:output_type -> pbs.SwitchGPTChatBranchResponse
+	19,  // 123: pbs.GPTService.ListMyGPTChats:output_type -> pbs.ListMyGPTChatsResponse
+	21,  // 124: pbs.GPTService.RenameGPTChat:output_type -> pbs.RenameGPTChatResponse
+	23,  // 125: pbs.GPTService.DeleteGPTChat:output_type -> pbs.DeleteGPTChatResponse
+	25,  // 126: pbs.GPTService.GetGPTChat:output_type -> pbs.GetGPTChatResponse
+	92,  // 127: pbs.GPTService.ExportGPTChat:output_type -> google.api.HttpBody
+	92,  // 128: pbs.GPTService.ExportMyGPTChats:output_type -> google.api.HttpBody
+	29,  // 129: pbs.GPTService.ShareGPTChat:output_type -> pbs.ShareGPTChatResponse
+	31,  // 130: pbs.GPTService.UnshareGPTChat:output_type -> pbs.UnshareGPTChatResponse
+	33,  // 131: pbs.GPTService.ListGroupChats:output_type -> pbs.ListGroupChatsResponse
+	55,  // 132: pbs.GPTService.GetUsageReport:output_type -> pbs.GetUsageReportResponse
+	59,  // 133: pbs.GPTService.CreateSystemPrompt:output_type -> pbs.CreateSystemPromptResponse
+	61,  // 134: pbs.GPTService.ListSystemPrompts:output_type -> pbs.ListSystemPromptsResponse
+	63,  // 135: pbs.GPTService.GetSystemPrompt:output_type -> pbs.GetSystemPromptResponse
+	17,  // 136: pbs.GPTService.ListGPTTools:output_type -> pbs.ListGPTToolsResponse
+	66,  // 137: pbs.GPTService.ListModerationEvents:output_type -> pbs.ListModerationEventsResponse
+	72,  // 138: pbs.GPTService.CreateDocumentCollection:output_type -> pbs.CreateDocumentCollectionResponse
+	74,  // 139: pbs.GPTService.ListDocumentCollections:output_type -> pbs.ListDocumentCollectionsResponse
+	76,  // 140: pbs.GPTService.DeleteDocumentCollection:output_type -> pbs.DeleteDocumentCollectionResponse
+	78,  // 141: pbs.GPTService.UploadDocument:output_type -> pbs.UploadDocumentResponse
+	80,  // 142: pbs.GPTService.ListDocuments:output_type -> pbs.ListDocumentsResponse
+	82,  // 143: pbs.GPTService.DeleteDocument:output_type -> pbs.DeleteDocumentResponse
+	84,  // 144: pbs.GPTService.SearchDocuments:output_type -> pbs.SearchDocumentsResponse
+	106, // [106:145] is the sub-list for method output_type
+	67,  // [67:106] is the sub-list for method input_type
+	67,  // [67:67] is the sub-list for extension type_name
+	67,  // [67:67] is the sub-list for extension extendee
+	0,   // [0:67] is the sub-list for field type_name
+}
+
+func init() { file_gpt_proto_init() }
+func file_gpt_proto_init() {
+	if File_gpt_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_gpt_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGPTChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
 		file_gpt_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NewGPTChatResponse); i {
@@ -559,8 +7248,644 @@ func file_gpt_proto_init() {
 				return nil
 			}
 		}
-		file_gpt_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyToGPTChatRequest); i {
+		file_gpt_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyToGPTChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyToGPTChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamGPTChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamGPTChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegenerateGPTReplyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegenerateGPTReplyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditGPTMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditGPTMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchGPTChatBranchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchGPTChatBranchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GPTContextInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GPTToolInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGPTToolsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGPTToolsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyGPTChatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyGPTChatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameGPTChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameGPTChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGPTChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGPTChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGPTChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGPTChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportGPTChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportMyGPTChatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareGPTChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareGPTChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnshareGPTChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnshareGPTChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupChatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupChatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGPTImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGPTImageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GPTOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GPTOperationMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GPTOperationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGPTOperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitGPTOperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelGPTOperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageBatchVariable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateImageBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageBatchInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageBatchProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageBatchItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImageBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelImageBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadImageBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GPTImageSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditGPTImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGPTImageVariationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGPTImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsageReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsageReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageReportRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemPromptInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -571,8 +7896,8 @@ func file_gpt_proto_init() {
 				return nil
 			}
 		}
-		file_gpt_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyToGPTChatResponse); i {
+		file_gpt_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSystemPromptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -583,8 +7908,8 @@ func file_gpt_proto_init() {
 				return nil
 			}
 		}
-		file_gpt_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewGPTImageRequest); i {
+		file_gpt_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSystemPromptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -595,8 +7920,296 @@ func file_gpt_proto_init() {
 				return nil
 			}
 		}
-		file_gpt_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewGPTImageResponse); i {
+		file_gpt_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSystemPromptsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSystemPromptsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSystemPromptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSystemPromptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerationEventInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModerationEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModerationEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentRetrieval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentCitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentCollectionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDocumentCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDocumentCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDocumentCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDocumentCollectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDocumentCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDocumentCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDocumentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDocumentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDocumentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDocumentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDocumentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDocumentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchDocumentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gpt_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchDocumentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -608,13 +8221,44 @@ func file_gpt_proto_init() {
 			}
 		}
 	}
+	file_gpt_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*StreamGPTChatResponse_Chat)(nil),
+		(*StreamGPTChatResponse_Delta)(nil),
+		(*StreamGPTChatResponse_Message)(nil),
+		(*StreamGPTChatResponse_Context)(nil),
+	}
+	file_gpt_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[34].OneofWrappers = []interface{}{
+		(*GPTOperation_Error)(nil),
+		(*GPTOperation_Response)(nil),
+	}
+	file_gpt_proto_msgTypes[41].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[48].OneofWrappers = []interface{}{
+		(*GPTImageSource_MediaId)(nil),
+		(*GPTImageSource_Upload)(nil),
+		(*GPTImageSource_Base64)(nil),
+	}
+	file_gpt_proto_msgTypes[49].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[50].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[52].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[58].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[63].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[65].OneofWrappers = []interface{}{}
+	file_gpt_proto_msgTypes[81].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gpt_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      2,
+			NumMessages:   86,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
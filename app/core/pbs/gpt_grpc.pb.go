@@ -8,6 +8,7 @@ package pbs
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,9 +20,45 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GPTService_NewGPTChat_FullMethodName     = "/pbs.GPTService/NewGPTChat"
-	GPTService_ReplyToGPTChat_FullMethodName = "/pbs.GPTService/ReplyToGPTChat"
-	GPTService_NewGPTImage_FullMethodName    = "/pbs.GPTService/NewGPTImage"
+	GPTService_NewGPTChat_FullMethodName               = "/pbs.GPTService/NewGPTChat"
+	GPTService_ReplyToGPTChat_FullMethodName           = "/pbs.GPTService/ReplyToGPTChat"
+	GPTService_StreamGPTChat_FullMethodName            = "/pbs.GPTService/StreamGPTChat"
+	GPTService_NewGPTImage_FullMethodName              = "/pbs.GPTService/NewGPTImage"
+	GPTService_EditGPTImage_FullMethodName             = "/pbs.GPTService/EditGPTImage"
+	GPTService_NewGPTImageVariation_FullMethodName     = "/pbs.GPTService/NewGPTImageVariation"
+	GPTService_GetGPTOperation_FullMethodName          = "/pbs.GPTService/GetGPTOperation"
+	GPTService_WaitGPTOperation_FullMethodName         = "/pbs.GPTService/WaitGPTOperation"
+	GPTService_CancelGPTOperation_FullMethodName       = "/pbs.GPTService/CancelGPTOperation"
+	GPTService_CreateImageBatch_FullMethodName         = "/pbs.GPTService/CreateImageBatch"
+	GPTService_GetImageBatch_FullMethodName            = "/pbs.GPTService/GetImageBatch"
+	GPTService_CancelImageBatch_FullMethodName         = "/pbs.GPTService/CancelImageBatch"
+	GPTService_DownloadImageBatch_FullMethodName       = "/pbs.GPTService/DownloadImageBatch"
+	GPTService_GetGPTImage_FullMethodName              = "/pbs.GPTService/GetGPTImage"
+	GPTService_RegenerateGPTReply_FullMethodName       = "/pbs.GPTService/RegenerateGPTReply"
+	GPTService_EditGPTMessage_FullMethodName           = "/pbs.GPTService/EditGPTMessage"
+	GPTService_SwitchGPTChatBranch_FullMethodName      = "/pbs.GPTService/SwitchGPTChatBranch"
+	GPTService_ListMyGPTChats_FullMethodName           = "/pbs.GPTService/ListMyGPTChats"
+	GPTService_RenameGPTChat_FullMethodName            = "/pbs.GPTService/RenameGPTChat"
+	GPTService_DeleteGPTChat_FullMethodName            = "/pbs.GPTService/DeleteGPTChat"
+	GPTService_GetGPTChat_FullMethodName               = "/pbs.GPTService/GetGPTChat"
+	GPTService_ExportGPTChat_FullMethodName            = "/pbs.GPTService/ExportGPTChat"
+	GPTService_ExportMyGPTChats_FullMethodName         = "/pbs.GPTService/ExportMyGPTChats"
+	GPTService_ShareGPTChat_FullMethodName             = "/pbs.GPTService/ShareGPTChat"
+	GPTService_UnshareGPTChat_FullMethodName           = "/pbs.GPTService/UnshareGPTChat"
+	GPTService_ListGroupChats_FullMethodName           = "/pbs.GPTService/ListGroupChats"
+	GPTService_GetUsageReport_FullMethodName           = "/pbs.GPTService/GetUsageReport"
+	GPTService_CreateSystemPrompt_FullMethodName       = "/pbs.GPTService/CreateSystemPrompt"
+	GPTService_ListSystemPrompts_FullMethodName        = "/pbs.GPTService/ListSystemPrompts"
+	GPTService_GetSystemPrompt_FullMethodName          = "/pbs.GPTService/GetSystemPrompt"
+	GPTService_ListGPTTools_FullMethodName             = "/pbs.GPTService/ListGPTTools"
+	GPTService_ListModerationEvents_FullMethodName     = "/pbs.GPTService/ListModerationEvents"
+	GPTService_CreateDocumentCollection_FullMethodName = "/pbs.GPTService/CreateDocumentCollection"
+	GPTService_ListDocumentCollections_FullMethodName  = "/pbs.GPTService/ListDocumentCollections"
+	GPTService_DeleteDocumentCollection_FullMethodName = "/pbs.GPTService/DeleteDocumentCollection"
+	GPTService_UploadDocument_FullMethodName           = "/pbs.GPTService/UploadDocument"
+	GPTService_ListDocuments_FullMethodName            = "/pbs.GPTService/ListDocuments"
+	GPTService_DeleteDocument_FullMethodName           = "/pbs.GPTService/DeleteDocument"
+	GPTService_SearchDocuments_FullMethodName          = "/pbs.GPTService/SearchDocuments"
 )
 
 // GPTServiceClient is the client API for GPTService service.
@@ -30,7 +67,93 @@ const (
 type GPTServiceClient interface {
 	NewGPTChat(ctx context.Context, in *NewGPTChatRequest, opts ...grpc.CallOption) (*NewGPTChatResponse, error)
 	ReplyToGPTChat(ctx context.Context, in *ReplyToGPTChatRequest, opts ...grpc.CallOption) (*ReplyToGPTChatResponse, error)
+	// Same as NewGPTChat or ReplyToGPTChat, depending on chat_id being set, but GPT's answer is
+	// sent as it's being written. Over HTTP, send 'Accept: text/event-stream' to get Server-Sent Events.
+	//
+	// The first event has the chat, then come the deltas, and the last one has the stored message.
+	// If it fails before that, the prompt isn't kept, and a chat it started is deleted.
+	StreamGPTChat(ctx context.Context, in *StreamGPTChatRequest, opts ...grpc.CallOption) (GPTService_StreamGPTChatClient, error)
 	NewGPTImage(ctx context.Context, in *NewGPTImageRequest, opts ...grpc.CallOption) (*NewGPTImageResponse, error)
+	// Edits an image with DALL-E 2, following the prompt. The transparent areas of the mask are the ones edited,
+	// or the image's own if there's no mask. The result goes to the chat, which defaults to the source image's.
+	EditGPTImage(ctx context.Context, in *EditGPTImageRequest, opts ...grpc.CallOption) (*NewGPTImageResponse, error)
+	// Makes a variation of an image with DALL-E 2. The result goes to the chat like with EditGPTImage.
+	NewGPTImageVariation(ctx context.Context, in *NewGPTImageVariationRequest, opts ...grpc.CallOption) (*NewGPTImageResponse, error)
+	// Returns an async image's operation: whether it's done and, if it is, its image or why it failed.
+	// Only its user can see it.
+	GetGPTOperation(ctx context.Context, in *GetGPTOperationRequest, opts ...grpc.CallOption) (*GPTOperation, error)
+	// Like GetGPTOperation, but waits until the operation is done or the timeout passes, whichever comes first.
+	WaitGPTOperation(ctx context.Context, in *WaitGPTOperationRequest, opts ...grpc.CallOption) (*GPTOperation, error)
+	// Cancels an operation that isn't done yet. If DALL-E is already making its image, the image is discarded.
+	CancelGPTOperation(ctx context.Context, in *CancelGPTOperationRequest, opts ...grpc.CallOption) (*GPTOperation, error)
+	// Makes an image for each combination of values of a prompt template's variables, or for a random sample of them.
+	// They're made in the background, one after the other, so we don't go over DALL-E's rate limits. All of them go to a new chat.
+	CreateImageBatch(ctx context.Context, in *CreateImageBatchRequest, opts ...grpc.CallOption) (*ImageBatchInfo, error)
+	// Returns a batch with its progress and each of its images' operations.
+	GetImageBatch(ctx context.Context, in *GetImageBatchRequest, opts ...grpc.CallOption) (*ImageBatchInfo, error)
+	// Cancels the batch's images that aren't done yet. The ones already made stay.
+	CancelImageBatch(ctx context.Context, in *CancelImageBatchRequest, opts ...grpc.CallOption) (*ImageBatchInfo, error)
+	// Returns a zip with the batch's images made so far and a manifest.json describing every one of them,
+	// with its prompt, variables and status.
+	DownloadImageBatch(ctx context.Context, in *DownloadImageBatchRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Returns an image we stored, like the ones DALL-E generates, as it is.
+	// Anyone who can see its chat can get it.
+	GetGPTImage(ctx context.Context, in *GetGPTImageRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Asks GPT again for the last answer on the chat's branch. The new answer is an alternative of the old one,
+	// and its branch becomes the chat's. Anyone who can reply to the chat can do it, the same rules as ReplyToGPTChat apply.
+	RegenerateGPTReply(ctx context.Context, in *RegenerateGPTReplyRequest, opts ...grpc.CallOption) (*RegenerateGPTReplyResponse, error)
+	// Sends a new version of one of the user's messages and answers it. The new message is an alternative of the old one,
+	// and its branch becomes the chat's. Only its sender can edit a message, if they can still reply to the chat.
+	EditGPTMessage(ctx context.Context, in *EditGPTMessageRequest, opts ...grpc.CallOption) (*EditGPTMessageResponse, error)
+	// Makes the chat show and continue the branch with the message on it. If there are several, the newest one.
+	// Anyone who can reply to the chat can do it.
+	SwitchGPTChatBranch(ctx context.Context, in *SwitchGPTChatBranchRequest, opts ...grpc.CallOption) (*SwitchGPTChatBranchResponse, error)
+	// Lists the caller's own chats, newest first.
+	ListMyGPTChats(ctx context.Context, in *ListMyGPTChatsRequest, opts ...grpc.CallOption) (*ListMyGPTChatsResponse, error)
+	// Changes a chat's title. Only its owner can do this.
+	RenameGPTChat(ctx context.Context, in *RenameGPTChatRequest, opts ...grpc.CallOption) (*RenameGPTChatResponse, error)
+	// Deletes a chat, also for the group it was shared with. Only its owner can do this.
+	DeleteGPTChat(ctx context.Context, in *DeleteGPTChatRequest, opts ...grpc.CallOption) (*DeleteGPTChatResponse, error)
+	// Returns a chat with the messages on its current branch. Only its owner, or the members of the group it's shared with, can see it.
+	GetGPTChat(ctx context.Context, in *GetGPTChatRequest, opts ...grpc.CallOption) (*GetGPTChatResponse, error)
+	// Returns a chat with its messages as a file to keep or share. It can be Markdown, JSON shaped like GetGPTChat's response,
+	// or a standalone HTML page with the chat's images embedded. Anyone who can see the chat can export it.
+	ExportGPTChat(ctx context.Context, in *ExportGPTChatRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Like ExportGPTChat, but with all of the caller's own chats, as a zip with a file for each one.
+	ExportMyGPTChats(ctx context.Context, in *ExportMyGPTChatsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Shares a chat with a group the owner is a member of. All members can read it,
+	// and if group_can_reply is set they can also continue it.
+	ShareGPTChat(ctx context.Context, in *ShareGPTChatRequest, opts ...grpc.CallOption) (*ShareGPTChatResponse, error)
+	// Makes a shared chat private again.
+	UnshareGPTChat(ctx context.Context, in *UnshareGPTChatRequest, opts ...grpc.CallOption) (*UnshareGPTChatResponse, error)
+	// Lists the chats shared with a group, most recently updated first. Only for members.
+	ListGroupChats(ctx context.Context, in *ListGroupChatsRequest, opts ...grpc.CallOption) (*ListGroupChatsResponse, error)
+	// Aggregates the model usage by user, model and day, with its tokens, images and cost. Admins only.
+	GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*GetUsageReportResponse, error)
+	// Stores a system prompt template. If one with the same name exists, this is its next version. Admins only.
+	CreateSystemPrompt(ctx context.Context, in *CreateSystemPromptRequest, opts ...grpc.CallOption) (*CreateSystemPromptResponse, error)
+	// Lists every version of the system prompts, newest first. They can be filtered by name.
+	ListSystemPrompts(ctx context.Context, in *ListSystemPromptsRequest, opts ...grpc.CallOption) (*ListSystemPromptsResponse, error)
+	// Returns a single version of a system prompt.
+	GetSystemPrompt(ctx context.Context, in *GetSystemPromptRequest, opts ...grpc.CallOption) (*GetSystemPromptResponse, error)
+	// Lists the tools GPT can be offered on NewGPTChat and ReplyToGPTChat, with the JSON schemas of their arguments.
+	ListGPTTools(ctx context.Context, in *ListGPTToolsRequest, opts ...grpc.CallOption) (*ListGPTToolsResponse, error)
+	// Lists the moderation decisions that blocked or redacted prompts and answers, newest first. Admins only.
+	ListModerationEvents(ctx context.Context, in *ListModerationEventsRequest, opts ...grpc.CallOption) (*ListModerationEventsResponse, error)
+	// Document collections group the documents a user uploads, to be searched together.
+	// Pass one as retrieval on NewGPTChat or ReplyToGPTChat and GPT answers with the most relevant excerpts at hand, citing them.
+	CreateDocumentCollection(ctx context.Context, in *CreateDocumentCollectionRequest, opts ...grpc.CallOption) (*CreateDocumentCollectionResponse, error)
+	// Lists the caller's collections, oldest first.
+	ListDocumentCollections(ctx context.Context, in *ListDocumentCollectionsRequest, opts ...grpc.CallOption) (*ListDocumentCollectionsResponse, error)
+	// Deletes a collection with all of its documents. Only its owner can do it.
+	DeleteDocumentCollection(ctx context.Context, in *DeleteDocumentCollectionRequest, opts ...grpc.CallOption) (*DeleteDocumentCollectionResponse, error)
+	// Adds a text, Markdown or PDF document to a collection. Its text is split into chunks, which are embedded and indexed.
+	// PDFs must have text on them, scanned ones only have images. Up to 20 MB, on JSON the content is base64.
+	UploadDocument(ctx context.Context, in *UploadDocumentRequest, opts ...grpc.CallOption) (*UploadDocumentResponse, error)
+	ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error)
+	DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error)
+	// Returns the chunks closest to the query, the same ones GPT would get with retrieval.
+	SearchDocuments(ctx context.Context, in *SearchDocumentsRequest, opts ...grpc.CallOption) (*SearchDocumentsResponse, error)
 }
 
 type gPTServiceClient struct {
@@ -59,101 +182,1293 @@ func (c *gPTServiceClient) ReplyToGPTChat(ctx context.Context, in *ReplyToGPTCha
 	return out, nil
 }
 
+func (c *gPTServiceClient) StreamGPTChat(ctx context.Context, in *StreamGPTChatRequest, opts ...grpc.CallOption) (GPTService_StreamGPTChatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GPTService_ServiceDesc.Streams[0], GPTService_StreamGPTChat_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gPTServiceStreamGPTChatClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GPTService_StreamGPTChatClient interface {
+	Recv() (*StreamGPTChatResponse, error)
+	grpc.ClientStream
+}
+
+type gPTServiceStreamGPTChatClient struct {
+	grpc.ClientStream
+}
+
+func (x *gPTServiceStreamGPTChatClient) Recv() (*StreamGPTChatResponse, error) {
+	m := new(StreamGPTChatResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *gPTServiceClient) NewGPTImage(ctx context.Context, in *NewGPTImageRequest, opts ...grpc.CallOption) (*NewGPTImageResponse, error) {
 	out := new(NewGPTImageResponse)
 	err := c.cc.Invoke(ctx, GPTService_NewGPTImage_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *gPTServiceClient) EditGPTImage(ctx context.Context, in *EditGPTImageRequest, opts ...grpc.CallOption) (*NewGPTImageResponse, error) {
+	out := new(NewGPTImageResponse)
+	err := c.cc.Invoke(ctx, GPTService_EditGPTImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) NewGPTImageVariation(ctx context.Context, in *NewGPTImageVariationRequest, opts ...grpc.CallOption) (*NewGPTImageResponse, error) {
+	out := new(NewGPTImageResponse)
+	err := c.cc.Invoke(ctx, GPTService_NewGPTImageVariation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) GetGPTOperation(ctx context.Context, in *GetGPTOperationRequest, opts ...grpc.CallOption) (*GPTOperation, error) {
+	out := new(GPTOperation)
+	err := c.cc.Invoke(ctx, GPTService_GetGPTOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) WaitGPTOperation(ctx context.Context, in *WaitGPTOperationRequest, opts ...grpc.CallOption) (*GPTOperation, error) {
+	out := new(GPTOperation)
+	err := c.cc.Invoke(ctx, GPTService_WaitGPTOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) CancelGPTOperation(ctx context.Context, in *CancelGPTOperationRequest, opts ...grpc.CallOption) (*GPTOperation, error) {
+	out := new(GPTOperation)
+	err := c.cc.Invoke(ctx, GPTService_CancelGPTOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) CreateImageBatch(ctx context.Context, in *CreateImageBatchRequest, opts ...grpc.CallOption) (*ImageBatchInfo, error) {
+	out := new(ImageBatchInfo)
+	err := c.cc.Invoke(ctx, GPTService_CreateImageBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) GetImageBatch(ctx context.Context, in *GetImageBatchRequest, opts ...grpc.CallOption) (*ImageBatchInfo, error) {
+	out := new(ImageBatchInfo)
+	err := c.cc.Invoke(ctx, GPTService_GetImageBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) CancelImageBatch(ctx context.Context, in *CancelImageBatchRequest, opts ...grpc.CallOption) (*ImageBatchInfo, error) {
+	out := new(ImageBatchInfo)
+	err := c.cc.Invoke(ctx, GPTService_CancelImageBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) DownloadImageBatch(ctx context.Context, in *DownloadImageBatchRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, GPTService_DownloadImageBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) GetGPTImage(ctx context.Context, in *GetGPTImageRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, GPTService_GetGPTImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) RegenerateGPTReply(ctx context.Context, in *RegenerateGPTReplyRequest, opts ...grpc.CallOption) (*RegenerateGPTReplyResponse, error) {
+	out := new(RegenerateGPTReplyResponse)
+	err := c.cc.Invoke(ctx, GPTService_RegenerateGPTReply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) EditGPTMessage(ctx context.Context, in *EditGPTMessageRequest, opts ...grpc.CallOption) (*EditGPTMessageResponse, error) {
+	out := new(EditGPTMessageResponse)
+	err := c.cc.Invoke(ctx, GPTService_EditGPTMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) SwitchGPTChatBranch(ctx context.Context, in *SwitchGPTChatBranchRequest, opts ...grpc.CallOption) (*SwitchGPTChatBranchResponse, error) {
+	out := new(SwitchGPTChatBranchResponse)
+	err := c.cc.Invoke(ctx, GPTService_SwitchGPTChatBranch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) ListMyGPTChats(ctx context.Context, in *ListMyGPTChatsRequest, opts ...grpc.CallOption) (*ListMyGPTChatsResponse, error) {
+	out := new(ListMyGPTChatsResponse)
+	err := c.cc.Invoke(ctx, GPTService_ListMyGPTChats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) RenameGPTChat(ctx context.Context, in *RenameGPTChatRequest, opts ...grpc.CallOption) (*RenameGPTChatResponse, error) {
+	out := new(RenameGPTChatResponse)
+	err := c.cc.Invoke(ctx, GPTService_RenameGPTChat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) DeleteGPTChat(ctx context.Context, in *DeleteGPTChatRequest, opts ...grpc.CallOption) (*DeleteGPTChatResponse, error) {
+	out := new(DeleteGPTChatResponse)
+	err := c.cc.Invoke(ctx, GPTService_DeleteGPTChat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) GetGPTChat(ctx context.Context, in *GetGPTChatRequest, opts ...grpc.CallOption) (*GetGPTChatResponse, error) {
+	out := new(GetGPTChatResponse)
+	err := c.cc.Invoke(ctx, GPTService_GetGPTChat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) ExportGPTChat(ctx context.Context, in *ExportGPTChatRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, GPTService_ExportGPTChat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) ExportMyGPTChats(ctx context.Context, in *ExportMyGPTChatsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, GPTService_ExportMyGPTChats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) ShareGPTChat(ctx context.Context, in *ShareGPTChatRequest, opts ...grpc.CallOption) (*ShareGPTChatResponse, error) {
+	out := new(ShareGPTChatResponse)
+	err := c.cc.Invoke(ctx, GPTService_ShareGPTChat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) UnshareGPTChat(ctx context.Context, in *UnshareGPTChatRequest, opts ...grpc.CallOption) (*UnshareGPTChatResponse, error) {
+	out := new(UnshareGPTChatResponse)
+	err := c.cc.Invoke(ctx, GPTService_UnshareGPTChat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) ListGroupChats(ctx context.Context, in *ListGroupChatsRequest, opts ...grpc.CallOption) (*ListGroupChatsResponse, error) {
+	out := new(ListGroupChatsResponse)
+	err := c.cc.Invoke(ctx, GPTService_ListGroupChats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*GetUsageReportResponse, error) {
+	out := new(GetUsageReportResponse)
+	err := c.cc.Invoke(ctx, GPTService_GetUsageReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) CreateSystemPrompt(ctx context.Context, in *CreateSystemPromptRequest, opts ...grpc.CallOption) (*CreateSystemPromptResponse, error) {
+	out := new(CreateSystemPromptResponse)
+	err := c.cc.Invoke(ctx, GPTService_CreateSystemPrompt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) ListSystemPrompts(ctx context.Context, in *ListSystemPromptsRequest, opts ...grpc.CallOption) (*ListSystemPromptsResponse, error) {
+	out := new(ListSystemPromptsResponse)
+	err := c.cc.Invoke(ctx, GPTService_ListSystemPrompts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) GetSystemPrompt(ctx context.Context, in *GetSystemPromptRequest, opts ...grpc.CallOption) (*GetSystemPromptResponse, error) {
+	out := new(GetSystemPromptResponse)
+	err := c.cc.Invoke(ctx, GPTService_GetSystemPrompt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) ListGPTTools(ctx context.Context, in *ListGPTToolsRequest, opts ...grpc.CallOption) (*ListGPTToolsResponse, error) {
+	out := new(ListGPTToolsResponse)
+	err := c.cc.Invoke(ctx, GPTService_ListGPTTools_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) ListModerationEvents(ctx context.Context, in *ListModerationEventsRequest, opts ...grpc.CallOption) (*ListModerationEventsResponse, error) {
+	out := new(ListModerationEventsResponse)
+	err := c.cc.Invoke(ctx, GPTService_ListModerationEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) CreateDocumentCollection(ctx context.Context, in *CreateDocumentCollectionRequest, opts ...grpc.CallOption) (*CreateDocumentCollectionResponse, error) {
+	out := new(CreateDocumentCollectionResponse)
+	err := c.cc.Invoke(ctx, GPTService_CreateDocumentCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) ListDocumentCollections(ctx context.Context, in *ListDocumentCollectionsRequest, opts ...grpc.CallOption) (*ListDocumentCollectionsResponse, error) {
+	out := new(ListDocumentCollectionsResponse)
+	err := c.cc.Invoke(ctx, GPTService_ListDocumentCollections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) DeleteDocumentCollection(ctx context.Context, in *DeleteDocumentCollectionRequest, opts ...grpc.CallOption) (*DeleteDocumentCollectionResponse, error) {
+	out := new(DeleteDocumentCollectionResponse)
+	err := c.cc.Invoke(ctx, GPTService_DeleteDocumentCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) UploadDocument(ctx context.Context, in *UploadDocumentRequest, opts ...grpc.CallOption) (*UploadDocumentResponse, error) {
+	out := new(UploadDocumentResponse)
+	err := c.cc.Invoke(ctx, GPTService_UploadDocument_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error) {
+	out := new(ListDocumentsResponse)
+	err := c.cc.Invoke(ctx, GPTService_ListDocuments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error) {
+	out := new(DeleteDocumentResponse)
+	err := c.cc.Invoke(ctx, GPTService_DeleteDocument_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gPTServiceClient) SearchDocuments(ctx context.Context, in *SearchDocumentsRequest, opts ...grpc.CallOption) (*SearchDocumentsResponse, error) {
+	out := new(SearchDocumentsResponse)
+	err := c.cc.Invoke(ctx, GPTService_SearchDocuments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GPTServiceServer is the server API for GPTService service.
+// All implementations must embed UnimplementedGPTServiceServer
+// for forward compatibility
+type GPTServiceServer interface {
+	NewGPTChat(context.Context, *NewGPTChatRequest) (*NewGPTChatResponse, error)
+	ReplyToGPTChat(context.Context, *ReplyToGPTChatRequest) (*ReplyToGPTChatResponse, error)
+	// Same as NewGPTChat or ReplyToGPTChat, depending on chat_id being set, but GPT's answer is
+	// sent as it's being written. Over HTTP, send 'Accept: text/event-stream' to get Server-Sent Events.
+	//
+	// The first event has the chat, then come the deltas, and the last one has the stored message.
+	// If it fails before that, the prompt isn't kept, and a chat it started is deleted.
+	StreamGPTChat(*StreamGPTChatRequest, GPTService_StreamGPTChatServer) error
+	NewGPTImage(context.Context, *NewGPTImageRequest) (*NewGPTImageResponse, error)
+	// Edits an image with DALL-E 2, following the prompt. The transparent areas of the mask are the ones edited,
+	// or the image's own if there's no mask. The result goes to the chat, which defaults to the source image's.
+	EditGPTImage(context.Context, *EditGPTImageRequest) (*NewGPTImageResponse, error)
+	// Makes a variation of an image with DALL-E 2. The result goes to the chat like with EditGPTImage.
+	NewGPTImageVariation(context.Context, *NewGPTImageVariationRequest) (*NewGPTImageResponse, error)
+	// Returns an async image's operation: whether it's done and, if it is, its image or why it failed.
+	// Only its user can see it.
+	GetGPTOperation(context.Context, *GetGPTOperationRequest) (*GPTOperation, error)
+	// Like GetGPTOperation, but waits until the operation is done or the timeout passes, whichever comes first.
+	WaitGPTOperation(context.Context, *WaitGPTOperationRequest) (*GPTOperation, error)
+	// Cancels an operation that isn't done yet. If DALL-E is already making its image, the image is discarded.
+	CancelGPTOperation(context.Context, *CancelGPTOperationRequest) (*GPTOperation, error)
+	// Makes an image for each combination of values of a prompt template's variables, or for a random sample of them.
+	// They're made in the background, one after the other, so we don't go over DALL-E's rate limits. All of them go to a new chat.
+	CreateImageBatch(context.Context, *CreateImageBatchRequest) (*ImageBatchInfo, error)
+	// Returns a batch with its progress and each of its images' operations.
+	GetImageBatch(context.Context, *GetImageBatchRequest) (*ImageBatchInfo, error)
+	// Cancels the batch's images that aren't done yet. The ones already made stay.
+	CancelImageBatch(context.Context, *CancelImageBatchRequest) (*ImageBatchInfo, error)
+	// Returns a zip with the batch's images made so far and a manifest.json describing every one of them,
+	// with its prompt, variables and status.
+	DownloadImageBatch(context.Context, *DownloadImageBatchRequest) (*httpbody.HttpBody, error)
+	// Returns an image we stored, like the ones DALL-E generates, as it is.
+	// Anyone who can see its chat can get it.
+	GetGPTImage(context.Context, *GetGPTImageRequest) (*httpbody.HttpBody, error)
+	// Asks GPT again for the last answer on the chat's branch. The new answer is an alternative of the old one,
+	// and its branch becomes the chat's. Anyone who can reply to the chat can do it, the same rules as ReplyToGPTChat apply.
+	RegenerateGPTReply(context.Context, *RegenerateGPTReplyRequest) (*RegenerateGPTReplyResponse, error)
+	// Sends a new version of one of the user's messages and answers it. The new message is an alternative of the old one,
+	// and its branch becomes the chat's. Only its sender can edit a message, if they can still reply to the chat.
+	EditGPTMessage(context.Context, *EditGPTMessageRequest) (*EditGPTMessageResponse, error)
+	// Makes the chat show and continue the branch with the message on it. If there are several, the newest one.
+	// Anyone who can reply to the chat can do it.
+	SwitchGPTChatBranch(context.Context, *SwitchGPTChatBranchRequest) (*SwitchGPTChatBranchResponse, error)
+	// Lists the caller's own chats, newest first.
+	ListMyGPTChats(context.Context, *ListMyGPTChatsRequest) (*ListMyGPTChatsResponse, error)
+	// Changes a chat's title. Only its owner can do this.
+	RenameGPTChat(context.Context, *RenameGPTChatRequest) (*RenameGPTChatResponse, error)
+	// Deletes a chat, also for the group it was shared with. Only its owner can do this.
+	DeleteGPTChat(context.Context, *DeleteGPTChatRequest) (*DeleteGPTChatResponse, error)
+	// Returns a chat with the messages on its current branch. Only its owner, or the members of the group it's shared with, can see it.
+	GetGPTChat(context.Context, *GetGPTChatRequest) (*GetGPTChatResponse, error)
+	// Returns a chat with its messages as a file to keep or share. It can be Markdown, JSON shaped like GetGPTChat's response,
+	// or a standalone HTML page with the chat's images embedded. Anyone who can see the chat can export it.
+	ExportGPTChat(context.Context, *ExportGPTChatRequest) (*httpbody.HttpBody, error)
+	// Like ExportGPTChat, but with all of the caller's own chats, as a zip with a file for each one.
+	ExportMyGPTChats(context.Context, *ExportMyGPTChatsRequest) (*httpbody.HttpBody, error)
+	// Shares a chat with a group the owner is a member of. All members can read it,
+	// and if group_can_reply is set they can also continue it.
+	ShareGPTChat(context.Context, *ShareGPTChatRequest) (*ShareGPTChatResponse, error)
+	// Makes a shared chat private again.
+	UnshareGPTChat(context.Context, *UnshareGPTChatRequest) (*UnshareGPTChatResponse, error)
+	// Lists the chats shared with a group, most recently updated first. Only for members.
+	ListGroupChats(context.Context, *ListGroupChatsRequest) (*ListGroupChatsResponse, error)
+	// Aggregates the model usage by user, model and day, with its tokens, images and cost. Admins only.
+	GetUsageReport(context.Context, *GetUsageReportRequest) (*GetUsageReportResponse, error)
+	// Stores a system prompt template. If one with the same name exists, this is its next version. Admins only.
+	CreateSystemPrompt(context.Context, *CreateSystemPromptRequest) (*CreateSystemPromptResponse, error)
+	// Lists every version of the system prompts, newest first. They can be filtered by name.
+	ListSystemPrompts(context.Context, *ListSystemPromptsRequest) (*ListSystemPromptsResponse, error)
+	// Returns a single version of a system prompt.
+	GetSystemPrompt(context.Context, *GetSystemPromptRequest) (*GetSystemPromptResponse, error)
+	// Lists the tools GPT can be offered on NewGPTChat and ReplyToGPTChat, with the JSON schemas of their arguments.
+	ListGPTTools(context.Context, *ListGPTToolsRequest) (*ListGPTToolsResponse, error)
+	// Lists the moderation decisions that blocked or redacted prompts and answers, newest first. Admins only.
+	ListModerationEvents(context.Context, *ListModerationEventsRequest) (*ListModerationEventsResponse, error)
+	// Document collections group the documents a user uploads, to be searched together.
+	// Pass one as retrieval on NewGPTChat or ReplyToGPTChat and GPT answers with the most relevant excerpts at hand, citing them.
+	CreateDocumentCollection(context.Context, *CreateDocumentCollectionRequest) (*CreateDocumentCollectionResponse, error)
+	// Lists the caller's collections, oldest first.
+	ListDocumentCollections(context.Context, *ListDocumentCollectionsRequest) (*ListDocumentCollectionsResponse, error)
+	// Deletes a collection with all of its documents. Only its owner can do it.
+	DeleteDocumentCollection(context.Context, *DeleteDocumentCollectionRequest) (*DeleteDocumentCollectionResponse, error)
+	// Adds a text, Markdown or PDF document to a collection. Its text is split into chunks, which are embedded and indexed.
+	// PDFs must have text on them, scanned ones only have images. Up to 20 MB, on JSON the content is base64.
+	UploadDocument(context.Context, *UploadDocumentRequest) (*UploadDocumentResponse, error)
+	ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error)
+	DeleteDocument(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error)
+	// Returns the chunks closest to the query, the same ones GPT would get with retrieval.
+	SearchDocuments(context.Context, *SearchDocumentsRequest) (*SearchDocumentsResponse, error)
+	mustEmbedUnimplementedGPTServiceServer()
+}
+
+// UnimplementedGPTServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGPTServiceServer struct {
+}
+
+func (UnimplementedGPTServiceServer) NewGPTChat(context.Context, *NewGPTChatRequest) (*NewGPTChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewGPTChat not implemented")
+}
+func (UnimplementedGPTServiceServer) ReplyToGPTChat(context.Context, *ReplyToGPTChatRequest) (*ReplyToGPTChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyToGPTChat not implemented")
+}
+func (UnimplementedGPTServiceServer) StreamGPTChat(*StreamGPTChatRequest, GPTService_StreamGPTChatServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGPTChat not implemented")
+}
+func (UnimplementedGPTServiceServer) NewGPTImage(context.Context, *NewGPTImageRequest) (*NewGPTImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewGPTImage not implemented")
+}
+func (UnimplementedGPTServiceServer) EditGPTImage(context.Context, *EditGPTImageRequest) (*NewGPTImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditGPTImage not implemented")
+}
+func (UnimplementedGPTServiceServer) NewGPTImageVariation(context.Context, *NewGPTImageVariationRequest) (*NewGPTImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewGPTImageVariation not implemented")
+}
+func (UnimplementedGPTServiceServer) GetGPTOperation(context.Context, *GetGPTOperationRequest) (*GPTOperation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGPTOperation not implemented")
+}
+func (UnimplementedGPTServiceServer) WaitGPTOperation(context.Context, *WaitGPTOperationRequest) (*GPTOperation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitGPTOperation not implemented")
+}
+func (UnimplementedGPTServiceServer) CancelGPTOperation(context.Context, *CancelGPTOperationRequest) (*GPTOperation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGPTOperation not implemented")
+}
+func (UnimplementedGPTServiceServer) CreateImageBatch(context.Context, *CreateImageBatchRequest) (*ImageBatchInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateImageBatch not implemented")
+}
+func (UnimplementedGPTServiceServer) GetImageBatch(context.Context, *GetImageBatchRequest) (*ImageBatchInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImageBatch not implemented")
+}
+func (UnimplementedGPTServiceServer) CancelImageBatch(context.Context, *CancelImageBatchRequest) (*ImageBatchInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelImageBatch not implemented")
+}
+func (UnimplementedGPTServiceServer) DownloadImageBatch(context.Context, *DownloadImageBatchRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadImageBatch not implemented")
+}
+func (UnimplementedGPTServiceServer) GetGPTImage(context.Context, *GetGPTImageRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGPTImage not implemented")
+}
+func (UnimplementedGPTServiceServer) RegenerateGPTReply(context.Context, *RegenerateGPTReplyRequest) (*RegenerateGPTReplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateGPTReply not implemented")
+}
+func (UnimplementedGPTServiceServer) EditGPTMessage(context.Context, *EditGPTMessageRequest) (*EditGPTMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditGPTMessage not implemented")
+}
+func (UnimplementedGPTServiceServer) SwitchGPTChatBranch(context.Context, *SwitchGPTChatBranchRequest) (*SwitchGPTChatBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchGPTChatBranch not implemented")
+}
+func (UnimplementedGPTServiceServer) ListMyGPTChats(context.Context, *ListMyGPTChatsRequest) (*ListMyGPTChatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyGPTChats not implemented")
+}
+func (UnimplementedGPTServiceServer) RenameGPTChat(context.Context, *RenameGPTChatRequest) (*RenameGPTChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameGPTChat not implemented")
+}
+func (UnimplementedGPTServiceServer) DeleteGPTChat(context.Context, *DeleteGPTChatRequest) (*DeleteGPTChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGPTChat not implemented")
+}
+func (UnimplementedGPTServiceServer) GetGPTChat(context.Context, *GetGPTChatRequest) (*GetGPTChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGPTChat not implemented")
+}
+func (UnimplementedGPTServiceServer) ExportGPTChat(context.Context, *ExportGPTChatRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGPTChat not implemented")
+}
+func (UnimplementedGPTServiceServer) ExportMyGPTChats(context.Context, *ExportMyGPTChatsRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMyGPTChats not implemented")
+}
+func (UnimplementedGPTServiceServer) ShareGPTChat(context.Context, *ShareGPTChatRequest) (*ShareGPTChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareGPTChat not implemented")
+}
+func (UnimplementedGPTServiceServer) UnshareGPTChat(context.Context, *UnshareGPTChatRequest) (*UnshareGPTChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareGPTChat not implemented")
+}
+func (UnimplementedGPTServiceServer) ListGroupChats(context.Context, *ListGroupChatsRequest) (*ListGroupChatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupChats not implemented")
+}
+func (UnimplementedGPTServiceServer) GetUsageReport(context.Context, *GetUsageReportRequest) (*GetUsageReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageReport not implemented")
+}
+func (UnimplementedGPTServiceServer) CreateSystemPrompt(context.Context, *CreateSystemPromptRequest) (*CreateSystemPromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSystemPrompt not implemented")
+}
+func (UnimplementedGPTServiceServer) ListSystemPrompts(context.Context, *ListSystemPromptsRequest) (*ListSystemPromptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSystemPrompts not implemented")
+}
+func (UnimplementedGPTServiceServer) GetSystemPrompt(context.Context, *GetSystemPromptRequest) (*GetSystemPromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemPrompt not implemented")
+}
+func (UnimplementedGPTServiceServer) ListGPTTools(context.Context, *ListGPTToolsRequest) (*ListGPTToolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGPTTools not implemented")
+}
+func (UnimplementedGPTServiceServer) ListModerationEvents(context.Context, *ListModerationEventsRequest) (*ListModerationEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationEvents not implemented")
+}
+func (UnimplementedGPTServiceServer) CreateDocumentCollection(context.Context, *CreateDocumentCollectionRequest) (*CreateDocumentCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDocumentCollection not implemented")
+}
+func (UnimplementedGPTServiceServer) ListDocumentCollections(context.Context, *ListDocumentCollectionsRequest) (*ListDocumentCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDocumentCollections not implemented")
+}
+func (UnimplementedGPTServiceServer) DeleteDocumentCollection(context.Context, *DeleteDocumentCollectionRequest) (*DeleteDocumentCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDocumentCollection not implemented")
+}
+func (UnimplementedGPTServiceServer) UploadDocument(context.Context, *UploadDocumentRequest) (*UploadDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadDocument not implemented")
+}
+func (UnimplementedGPTServiceServer) ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDocuments not implemented")
+}
+func (UnimplementedGPTServiceServer) DeleteDocument(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDocument not implemented")
+}
+func (UnimplementedGPTServiceServer) SearchDocuments(context.Context, *SearchDocumentsRequest) (*SearchDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchDocuments not implemented")
+}
+func (UnimplementedGPTServiceServer) mustEmbedUnimplementedGPTServiceServer() {}
+
+// UnsafeGPTServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GPTServiceServer will
+// result in compilation errors.
+type UnsafeGPTServiceServer interface {
+	mustEmbedUnimplementedGPTServiceServer()
+}
+
+func RegisterGPTServiceServer(s grpc.ServiceRegistrar, srv GPTServiceServer) {
+	s.RegisterService(&GPTService_ServiceDesc, srv)
+}
+
+func _GPTService_NewGPTChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewGPTChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).NewGPTChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_NewGPTChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).NewGPTChat(ctx, req.(*NewGPTChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_ReplyToGPTChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyToGPTChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).ReplyToGPTChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_ReplyToGPTChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).ReplyToGPTChat(ctx, req.(*ReplyToGPTChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_StreamGPTChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamGPTChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GPTServiceServer).StreamGPTChat(m, &gPTServiceStreamGPTChatServer{stream})
+}
+
+type GPTService_StreamGPTChatServer interface {
+	Send(*StreamGPTChatResponse) error
+	grpc.ServerStream
+}
+
+type gPTServiceStreamGPTChatServer struct {
+	grpc.ServerStream
+}
+
+func (x *gPTServiceStreamGPTChatServer) Send(m *StreamGPTChatResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GPTService_NewGPTImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewGPTImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).NewGPTImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_NewGPTImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).NewGPTImage(ctx, req.(*NewGPTImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_EditGPTImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditGPTImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).EditGPTImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_EditGPTImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).EditGPTImage(ctx, req.(*EditGPTImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_NewGPTImageVariation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewGPTImageVariationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).NewGPTImageVariation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_NewGPTImageVariation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).NewGPTImageVariation(ctx, req.(*NewGPTImageVariationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_GetGPTOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGPTOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).GetGPTOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_GetGPTOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).GetGPTOperation(ctx, req.(*GetGPTOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_WaitGPTOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitGPTOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).WaitGPTOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_WaitGPTOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).WaitGPTOperation(ctx, req.(*WaitGPTOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_CancelGPTOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelGPTOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).CancelGPTOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_CancelGPTOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).CancelGPTOperation(ctx, req.(*CancelGPTOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_CreateImageBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateImageBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).CreateImageBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_CreateImageBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).CreateImageBatch(ctx, req.(*CreateImageBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_GetImageBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImageBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).GetImageBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_GetImageBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).GetImageBatch(ctx, req.(*GetImageBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_CancelImageBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelImageBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).CancelImageBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_CancelImageBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).CancelImageBatch(ctx, req.(*CancelImageBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_DownloadImageBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadImageBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).DownloadImageBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_DownloadImageBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).DownloadImageBatch(ctx, req.(*DownloadImageBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_GetGPTImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGPTImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).GetGPTImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_GetGPTImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).GetGPTImage(ctx, req.(*GetGPTImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_RegenerateGPTReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateGPTReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).RegenerateGPTReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_RegenerateGPTReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).RegenerateGPTReply(ctx, req.(*RegenerateGPTReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_EditGPTMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditGPTMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).EditGPTMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_EditGPTMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).EditGPTMessage(ctx, req.(*EditGPTMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_SwitchGPTChatBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchGPTChatBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).SwitchGPTChatBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_SwitchGPTChatBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).SwitchGPTChatBranch(ctx, req.(*SwitchGPTChatBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_ListMyGPTChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyGPTChatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).ListMyGPTChats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_ListMyGPTChats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).ListMyGPTChats(ctx, req.(*ListMyGPTChatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_RenameGPTChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameGPTChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).RenameGPTChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_RenameGPTChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).RenameGPTChat(ctx, req.(*RenameGPTChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_DeleteGPTChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGPTChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).DeleteGPTChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_DeleteGPTChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).DeleteGPTChat(ctx, req.(*DeleteGPTChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_GetGPTChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGPTChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).GetGPTChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_GetGPTChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).GetGPTChat(ctx, req.(*GetGPTChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_ExportGPTChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGPTChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).ExportGPTChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_ExportGPTChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).ExportGPTChat(ctx, req.(*ExportGPTChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_ExportMyGPTChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMyGPTChatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).ExportMyGPTChats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_ExportMyGPTChats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).ExportMyGPTChats(ctx, req.(*ExportMyGPTChatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_ShareGPTChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareGPTChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).ShareGPTChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_ShareGPTChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).ShareGPTChat(ctx, req.(*ShareGPTChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_UnshareGPTChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareGPTChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).UnshareGPTChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_UnshareGPTChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).UnshareGPTChat(ctx, req.(*UnshareGPTChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// GPTServiceServer is the server API for GPTService service.
-// All implementations must embed UnimplementedGPTServiceServer
-// for forward compatibility
-type GPTServiceServer interface {
-	NewGPTChat(context.Context, *NewGPTChatRequest) (*NewGPTChatResponse, error)
-	ReplyToGPTChat(context.Context, *ReplyToGPTChatRequest) (*ReplyToGPTChatResponse, error)
-	NewGPTImage(context.Context, *NewGPTImageRequest) (*NewGPTImageResponse, error)
-	mustEmbedUnimplementedGPTServiceServer()
+func _GPTService_ListGroupChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupChatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).ListGroupChats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_ListGroupChats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).ListGroupChats(ctx, req.(*ListGroupChatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedGPTServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedGPTServiceServer struct {
+func _GPTService_GetUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).GetUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_GetUsageReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).GetUsageReport(ctx, req.(*GetUsageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedGPTServiceServer) NewGPTChat(context.Context, *NewGPTChatRequest) (*NewGPTChatResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewGPTChat not implemented")
+func _GPTService_CreateSystemPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSystemPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).CreateSystemPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_CreateSystemPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).CreateSystemPrompt(ctx, req.(*CreateSystemPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGPTServiceServer) ReplyToGPTChat(context.Context, *ReplyToGPTChatRequest) (*ReplyToGPTChatResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReplyToGPTChat not implemented")
+
+func _GPTService_ListSystemPrompts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSystemPromptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).ListSystemPrompts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_ListSystemPrompts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).ListSystemPrompts(ctx, req.(*ListSystemPromptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGPTServiceServer) NewGPTImage(context.Context, *NewGPTImageRequest) (*NewGPTImageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewGPTImage not implemented")
+
+func _GPTService_GetSystemPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).GetSystemPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_GetSystemPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).GetSystemPrompt(ctx, req.(*GetSystemPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGPTServiceServer) mustEmbedUnimplementedGPTServiceServer() {}
 
-// UnsafeGPTServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GPTServiceServer will
-// result in compilation errors.
-type UnsafeGPTServiceServer interface {
-	mustEmbedUnimplementedGPTServiceServer()
+func _GPTService_ListGPTTools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGPTToolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).ListGPTTools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_ListGPTTools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).ListGPTTools(ctx, req.(*ListGPTToolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGPTServiceServer(s grpc.ServiceRegistrar, srv GPTServiceServer) {
-	s.RegisterService(&GPTService_ServiceDesc, srv)
+func _GPTService_ListModerationEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).ListModerationEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_ListModerationEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).ListModerationEvents(ctx, req.(*ListModerationEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GPTService_NewGPTChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewGPTChatRequest)
+func _GPTService_CreateDocumentCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDocumentCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GPTServiceServer).NewGPTChat(ctx, in)
+		return srv.(GPTServiceServer).CreateDocumentCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GPTService_NewGPTChat_FullMethodName,
+		FullMethod: GPTService_CreateDocumentCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GPTServiceServer).NewGPTChat(ctx, req.(*NewGPTChatRequest))
+		return srv.(GPTServiceServer).CreateDocumentCollection(ctx, req.(*CreateDocumentCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GPTService_ReplyToGPTChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReplyToGPTChatRequest)
+func _GPTService_ListDocumentCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentCollectionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GPTServiceServer).ReplyToGPTChat(ctx, in)
+		return srv.(GPTServiceServer).ListDocumentCollections(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GPTService_ReplyToGPTChat_FullMethodName,
+		FullMethod: GPTService_ListDocumentCollections_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GPTServiceServer).ReplyToGPTChat(ctx, req.(*ReplyToGPTChatRequest))
+		return srv.(GPTServiceServer).ListDocumentCollections(ctx, req.(*ListDocumentCollectionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GPTService_NewGPTImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewGPTImageRequest)
+func _GPTService_DeleteDocumentCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GPTServiceServer).NewGPTImage(ctx, in)
+		return srv.(GPTServiceServer).DeleteDocumentCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GPTService_NewGPTImage_FullMethodName,
+		FullMethod: GPTService_DeleteDocumentCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GPTServiceServer).NewGPTImage(ctx, req.(*NewGPTImageRequest))
+		return srv.(GPTServiceServer).DeleteDocumentCollection(ctx, req.(*DeleteDocumentCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_UploadDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).UploadDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_UploadDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).UploadDocument(ctx, req.(*UploadDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_ListDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).ListDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_ListDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).ListDocuments(ctx, req.(*ListDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_DeleteDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).DeleteDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_DeleteDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).DeleteDocument(ctx, req.(*DeleteDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GPTService_SearchDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPTServiceServer).SearchDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GPTService_SearchDocuments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPTServiceServer).SearchDocuments(ctx, req.(*SearchDocumentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -177,7 +1492,153 @@ var GPTService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NewGPTImage",
 			Handler:    _GPTService_NewGPTImage_Handler,
 		},
+		{
+			MethodName: "EditGPTImage",
+			Handler:    _GPTService_EditGPTImage_Handler,
+		},
+		{
+			MethodName: "NewGPTImageVariation",
+			Handler:    _GPTService_NewGPTImageVariation_Handler,
+		},
+		{
+			MethodName: "GetGPTOperation",
+			Handler:    _GPTService_GetGPTOperation_Handler,
+		},
+		{
+			MethodName: "WaitGPTOperation",
+			Handler:    _GPTService_WaitGPTOperation_Handler,
+		},
+		{
+			MethodName: "CancelGPTOperation",
+			Handler:    _GPTService_CancelGPTOperation_Handler,
+		},
+		{
+			MethodName: "CreateImageBatch",
+			Handler:    _GPTService_CreateImageBatch_Handler,
+		},
+		{
+			MethodName: "GetImageBatch",
+			Handler:    _GPTService_GetImageBatch_Handler,
+		},
+		{
+			MethodName: "CancelImageBatch",
+			Handler:    _GPTService_CancelImageBatch_Handler,
+		},
+		{
+			MethodName: "DownloadImageBatch",
+			Handler:    _GPTService_DownloadImageBatch_Handler,
+		},
+		{
+			MethodName: "GetGPTImage",
+			Handler:    _GPTService_GetGPTImage_Handler,
+		},
+		{
+			MethodName: "RegenerateGPTReply",
+			Handler:    _GPTService_RegenerateGPTReply_Handler,
+		},
+		{
+			MethodName: "EditGPTMessage",
+			Handler:    _GPTService_EditGPTMessage_Handler,
+		},
+		{
+			MethodName: "SwitchGPTChatBranch",
+			Handler:    _GPTService_SwitchGPTChatBranch_Handler,
+		},
+		{
+			MethodName: "ListMyGPTChats",
+			Handler:    _GPTService_ListMyGPTChats_Handler,
+		},
+		{
+			MethodName: "RenameGPTChat",
+			Handler:    _GPTService_RenameGPTChat_Handler,
+		},
+		{
+			MethodName: "DeleteGPTChat",
+			Handler:    _GPTService_DeleteGPTChat_Handler,
+		},
+		{
+			MethodName: "GetGPTChat",
+			Handler:    _GPTService_GetGPTChat_Handler,
+		},
+		{
+			MethodName: "ExportGPTChat",
+			Handler:    _GPTService_ExportGPTChat_Handler,
+		},
+		{
+			MethodName: "ExportMyGPTChats",
+			Handler:    _GPTService_ExportMyGPTChats_Handler,
+		},
+		{
+			MethodName: "ShareGPTChat",
+			Handler:    _GPTService_ShareGPTChat_Handler,
+		},
+		{
+			MethodName: "UnshareGPTChat",
+			Handler:    _GPTService_UnshareGPTChat_Handler,
+		},
+		{
+			MethodName: "ListGroupChats",
+			Handler:    _GPTService_ListGroupChats_Handler,
+		},
+		{
+			MethodName: "GetUsageReport",
+			Handler:    _GPTService_GetUsageReport_Handler,
+		},
+		{
+			MethodName: "CreateSystemPrompt",
+			Handler:    _GPTService_CreateSystemPrompt_Handler,
+		},
+		{
+			MethodName: "ListSystemPrompts",
+			Handler:    _GPTService_ListSystemPrompts_Handler,
+		},
+		{
+			MethodName: "GetSystemPrompt",
+			Handler:    _GPTService_GetSystemPrompt_Handler,
+		},
+		{
+			MethodName: "ListGPTTools",
+			Handler:    _GPTService_ListGPTTools_Handler,
+		},
+		{
+			MethodName: "ListModerationEvents",
+			Handler:    _GPTService_ListModerationEvents_Handler,
+		},
+		{
+			MethodName: "CreateDocumentCollection",
+			Handler:    _GPTService_CreateDocumentCollection_Handler,
+		},
+		{
+			MethodName: "ListDocumentCollections",
+			Handler:    _GPTService_ListDocumentCollections_Handler,
+		},
+		{
+			MethodName: "DeleteDocumentCollection",
+			Handler:    _GPTService_DeleteDocumentCollection_Handler,
+		},
+		{
+			MethodName: "UploadDocument",
+			Handler:    _GPTService_UploadDocument_Handler,
+		},
+		{
+			MethodName: "ListDocuments",
+			Handler:    _GPTService_ListDocuments_Handler,
+		},
+		{
+			MethodName: "DeleteDocument",
+			Handler:    _GPTService_DeleteDocument_Handler,
+		},
+		{
+			MethodName: "SearchDocuments",
+			Handler:    _GPTService_SearchDocuments_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamGPTChat",
+			Handler:       _GPTService_StreamGPTChat_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "gpt.proto",
 }
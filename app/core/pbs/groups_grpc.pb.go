@@ -19,10 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GroupsService_CreateGroup_FullMethodName       = "/pbs.GroupsService/CreateGroup"
-	GroupsService_GetGroup_FullMethodName          = "/pbs.GroupsService/GetGroup"
-	GroupsService_InviteToGroup_FullMethodName     = "/pbs.GroupsService/InviteToGroup"
-	GroupsService_AnswerGroupInvite_FullMethodName = "/pbs.GroupsService/AnswerGroupInvite"
+	GroupsService_CreateGroup_FullMethodName           = "/pbs.GroupsService/CreateGroup"
+	GroupsService_GetGroup_FullMethodName              = "/pbs.GroupsService/GetGroup"
+	GroupsService_InviteToGroup_FullMethodName         = "/pbs.GroupsService/InviteToGroup"
+	GroupsService_AnswerGroupInvite_FullMethodName     = "/pbs.GroupsService/AnswerGroupInvite"
+	GroupsService_RevokeGroupInvite_FullMethodName     = "/pbs.GroupsService/RevokeGroupInvite"
+	GroupsService_RenameGroup_FullMethodName           = "/pbs.GroupsService/RenameGroup"
+	GroupsService_DeleteGroup_FullMethodName           = "/pbs.GroupsService/DeleteGroup"
+	GroupsService_LeaveGroup_FullMethodName            = "/pbs.GroupsService/LeaveGroup"
+	GroupsService_RemoveMember_FullMethodName          = "/pbs.GroupsService/RemoveMember"
+	GroupsService_ChangeMemberRole_FullMethodName      = "/pbs.GroupsService/ChangeMemberRole"
+	GroupsService_TransferOwnership_FullMethodName     = "/pbs.GroupsService/TransferOwnership"
+	GroupsService_CreateGroupInviteLink_FullMethodName = "/pbs.GroupsService/CreateGroupInviteLink"
+	GroupsService_ListGroupInviteLinks_FullMethodName  = "/pbs.GroupsService/ListGroupInviteLinks"
+	GroupsService_RevokeGroupInviteLink_FullMethodName = "/pbs.GroupsService/RevokeGroupInviteLink"
+	GroupsService_JoinGroupByLink_FullMethodName       = "/pbs.GroupsService/JoinGroupByLink"
+	GroupsService_ListGroupActivity_FullMethodName     = "/pbs.GroupsService/ListGroupActivity"
+	GroupsService_ListGroupBudgets_FullMethodName      = "/pbs.GroupsService/ListGroupBudgets"
+	GroupsService_SetGroupBudget_FullMethodName        = "/pbs.GroupsService/SetGroupBudget"
+	GroupsService_DeleteGroupBudget_FullMethodName     = "/pbs.GroupsService/DeleteGroupBudget"
+	GroupsService_ListMyGroupInvites_FullMethodName    = "/pbs.GroupsService/ListMyGroupInvites"
 )
 
 // GroupsServiceClient is the client API for GroupsService service.
@@ -35,6 +51,41 @@ type GroupsServiceClient interface {
 	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error)
 	InviteToGroup(ctx context.Context, in *InviteToGroupRequest, opts ...grpc.CallOption) (*InviteToGroupResponse, error)
 	AnswerGroupInvite(ctx context.Context, in *AnswerGroupInviteRequest, opts ...grpc.CallOption) (*AnswerGroupInviteResponse, error)
+	RevokeGroupInvite(ctx context.Context, in *RevokeGroupInviteRequest, opts ...grpc.CallOption) (*RevokeGroupInviteResponse, error)
+	// Renames the group. Only the owner and admins can do this.
+	RenameGroup(ctx context.Context, in *RenameGroupRequest, opts ...grpc.CallOption) (*RenameGroupResponse, error)
+	// Deletes the group. Only the owner can do this.
+	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+	// Removes the authenticated user from the group.
+	// The owner can't leave without transferring the ownership first.
+	LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*LeaveGroupResponse, error)
+	// Kicks a member out of the group. The owner can remove anyone,
+	// admins can only remove plain members.
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
+	// Changes a member's role between admin and member. Only the owner can do this.
+	ChangeMemberRole(ctx context.Context, in *ChangeMemberRoleRequest, opts ...grpc.CallOption) (*ChangeMemberRoleResponse, error)
+	// Makes another member the owner of the group. The old owner becomes an admin.
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error)
+	// Creates a shareable invite link for the group. Only the owner can do this.
+	// The link's token is only returned here, we just store its hash.
+	CreateGroupInviteLink(ctx context.Context, in *CreateGroupInviteLinkRequest, opts ...grpc.CallOption) (*CreateGroupInviteLinkResponse, error)
+	// Lists the group's invite links and who joined through each one.
+	ListGroupInviteLinks(ctx context.Context, in *ListGroupInviteLinksRequest, opts ...grpc.CallOption) (*ListGroupInviteLinksResponse, error)
+	RevokeGroupInviteLink(ctx context.Context, in *RevokeGroupInviteLinkRequest, opts ...grpc.CallOption) (*RevokeGroupInviteLinkResponse, error)
+	// Joins the group the invite link belongs to, with the link's role.
+	JoinGroupByLink(ctx context.Context, in *JoinGroupByLinkRequest, opts ...grpc.CallOption) (*JoinGroupByLinkResponse, error)
+	// Lists what happened in the group, newest first. Only members can see it.
+	ListGroupActivity(ctx context.Context, in *ListGroupActivityRequest, opts ...grpc.CallOption) (*ListGroupActivityResponse, error)
+	// Lists the group's monthly GPT budgets with how much is left of each one.
+	// The owner and admins see all of them, members only the group-wide ones and their own.
+	ListGroupBudgets(ctx context.Context, in *ListGroupBudgetsRequest, opts ...grpc.CallOption) (*ListGroupBudgetsResponse, error)
+	// Creates or changes a monthly GPT budget, for the whole group or for one of its members.
+	// A member's budget also caps what they use without sending a group. Only the owner can do this.
+	SetGroupBudget(ctx context.Context, in *SetGroupBudgetRequest, opts ...grpc.CallOption) (*SetGroupBudgetResponse, error)
+	// Deletes a budget, so that usage is no longer capped. Only the owner can do this.
+	DeleteGroupBudget(ctx context.Context, in *DeleteGroupBudgetRequest, opts ...grpc.CallOption) (*DeleteGroupBudgetResponse, error)
+	// Lists the pending (not answered, not expired) group invites of the user.
+	ListMyGroupInvites(ctx context.Context, in *ListMyGroupInvitesRequest, opts ...grpc.CallOption) (*ListMyGroupInvitesResponse, error)
 }
 
 type groupsServiceClient struct {
@@ -81,6 +132,150 @@ func (c *groupsServiceClient) AnswerGroupInvite(ctx context.Context, in *AnswerG
 	return out, nil
 }
 
+func (c *groupsServiceClient) RevokeGroupInvite(ctx context.Context, in *RevokeGroupInviteRequest, opts ...grpc.CallOption) (*RevokeGroupInviteResponse, error) {
+	out := new(RevokeGroupInviteResponse)
+	err := c.cc.Invoke(ctx, GroupsService_RevokeGroupInvite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) RenameGroup(ctx context.Context, in *RenameGroupRequest, opts ...grpc.CallOption) (*RenameGroupResponse, error) {
+	out := new(RenameGroupResponse)
+	err := c.cc.Invoke(ctx, GroupsService_RenameGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
+	out := new(DeleteGroupResponse)
+	err := c.cc.Invoke(ctx, GroupsService_DeleteGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*LeaveGroupResponse, error) {
+	out := new(LeaveGroupResponse)
+	err := c.cc.Invoke(ctx, GroupsService_LeaveGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error) {
+	out := new(RemoveMemberResponse)
+	err := c.cc.Invoke(ctx, GroupsService_RemoveMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ChangeMemberRole(ctx context.Context, in *ChangeMemberRoleRequest, opts ...grpc.CallOption) (*ChangeMemberRoleResponse, error) {
+	out := new(ChangeMemberRoleResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ChangeMemberRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error) {
+	out := new(TransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, GroupsService_TransferOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) CreateGroupInviteLink(ctx context.Context, in *CreateGroupInviteLinkRequest, opts ...grpc.CallOption) (*CreateGroupInviteLinkResponse, error) {
+	out := new(CreateGroupInviteLinkResponse)
+	err := c.cc.Invoke(ctx, GroupsService_CreateGroupInviteLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListGroupInviteLinks(ctx context.Context, in *ListGroupInviteLinksRequest, opts ...grpc.CallOption) (*ListGroupInviteLinksResponse, error) {
+	out := new(ListGroupInviteLinksResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListGroupInviteLinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) RevokeGroupInviteLink(ctx context.Context, in *RevokeGroupInviteLinkRequest, opts ...grpc.CallOption) (*RevokeGroupInviteLinkResponse, error) {
+	out := new(RevokeGroupInviteLinkResponse)
+	err := c.cc.Invoke(ctx, GroupsService_RevokeGroupInviteLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) JoinGroupByLink(ctx context.Context, in *JoinGroupByLinkRequest, opts ...grpc.CallOption) (*JoinGroupByLinkResponse, error) {
+	out := new(JoinGroupByLinkResponse)
+	err := c.cc.Invoke(ctx, GroupsService_JoinGroupByLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListGroupActivity(ctx context.Context, in *ListGroupActivityRequest, opts ...grpc.CallOption) (*ListGroupActivityResponse, error) {
+	out := new(ListGroupActivityResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListGroupActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListGroupBudgets(ctx context.Context, in *ListGroupBudgetsRequest, opts ...grpc.CallOption) (*ListGroupBudgetsResponse, error) {
+	out := new(ListGroupBudgetsResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListGroupBudgets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) SetGroupBudget(ctx context.Context, in *SetGroupBudgetRequest, opts ...grpc.CallOption) (*SetGroupBudgetResponse, error) {
+	out := new(SetGroupBudgetResponse)
+	err := c.cc.Invoke(ctx, GroupsService_SetGroupBudget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) DeleteGroupBudget(ctx context.Context, in *DeleteGroupBudgetRequest, opts ...grpc.CallOption) (*DeleteGroupBudgetResponse, error) {
+	out := new(DeleteGroupBudgetResponse)
+	err := c.cc.Invoke(ctx, GroupsService_DeleteGroupBudget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsServiceClient) ListMyGroupInvites(ctx context.Context, in *ListMyGroupInvitesRequest, opts ...grpc.CallOption) (*ListMyGroupInvitesResponse, error) {
+	out := new(ListMyGroupInvitesResponse)
+	err := c.cc.Invoke(ctx, GroupsService_ListMyGroupInvites_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupsServiceServer is the server API for GroupsService service.
 // All implementations must embed UnimplementedGroupsServiceServer
 // for forward compatibility
@@ -91,6 +286,41 @@ type GroupsServiceServer interface {
 	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
 	InviteToGroup(context.Context, *InviteToGroupRequest) (*InviteToGroupResponse, error)
 	AnswerGroupInvite(context.Context, *AnswerGroupInviteRequest) (*AnswerGroupInviteResponse, error)
+	RevokeGroupInvite(context.Context, *RevokeGroupInviteRequest) (*RevokeGroupInviteResponse, error)
+	// Renames the group. Only the owner and admins can do this.
+	RenameGroup(context.Context, *RenameGroupRequest) (*RenameGroupResponse, error)
+	// Deletes the group. Only the owner can do this.
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	// Removes the authenticated user from the group.
+	// The owner can't leave without transferring the ownership first.
+	LeaveGroup(context.Context, *LeaveGroupRequest) (*LeaveGroupResponse, error)
+	// Kicks a member out of the group. The owner can remove anyone,
+	// admins can only remove plain members.
+	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
+	// Changes a member's role between admin and member. Only the owner can do this.
+	ChangeMemberRole(context.Context, *ChangeMemberRoleRequest) (*ChangeMemberRoleResponse, error)
+	// Makes another member the owner of the group. The old owner becomes an admin.
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error)
+	// Creates a shareable invite link for the group. Only the owner can do this.
+	// The link's token is only returned here, we just store its hash.
+	CreateGroupInviteLink(context.Context, *CreateGroupInviteLinkRequest) (*CreateGroupInviteLinkResponse, error)
+	// Lists the group's invite links and who joined through each one.
+	ListGroupInviteLinks(context.Context, *ListGroupInviteLinksRequest) (*ListGroupInviteLinksResponse, error)
+	RevokeGroupInviteLink(context.Context, *RevokeGroupInviteLinkRequest) (*RevokeGroupInviteLinkResponse, error)
+	// Joins the group the invite link belongs to, with the link's role.
+	JoinGroupByLink(context.Context, *JoinGroupByLinkRequest) (*JoinGroupByLinkResponse, error)
+	// Lists what happened in the group, newest first. Only members can see it.
+	ListGroupActivity(context.Context, *ListGroupActivityRequest) (*ListGroupActivityResponse, error)
+	// Lists the group's monthly GPT budgets with how much is left of each one.
+	// The owner and admins see all of them, members only the group-wide ones and their own.
+	ListGroupBudgets(context.Context, *ListGroupBudgetsRequest) (*ListGroupBudgetsResponse, error)
+	// Creates or changes a monthly GPT budget, for the whole group or for one of its members.
+	// A member's budget also caps what they use without sending a group. Only the owner can do this.
+	SetGroupBudget(context.Context, *SetGroupBudgetRequest) (*SetGroupBudgetResponse, error)
+	// Deletes a budget, so that usage is no longer capped. Only the owner can do this.
+	DeleteGroupBudget(context.Context, *DeleteGroupBudgetRequest) (*DeleteGroupBudgetResponse, error)
+	// Lists the pending (not answered, not expired) group invites of the user.
+	ListMyGroupInvites(context.Context, *ListMyGroupInvitesRequest) (*ListMyGroupInvitesResponse, error)
 	mustEmbedUnimplementedGroupsServiceServer()
 }
 
@@ -110,6 +340,54 @@ func (UnimplementedGroupsServiceServer) InviteToGroup(context.Context, *InviteTo
 func (UnimplementedGroupsServiceServer) AnswerGroupInvite(context.Context, *AnswerGroupInviteRequest) (*AnswerGroupInviteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnswerGroupInvite not implemented")
 }
+func (UnimplementedGroupsServiceServer) RevokeGroupInvite(context.Context, *RevokeGroupInviteRequest) (*RevokeGroupInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeGroupInvite not implemented")
+}
+func (UnimplementedGroupsServiceServer) RenameGroup(context.Context, *RenameGroupRequest) (*RenameGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameGroup not implemented")
+}
+func (UnimplementedGroupsServiceServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedGroupsServiceServer) LeaveGroup(context.Context, *LeaveGroupRequest) (*LeaveGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
+}
+func (UnimplementedGroupsServiceServer) RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedGroupsServiceServer) ChangeMemberRole(context.Context, *ChangeMemberRoleRequest) (*ChangeMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMemberRole not implemented")
+}
+func (UnimplementedGroupsServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedGroupsServiceServer) CreateGroupInviteLink(context.Context, *CreateGroupInviteLinkRequest) (*CreateGroupInviteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupInviteLink not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListGroupInviteLinks(context.Context, *ListGroupInviteLinksRequest) (*ListGroupInviteLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupInviteLinks not implemented")
+}
+func (UnimplementedGroupsServiceServer) RevokeGroupInviteLink(context.Context, *RevokeGroupInviteLinkRequest) (*RevokeGroupInviteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeGroupInviteLink not implemented")
+}
+func (UnimplementedGroupsServiceServer) JoinGroupByLink(context.Context, *JoinGroupByLinkRequest) (*JoinGroupByLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinGroupByLink not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListGroupActivity(context.Context, *ListGroupActivityRequest) (*ListGroupActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupActivity not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListGroupBudgets(context.Context, *ListGroupBudgetsRequest) (*ListGroupBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupBudgets not implemented")
+}
+func (UnimplementedGroupsServiceServer) SetGroupBudget(context.Context, *SetGroupBudgetRequest) (*SetGroupBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupBudget not implemented")
+}
+func (UnimplementedGroupsServiceServer) DeleteGroupBudget(context.Context, *DeleteGroupBudgetRequest) (*DeleteGroupBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupBudget not implemented")
+}
+func (UnimplementedGroupsServiceServer) ListMyGroupInvites(context.Context, *ListMyGroupInvitesRequest) (*ListMyGroupInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyGroupInvites not implemented")
+}
 func (UnimplementedGroupsServiceServer) mustEmbedUnimplementedGroupsServiceServer() {}
 
 // UnsafeGroupsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -195,6 +473,294 @@ func _GroupsService_AnswerGroupInvite_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupsService_RevokeGroupInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGroupInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).RevokeGroupInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_RevokeGroupInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).RevokeGroupInvite(ctx, req.(*RevokeGroupInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_RenameGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).RenameGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_RenameGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).RenameGroup(ctx, req.(*RenameGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).LeaveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_LeaveGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).LeaveGroup(ctx, req.(*LeaveGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ChangeMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ChangeMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ChangeMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ChangeMemberRole(ctx, req.(*ChangeMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_CreateGroupInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).CreateGroupInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_CreateGroupInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).CreateGroupInviteLink(ctx, req.(*CreateGroupInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ListGroupInviteLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupInviteLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListGroupInviteLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListGroupInviteLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListGroupInviteLinks(ctx, req.(*ListGroupInviteLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_RevokeGroupInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGroupInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).RevokeGroupInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_RevokeGroupInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).RevokeGroupInviteLink(ctx, req.(*RevokeGroupInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_JoinGroupByLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGroupByLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).JoinGroupByLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_JoinGroupByLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).JoinGroupByLink(ctx, req.(*JoinGroupByLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ListGroupActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListGroupActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListGroupActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListGroupActivity(ctx, req.(*ListGroupActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ListGroupBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListGroupBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListGroupBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListGroupBudgets(ctx, req.(*ListGroupBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_SetGroupBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).SetGroupBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_SetGroupBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).SetGroupBudget(ctx, req.(*SetGroupBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_DeleteGroupBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).DeleteGroupBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_DeleteGroupBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).DeleteGroupBudget(ctx, req.(*DeleteGroupBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupsService_ListMyGroupInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyGroupInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).ListMyGroupInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_ListMyGroupInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).ListMyGroupInvites(ctx, req.(*ListMyGroupInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GroupsService_ServiceDesc is the grpc.ServiceDesc for GroupsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -218,6 +784,70 @@ var GroupsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnswerGroupInvite",
 			Handler:    _GroupsService_AnswerGroupInvite_Handler,
 		},
+		{
+			MethodName: "RevokeGroupInvite",
+			Handler:    _GroupsService_RevokeGroupInvite_Handler,
+		},
+		{
+			MethodName: "RenameGroup",
+			Handler:    _GroupsService_RenameGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _GroupsService_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "LeaveGroup",
+			Handler:    _GroupsService_LeaveGroup_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _GroupsService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "ChangeMemberRole",
+			Handler:    _GroupsService_ChangeMemberRole_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _GroupsService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "CreateGroupInviteLink",
+			Handler:    _GroupsService_CreateGroupInviteLink_Handler,
+		},
+		{
+			MethodName: "ListGroupInviteLinks",
+			Handler:    _GroupsService_ListGroupInviteLinks_Handler,
+		},
+		{
+			MethodName: "RevokeGroupInviteLink",
+			Handler:    _GroupsService_RevokeGroupInviteLink_Handler,
+		},
+		{
+			MethodName: "JoinGroupByLink",
+			Handler:    _GroupsService_JoinGroupByLink_Handler,
+		},
+		{
+			MethodName: "ListGroupActivity",
+			Handler:    _GroupsService_ListGroupActivity_Handler,
+		},
+		{
+			MethodName: "ListGroupBudgets",
+			Handler:    _GroupsService_ListGroupBudgets_Handler,
+		},
+		{
+			MethodName: "SetGroupBudget",
+			Handler:    _GroupsService_SetGroupBudget_Handler,
+		},
+		{
+			MethodName: "DeleteGroupBudget",
+			Handler:    _GroupsService_DeleteGroupBudget_Handler,
+		},
+		{
+			MethodName: "ListMyGroupInvites",
+			Handler:    _GroupsService_ListMyGroupInvites_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "groups.proto",
@@ -0,0 +1,1094 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.2
+// source: weather.proto
+
+package pbs
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Temperatures are in Celsius on METRIC, which is the default, in Fahrenheit on IMPERIAL and in Kelvin on STANDARD.
+// Wind speeds are in meters per second, except on IMPERIAL, where they're in miles per hour.
+type WeatherUnits int32
+
+const (
+	WeatherUnits_WEATHER_UNITS_UNSPECIFIED WeatherUnits = 0
+	WeatherUnits_WEATHER_UNITS_METRIC      WeatherUnits = 1
+	WeatherUnits_WEATHER_UNITS_IMPERIAL    WeatherUnits = 2
+	WeatherUnits_WEATHER_UNITS_STANDARD    WeatherUnits = 3
+)
+
+// Enum value maps for WeatherUnits.
+var (
+	WeatherUnits_name = map[int32]string{
+		0: "WEATHER_UNITS_UNSPECIFIED",
+		1: "WEATHER_UNITS_METRIC",
+		2: "WEATHER_UNITS_IMPERIAL",
+		3: "WEATHER_UNITS_STANDARD",
+	}
+	WeatherUnits_value = map[string]int32{
+		"WEATHER_UNITS_UNSPECIFIED": 0,
+		"WEATHER_UNITS_METRIC":      1,
+		"WEATHER_UNITS_IMPERIAL":    2,
+		"WEATHER_UNITS_STANDARD":    3,
+	}
+)
+
+func (x WeatherUnits) Enum() *WeatherUnits {
+	p := new(WeatherUnits)
+	*p = x
+	return p
+}
+
+func (x WeatherUnits) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WeatherUnits) Descriptor() protoreflect.EnumDescriptor {
+	return file_weather_proto_enumTypes[0].Descriptor()
+}
+
+func (WeatherUnits) Type() protoreflect.EnumType {
+	return &file_weather_proto_enumTypes[0]
+}
+
+func (x WeatherUnits) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WeatherUnits.Descriptor instead.
+func (WeatherUnits) EnumDescriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{0}
+}
+
+type GetCurrentWeatherRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lat   *float64     `protobuf:"fixed64,1,opt,name=lat,proto3,oneof" json:"lat,omitempty"`
+	Lon   *float64     `protobuf:"fixed64,2,opt,name=lon,proto3,oneof" json:"lon,omitempty"`
+	City  string       `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Units WeatherUnits `protobuf:"varint,4,opt,name=units,proto3,enum=pbs.WeatherUnits" json:"units,omitempty"`
+}
+
+func (x *GetCurrentWeatherRequest) Reset() {
+	*x = GetCurrentWeatherRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCurrentWeatherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentWeatherRequest) ProtoMessage() {}
+
+func (x *GetCurrentWeatherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentWeatherRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentWeatherRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCurrentWeatherRequest) GetLat() float64 {
+	if x != nil && x.Lat != nil {
+		return *x.Lat
+	}
+	return 0
+}
+
+func (x *GetCurrentWeatherRequest) GetLon() float64 {
+	if x != nil && x.Lon != nil {
+		return *x.Lon
+	}
+	return 0
+}
+
+func (x *GetCurrentWeatherRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *GetCurrentWeatherRequest) GetUnits() WeatherUnits {
+	if x != nil {
+		return x.Units
+	}
+	return WeatherUnits_WEATHER_UNITS_UNSPECIFIED
+}
+
+type GetCurrentWeatherResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *WeatherLocationInfo `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Weather  *WeatherConditions   `protobuf:"bytes,2,opt,name=weather,proto3" json:"weather,omitempty"`
+	Units    WeatherUnits         `protobuf:"varint,3,opt,name=units,proto3,enum=pbs.WeatherUnits" json:"units,omitempty"`
+	Sunrise  string               `protobuf:"bytes,4,opt,name=sunrise,proto3" json:"sunrise,omitempty"`
+	Sunset   string               `protobuf:"bytes,5,opt,name=sunset,proto3" json:"sunset,omitempty"`
+}
+
+func (x *GetCurrentWeatherResponse) Reset() {
+	*x = GetCurrentWeatherResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCurrentWeatherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentWeatherResponse) ProtoMessage() {}
+
+func (x *GetCurrentWeatherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentWeatherResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentWeatherResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCurrentWeatherResponse) GetLocation() *WeatherLocationInfo {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GetCurrentWeatherResponse) GetWeather() *WeatherConditions {
+	if x != nil {
+		return x.Weather
+	}
+	return nil
+}
+
+func (x *GetCurrentWeatherResponse) GetUnits() WeatherUnits {
+	if x != nil {
+		return x.Units
+	}
+	return WeatherUnits_WEATHER_UNITS_UNSPECIFIED
+}
+
+func (x *GetCurrentWeatherResponse) GetSunrise() string {
+	if x != nil {
+		return x.Sunrise
+	}
+	return ""
+}
+
+func (x *GetCurrentWeatherResponse) GetSunset() string {
+	if x != nil {
+		return x.Sunset
+	}
+	return ""
+}
+
+type GetWeatherForecastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lat   *float64     `protobuf:"fixed64,1,opt,name=lat,proto3,oneof" json:"lat,omitempty"`
+	Lon   *float64     `protobuf:"fixed64,2,opt,name=lon,proto3,oneof" json:"lon,omitempty"`
+	City  string       `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Units WeatherUnits `protobuf:"varint,4,opt,name=units,proto3,enum=pbs.WeatherUnits" json:"units,omitempty"`
+	Days  *int32       `protobuf:"varint,5,opt,name=days,proto3,oneof" json:"days,omitempty"` // Defaults to all 5.
+}
+
+func (x *GetWeatherForecastRequest) Reset() {
+	*x = GetWeatherForecastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWeatherForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeatherForecastRequest) ProtoMessage() {}
+
+func (x *GetWeatherForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeatherForecastRequest.ProtoReflect.Descriptor instead.
+func (*GetWeatherForecastRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetWeatherForecastRequest) GetLat() float64 {
+	if x != nil && x.Lat != nil {
+		return *x.Lat
+	}
+	return 0
+}
+
+func (x *GetWeatherForecastRequest) GetLon() float64 {
+	if x != nil && x.Lon != nil {
+		return *x.Lon
+	}
+	return 0
+}
+
+func (x *GetWeatherForecastRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *GetWeatherForecastRequest) GetUnits() WeatherUnits {
+	if x != nil {
+		return x.Units
+	}
+	return WeatherUnits_WEATHER_UNITS_UNSPECIFIED
+}
+
+func (x *GetWeatherForecastRequest) GetDays() int32 {
+	if x != nil && x.Days != nil {
+		return *x.Days
+	}
+	return 0
+}
+
+type GetWeatherForecastResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location *WeatherLocationInfo `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Forecast []*WeatherConditions `protobuf:"bytes,2,rep,name=forecast,proto3" json:"forecast,omitempty"`
+	Units    WeatherUnits         `protobuf:"varint,3,opt,name=units,proto3,enum=pbs.WeatherUnits" json:"units,omitempty"`
+}
+
+func (x *GetWeatherForecastResponse) Reset() {
+	*x = GetWeatherForecastResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWeatherForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeatherForecastResponse) ProtoMessage() {}
+
+func (x *GetWeatherForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeatherForecastResponse.ProtoReflect.Descriptor instead.
+func (*GetWeatherForecastResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetWeatherForecastResponse) GetLocation() *WeatherLocationInfo {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GetWeatherForecastResponse) GetForecast() []*WeatherConditions {
+	if x != nil {
+		return x.Forecast
+	}
+	return nil
+}
+
+func (x *GetWeatherForecastResponse) GetUnits() WeatherUnits {
+	if x != nil {
+		return x.Units
+	}
+	return WeatherUnits_WEATHER_UNITS_UNSPECIFIED
+}
+
+type GeocodeCityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City  string `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Limit *int32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"` // Defaults to 5.
+}
+
+func (x *GeocodeCityRequest) Reset() {
+	*x = GeocodeCityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeocodeCityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeocodeCityRequest) ProtoMessage() {}
+
+func (x *GeocodeCityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeocodeCityRequest.ProtoReflect.Descriptor instead.
+func (*GeocodeCityRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GeocodeCityRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *GeocodeCityRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type GeocodeCityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locations []*WeatherLocationInfo `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+}
+
+func (x *GeocodeCityResponse) Reset() {
+	*x = GeocodeCityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeocodeCityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeocodeCityResponse) ProtoMessage() {}
+
+func (x *GeocodeCityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeocodeCityResponse.ProtoReflect.Descriptor instead.
+func (*GeocodeCityResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GeocodeCityResponse) GetLocations() []*WeatherLocationInfo {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type WeatherLocationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Country string  `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	State   string  `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Lat     float64 `protobuf:"fixed64,4,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon     float64 `protobuf:"fixed64,5,opt,name=lon,proto3" json:"lon,omitempty"`
+}
+
+func (x *WeatherLocationInfo) Reset() {
+	*x = WeatherLocationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeatherLocationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeatherLocationInfo) ProtoMessage() {}
+
+func (x *WeatherLocationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeatherLocationInfo.ProtoReflect.Descriptor instead.
+func (*WeatherLocationInfo) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WeatherLocationInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WeatherLocationInfo) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *WeatherLocationInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *WeatherLocationInfo) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *WeatherLocationInfo) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+type WeatherConditions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time              string  `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Main              string  `protobuf:"bytes,2,opt,name=main,proto3" json:"main,omitempty"` // Like "Rain" or "Clouds".
+	Description       string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Icon              string  `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Temperature       float64 `protobuf:"fixed64,5,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	FeelsLike         float64 `protobuf:"fixed64,6,opt,name=feels_like,proto3" json:"feels_like,omitempty"`
+	TemperatureMin    float64 `protobuf:"fixed64,7,opt,name=temperature_min,proto3" json:"temperature_min,omitempty"`
+	TemperatureMax    float64 `protobuf:"fixed64,8,opt,name=temperature_max,proto3" json:"temperature_max,omitempty"`
+	Humidity          int32   `protobuf:"varint,9,opt,name=humidity,proto3" json:"humidity,omitempty"`  // In %.
+	Pressure          int32   `protobuf:"varint,10,opt,name=pressure,proto3" json:"pressure,omitempty"` // In hPa.
+	WindSpeed         float64 `protobuf:"fixed64,11,opt,name=wind_speed,proto3" json:"wind_speed,omitempty"`
+	WindDeg           int32   `protobuf:"varint,12,opt,name=wind_deg,proto3" json:"wind_deg,omitempty"`
+	Clouds            int32   `protobuf:"varint,13,opt,name=clouds,proto3" json:"clouds,omitempty"`                          // In %.
+	RainMm            float64 `protobuf:"fixed64,14,opt,name=rain_mm,proto3" json:"rain_mm,omitempty"`                       // On the last hour, or on the 3 hours of a forecast.
+	PrecipitationProb float64 `protobuf:"fixed64,15,opt,name=precipitation_prob,proto3" json:"precipitation_prob,omitempty"` // From 0 to 1. Only on forecasts.
+}
+
+func (x *WeatherConditions) Reset() {
+	*x = WeatherConditions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weather_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeatherConditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeatherConditions) ProtoMessage() {}
+
+func (x *WeatherConditions) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeatherConditions.ProtoReflect.Descriptor instead.
+func (*WeatherConditions) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WeatherConditions) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *WeatherConditions) GetMain() string {
+	if x != nil {
+		return x.Main
+	}
+	return ""
+}
+
+func (x *WeatherConditions) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WeatherConditions) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *WeatherConditions) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *WeatherConditions) GetFeelsLike() float64 {
+	if x != nil {
+		return x.FeelsLike
+	}
+	return 0
+}
+
+func (x *WeatherConditions) GetTemperatureMin() float64 {
+	if x != nil {
+		return x.TemperatureMin
+	}
+	return 0
+}
+
+func (x *WeatherConditions) GetTemperatureMax() float64 {
+	if x != nil {
+		return x.TemperatureMax
+	}
+	return 0
+}
+
+func (x *WeatherConditions) GetHumidity() int32 {
+	if x != nil {
+		return x.Humidity
+	}
+	return 0
+}
+
+func (x *WeatherConditions) GetPressure() int32 {
+	if x != nil {
+		return x.Pressure
+	}
+	return 0
+}
+
+func (x *WeatherConditions) GetWindSpeed() float64 {
+	if x != nil {
+		return x.WindSpeed
+	}
+	return 0
+}
+
+func (x *WeatherConditions) GetWindDeg() int32 {
+	if x != nil {
+		return x.WindDeg
+	}
+	return 0
+}
+
+func (x *WeatherConditions) GetClouds() int32 {
+	if x != nil {
+		return x.Clouds
+	}
+	return 0
+}
+
+func (x *WeatherConditions) GetRainMm() float64 {
+	if x != nil {
+		return x.RainMm
+	}
+	return 0
+}
+
+func (x *WeatherConditions) GetPrecipitationProb() float64 {
+	if x != nil {
+		return x.PrecipitationProb
+	}
+	return 0
+}
+
+var File_weather_proto protoreflect.FileDescriptor
+
+var file_weather_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x03, 0x70, 0x62, 0x73, 0x1a, 0x24, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x62,
+	0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x28, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68,
+	0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x37, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
+	0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17,
+	0xba, 0x48, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0x40, 0x29, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0xc0, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17,
+	0xba, 0x48, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0x40, 0x29, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0xc0, 0x48, 0x01, 0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x31,
+	0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x61, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x6f,
+	0x6e, 0x22, 0xde, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x57, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x57, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75,
+	0x6e, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x6e, 0x73,
+	0x65, 0x74, 0x22, 0x88, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xba,
+	0x48, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0x40, 0x29, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x80, 0x56, 0xc0, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xba,
+	0x48, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0x40, 0x29, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x80, 0x66, 0xc0, 0x48, 0x01, 0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x1b, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x64, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a,
+	0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70,
+	0x62, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73,
+	0x12, 0x22, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09,
+	0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x05, 0x28, 0x01, 0x48, 0x02, 0x52, 0x04, 0x64, 0x61, 0x79,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x61, 0x74, 0x42, 0x06, 0x0a, 0x04,
+	0x5f, 0x6c, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x22, 0xaf, 0x01,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x62, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x22,
+	0x66, 0x0a, 0x12, 0x47, 0x65, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18,
+	0x64, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x05, 0x28,
+	0x01, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4d, 0x0a, 0x13, 0x47, 0x65, 0x6f, 0x63, 0x6f,
+	0x64, 0x65, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x13, 0x57, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
+	0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x15, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x03,
+	0xe0, 0x41, 0x03, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x15, 0x0a, 0x03, 0x6c, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x22,
+	0xa8, 0x04, 0x0a, 0x11, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x17,
+	0x0a, 0x04, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
+	0x03, 0x52, 0x04, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
+	0x03, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17,
+	0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
+	0x03, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x03, 0xe0, 0x41,
+	0x03, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x23,
+	0x0a, 0x0a, 0x66, 0x65, 0x65, 0x6c, 0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x6c, 0x73, 0x5f, 0x6c,
+	0x69, 0x6b, 0x65, 0x12, 0x2d, 0x0a, 0x0f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x42, 0x03, 0xe0, 0x41,
+	0x03, 0x52, 0x0f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6d,
+	0x69, 0x6e, 0x12, 0x2d, 0x0a, 0x0f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x42, 0x03, 0xe0, 0x41, 0x03,
+	0x52, 0x0f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x61,
+	0x78, 0x12, 0x1f, 0x0a, 0x08, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x08, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x77, 0x69,
+	0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x64,
+	0x5f, 0x64, 0x65, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52,
+	0x08, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x67, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x06,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x6d,
+	0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x07, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x6d, 0x6d, 0x12, 0x33, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x12, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x2a, 0x7f, 0x0a, 0x0c, 0x57, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x45,
+	0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x57, 0x45, 0x41,
+	0x54, 0x48, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49,
+	0x43, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x55,
+	0x4e, 0x49, 0x54, 0x53, 0x5f, 0x49, 0x4d, 0x50, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x10, 0x02, 0x12,
+	0x1a, 0x0a, 0x16, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x53,
+	0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x03, 0x32, 0xb7, 0x04, 0x0a, 0x0e,
+	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xbd,
+	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x69, 0x92, 0x41, 0x4b, 0x0a, 0x07, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x2a, 0x13, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x4a, 0x2b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x24, 0x12,
+	0x22, 0x0a, 0x20, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0xc3,
+	0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x92, 0x41, 0x4d, 0x0a, 0x07, 0x57, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x2a, 0x14, 0x67, 0x65, 0x74, 0x5f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x2c, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x25, 0x12, 0x23, 0x0a, 0x21, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2f, 0x66, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0x9e, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x6f, 0x63, 0x6f, 0x64, 0x65,
+	0x43, 0x69, 0x74, 0x79, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x6f, 0x63, 0x6f,
+	0x64, 0x65, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x92, 0x41, 0x3e, 0x0a, 0x07, 0x57, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x2a, 0x0c, 0x67, 0x65, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63,
+	0x69, 0x74, 0x79, 0x4a, 0x25, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x1e, 0x12, 0x1c, 0x0a, 0x1a,
+	0x1a, 0x18, 0x2e, 0x70, 0x62, 0x73, 0x2e, 0x47, 0x65, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2f, 0x67, 0x65,
+	0x6f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0xb2, 0x04, 0x92, 0x41, 0xf6, 0x03, 0x12, 0x1a, 0x0a, 0x11,
+	0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2d, 0x69, 0x6d, 0x70,
+	0x6c, 0x22, 0x00, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x1a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68,
+	0x6f, 0x73, 0x74, 0x3a, 0x38, 0x30, 0x38, 0x33, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x52, 0x75, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x6e, 0x12, 0x6c, 0x32, 0x6a, 0x7b, 0x22, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x3a, 0x20, 0x6c, 0x61, 0x74, 0x20, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c,
+	0x20, 0x74, 0x6f, 0x20, 0x2d, 0x39, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x65, 0x73, 0x73,
+	0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74,
+	0x6f, 0x20, 0x39, 0x30, 0x2e, 0x22, 0x7d, 0x52, 0x24, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1d,
+	0x12, 0x1b, 0x32, 0x19, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x22, 0x75, 0x6e,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x2e, 0x22, 0x7d, 0x52, 0x28, 0x0a,
+	0x03, 0x34, 0x30, 0x33, 0x12, 0x21, 0x12, 0x1f, 0x32, 0x1d, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x3a, 0x20, 0x22, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x20, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x2e, 0x22, 0x7d, 0x52, 0x32, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x2b,
+	0x12, 0x29, 0x32, 0x27, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x20, 0x22, 0x6e,
+	0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x3a, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x22, 0x7d, 0x52, 0x4f, 0x0a, 0x03, 0x35,
+	0x30, 0x30, 0x12, 0x48, 0x12, 0x46, 0x32, 0x44, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x3a, 0x20, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2c, 0x20, 0x73, 0x6f, 0x6d, 0x65, 0x74, 0x68,
+	0x69, 0x6e, 0x67, 0x20, 0x77, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x20, 0x6f,
+	0x6e, 0x20, 0x6f, 0x75, 0x72, 0x20, 0x65, 0x6e, 0x64, 0x2e, 0x22, 0x7d, 0x52, 0x54, 0x0a, 0x03,
+	0x35, 0x30, 0x33, 0x12, 0x4d, 0x12, 0x4b, 0x32, 0x49, 0x7b, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x3a, 0x20, 0x22, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x75, 0x6e, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x3a, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74, 0x20, 0x62, 0x65, 0x20, 0x72, 0x65,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x20, 0x72, 0x69, 0x67, 0x68, 0x74, 0x20, 0x6e, 0x6f, 0x77, 0x2e,
+	0x22, 0x7d, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67,
+	0x69, 0x6c, 0x70, 0x65, 0x72, 0x6f, 0x70, 0x69, 0x6f, 0x6c, 0x61, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x2d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2d, 0x69, 0x6d, 0x70, 0x6c, 0x2f, 0x61, 0x70,
+	0x70, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x62, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_weather_proto_rawDescOnce sync.Once
+	file_weather_proto_rawDescData = file_weather_proto_rawDesc
+)
+
+func file_weather_proto_rawDescGZIP() []byte {
+	file_weather_proto_rawDescOnce.Do(func() {
+		file_weather_proto_rawDescData = protoimpl.X.CompressGZIP(file_weather_proto_rawDescData)
+	})
+	return file_weather_proto_rawDescData
+}
+
+var file_weather_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_weather_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_weather_proto_goTypes = []interface{}{
+	(WeatherUnits)(0),                  // 0: pbs.WeatherUnits
+	(*GetCurrentWeatherRequest)(nil),   // 1: pbs.GetCurrentWeatherRequest
+	(*GetCurrentWeatherResponse)(nil),  // 2: pbs.GetCurrentWeatherResponse
+	(*GetWeatherForecastRequest)(nil),  // 3: pbs.GetWeatherForecastRequest
+	(*GetWeatherForecastResponse)(nil), // 4: pbs.GetWeatherForecastResponse
+	(*GeocodeCityRequest)(nil),         // 5: pbs.GeocodeCityRequest
+	(*GeocodeCityResponse)(nil),        // 6: pbs.GeocodeCityResponse
+	(*WeatherLocationInfo)(nil),        // 7: pbs.WeatherLocationInfo
+	(*WeatherConditions)(nil),          // 8: pbs.WeatherConditions
+}
+var file_weather_proto_depIdxs = []int32{
+	0,  // 0: pbs.GetCurrentWeatherRequest.units:type_name -> pbs.WeatherUnits
+	7,  // 1: pbs.GetCurrentWeatherResponse.location:type_name -> pbs.WeatherLocationInfo
+	8,  // 2: pbs.GetCurrentWeatherResponse.weather:type_name -> pbs.WeatherConditions
+	0,  // 3: pbs.GetCurrentWeatherResponse.units:type_name -> pbs.WeatherUnits
+	0,  // 4: pbs.GetWeatherForecastRequest.units:type_name -> pbs.WeatherUnits
+	7,  // 5: pbs.GetWeatherForecastResponse.location:type_name -> pbs.WeatherLocationInfo
+	8,  // 6: pbs.GetWeatherForecastResponse.forecast:type_name -> pbs.WeatherConditions
+	0,  // 7: pbs.GetWeatherForecastResponse.units:type_name -> pbs.WeatherUnits
+	7,  // 8: pbs.GeocodeCityResponse.locations:type_name -> pbs.WeatherLocationInfo
+	1,  // 9: pbs.WeatherService.GetCurrentWeather:input_type -> pbs.GetCurrentWeatherRequest
+	3,  // 10: pbs.WeatherService.GetWeatherForecast:input_type -> pbs.GetWeatherForecastRequest
+	5,  // 11: pbs.WeatherService.GeocodeCity:input_type -> pbs.GeocodeCityRequest
+	2,  // 12: pbs.WeatherService.GetCurrentWeather:output_type -> pbs.GetCurrentWeatherResponse
+	4,  // 13: pbs.WeatherService.GetWeatherForecast:output_type -> pbs.GetWeatherForecastResponse
+	6,  // 14: pbs.WeatherService.GeocodeCity:output_type -> pbs.GeocodeCityResponse
+	12, // [12:15] is the sub-list for method output_type
+	9,  // [9:12] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_weather_proto_init() }
+func file_weather_proto_init() {
+	if File_weather_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_weather_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCurrentWeatherRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCurrentWeatherResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWeatherForecastRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWeatherForecastResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeocodeCityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeocodeCityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeatherLocationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weather_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeatherConditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_weather_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_weather_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_weather_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_weather_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_weather_proto_goTypes,
+		DependencyIndexes: file_weather_proto_depIdxs,
+		EnumInfos:         file_weather_proto_enumTypes,
+		MessageInfos:      file_weather_proto_msgTypes,
+	}.Build()
+	File_weather_proto = out.File
+	file_weather_proto_rawDesc = nil
+	file_weather_proto_goTypes = nil
+	file_weather_proto_depIdxs = nil
+}
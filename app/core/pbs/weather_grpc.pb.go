@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.2
+// source: weather.proto
+
+package pbs
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	WeatherService_GetCurrentWeather_FullMethodName  = "/pbs.WeatherService/GetCurrentWeather"
+	WeatherService_GetWeatherForecast_FullMethodName = "/pbs.WeatherService/GetWeatherForecast"
+	WeatherService_GeocodeCity_FullMethodName        = "/pbs.WeatherService/GeocodeCity"
+)
+
+// WeatherServiceClient is the client API for WeatherService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WeatherServiceClient interface {
+	// Returns the weather right now.
+	GetCurrentWeather(ctx context.Context, in *GetCurrentWeatherRequest, opts ...grpc.CallOption) (*GetCurrentWeatherResponse, error)
+	// Returns the forecast for the next days, every 3 hours.
+	GetWeatherForecast(ctx context.Context, in *GetWeatherForecastRequest, opts ...grpc.CallOption) (*GetWeatherForecastResponse, error)
+	// Returns the places with that name, the most relevant first.
+	GeocodeCity(ctx context.Context, in *GeocodeCityRequest, opts ...grpc.CallOption) (*GeocodeCityResponse, error)
+}
+
+type weatherServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWeatherServiceClient(cc grpc.ClientConnInterface) WeatherServiceClient {
+	return &weatherServiceClient{cc}
+}
+
+func (c *weatherServiceClient) GetCurrentWeather(ctx context.Context, in *GetCurrentWeatherRequest, opts ...grpc.CallOption) (*GetCurrentWeatherResponse, error) {
+	out := new(GetCurrentWeatherResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetCurrentWeather_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) GetWeatherForecast(ctx context.Context, in *GetWeatherForecastRequest, opts ...grpc.CallOption) (*GetWeatherForecastResponse, error) {
+	out := new(GetWeatherForecastResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetWeatherForecast_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) GeocodeCity(ctx context.Context, in *GeocodeCityRequest, opts ...grpc.CallOption) (*GeocodeCityResponse, error) {
+	out := new(GeocodeCityResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GeocodeCity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WeatherServiceServer is the server API for WeatherService service.
+// All implementations must embed UnimplementedWeatherServiceServer
+// for forward compatibility
+type WeatherServiceServer interface {
+	// Returns the weather right now.
+	GetCurrentWeather(context.Context, *GetCurrentWeatherRequest) (*GetCurrentWeatherResponse, error)
+	// Returns the forecast for the next days, every 3 hours.
+	GetWeatherForecast(context.Context, *GetWeatherForecastRequest) (*GetWeatherForecastResponse, error)
+	// Returns the places with that name, the most relevant first.
+	GeocodeCity(context.Context, *GeocodeCityRequest) (*GeocodeCityResponse, error)
+	mustEmbedUnimplementedWeatherServiceServer()
+}
+
+// UnimplementedWeatherServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWeatherServiceServer struct {
+}
+
+func (UnimplementedWeatherServiceServer) GetCurrentWeather(context.Context, *GetCurrentWeatherRequest) (*GetCurrentWeatherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentWeather not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetWeatherForecast(context.Context, *GetWeatherForecastRequest) (*GetWeatherForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWeatherForecast not implemented")
+}
+func (UnimplementedWeatherServiceServer) GeocodeCity(context.Context, *GeocodeCityRequest) (*GeocodeCityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeocodeCity not implemented")
+}
+func (UnimplementedWeatherServiceServer) mustEmbedUnimplementedWeatherServiceServer() {}
+
+// UnsafeWeatherServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WeatherServiceServer will
+// result in compilation errors.
+type UnsafeWeatherServiceServer interface {
+	mustEmbedUnimplementedWeatherServiceServer()
+}
+
+func RegisterWeatherServiceServer(s grpc.ServiceRegistrar, srv WeatherServiceServer) {
+	s.RegisterService(&WeatherService_ServiceDesc, srv)
+}
+
+func _WeatherService_GetCurrentWeather_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentWeatherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetCurrentWeather(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetCurrentWeather_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetCurrentWeather(ctx, req.(*GetCurrentWeatherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_GetWeatherForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWeatherForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetWeatherForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetWeatherForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetWeatherForecast(ctx, req.(*GetWeatherForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_GeocodeCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeocodeCityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GeocodeCity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GeocodeCity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GeocodeCity(ctx, req.(*GeocodeCityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WeatherService_ServiceDesc is the grpc.ServiceDesc for WeatherService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WeatherService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pbs.WeatherService",
+	HandlerType: (*WeatherServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCurrentWeather",
+			Handler:    _WeatherService_GetCurrentWeather_Handler,
+		},
+		{
+			MethodName: "GetWeatherForecast",
+			Handler:    _WeatherService_GetWeatherForecast_Handler,
+		},
+		{
+			MethodName: "GeocodeCity",
+			Handler:    _WeatherService_GeocodeCity_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "weather.proto",
+}
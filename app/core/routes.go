@@ -61,14 +61,79 @@ var Routes = map[string]Route{
 
 	// 👨‍👨‍👧‍👦 Groups Service
 	"GetGroup":          {"GetGroup", RouteAuthUser},
-	"CreateGroup":       {"CreateGroup", RouteAuthSelf},
-	"InviteToGroup":     {"InviteToGroup", RouteAuthSelf},
+	"CreateGroup":       {"CreateGroup", RouteAuthUser},
+	"InviteToGroup":     {"InviteToGroup", RouteAuthUser},
 	"AnswerGroupInvite": {"AnswerGroupInvite", RouteAuthSelf},
+	"RevokeGroupInvite": {"RevokeGroupInvite", RouteAuthUser},
+	"RenameGroup":       {"RenameGroup", RouteAuthUser},
+	"DeleteGroup":       {"DeleteGroup", RouteAuthUser},
+	"LeaveGroup":        {"LeaveGroup", RouteAuthUser},
+	"RemoveMember":      {"RemoveMember", RouteAuthUser},
+	"ChangeMemberRole":  {"ChangeMemberRole", RouteAuthUser},
+	"TransferOwnership": {"TransferOwnership", RouteAuthUser},
+
+	"CreateGroupInviteLink": {"CreateGroupInviteLink", RouteAuthUser},
+	"ListGroupInviteLinks":  {"ListGroupInviteLinks", RouteAuthUser},
+	"RevokeGroupInviteLink": {"RevokeGroupInviteLink", RouteAuthUser},
+	"JoinGroupByLink":       {"JoinGroupByLink", RouteAuthUser},
+	"ListGroupActivity":     {"ListGroupActivity", RouteAuthUser},
+	"ListGroupBudgets":      {"ListGroupBudgets", RouteAuthUser},
+	"SetGroupBudget":        {"SetGroupBudget", RouteAuthUser},
+	"DeleteGroupBudget":     {"DeleteGroupBudget", RouteAuthUser},
+	"ListMyGroupInvites":    {"ListMyGroupInvites", RouteAuthSelf},
 
 	// 🤖 GPT Service
-	"NewGPTChat":     {"NewGPTChat", RouteAuthPublic},
-	"ReplyToGPTChat": {"ReplyToGPTChat", RouteAuthPublic},
-	"NewGPTImage":    {"NewGPTImage", RouteAuthPublic},
+	"NewGPTChat":     {"NewGPTChat", RouteAuthUser},
+	"ReplyToGPTChat": {"ReplyToGPTChat", RouteAuthUser},
+	"StreamGPTChat":  {"StreamGPTChat", RouteAuthUser},
+	"NewGPTImage":    {"NewGPTImage", RouteAuthUser},
+	"GetGPTImage":    {"GetGPTImage", RouteAuthUser},
+	"EditGPTImage":   {"EditGPTImage", RouteAuthUser},
+	"ListMyGPTChats": {"ListMyGPTChats", RouteAuthUser},
+	"GetGPTChat":     {"GetGPTChat", RouteAuthUser},
+	"RenameGPTChat":  {"RenameGPTChat", RouteAuthUser},
+	"DeleteGPTChat":  {"DeleteGPTChat", RouteAuthUser},
+	"ShareGPTChat":   {"ShareGPTChat", RouteAuthUser},
+	"UnshareGPTChat": {"UnshareGPTChat", RouteAuthUser},
+	"ListGroupChats": {"ListGroupChats", RouteAuthUser},
+	"GetUsageReport": {"GetUsageReport", RouteAuthAdmin},
+
+	"NewGPTImageVariation": {"NewGPTImageVariation", RouteAuthUser},
+
+	"CreateSystemPrompt": {"CreateSystemPrompt", RouteAuthAdmin},
+	"ListSystemPrompts":  {"ListSystemPrompts", RouteAuthUser},
+	"GetSystemPrompt":    {"GetSystemPrompt", RouteAuthUser},
+	"ListGPTTools":       {"ListGPTTools", RouteAuthUser},
+
+	"ListModerationEvents": {"ListModerationEvents", RouteAuthAdmin},
+
+	"GetGPTOperation":    {"GetGPTOperation", RouteAuthUser},
+	"WaitGPTOperation":   {"WaitGPTOperation", RouteAuthUser},
+	"CancelGPTOperation": {"CancelGPTOperation", RouteAuthUser},
+
+	"CreateImageBatch":   {"CreateImageBatch", RouteAuthUser},
+	"GetImageBatch":      {"GetImageBatch", RouteAuthUser},
+	"CancelImageBatch":   {"CancelImageBatch", RouteAuthUser},
+	"DownloadImageBatch": {"DownloadImageBatch", RouteAuthUser},
+
+	"ExportGPTChat":    {"ExportGPTChat", RouteAuthUser},
+	"ExportMyGPTChats": {"ExportMyGPTChats", RouteAuthUser},
+
+	"CreateDocumentCollection": {"CreateDocumentCollection", RouteAuthUser},
+	"ListDocumentCollections":  {"ListDocumentCollections", RouteAuthUser},
+	"DeleteDocumentCollection": {"DeleteDocumentCollection", RouteAuthUser},
+	"UploadDocument":           {"UploadDocument", RouteAuthUser},
+	"ListDocuments":            {"ListDocuments", RouteAuthUser},
+	"DeleteDocument":           {"DeleteDocument", RouteAuthUser},
+	"SearchDocuments":          {"SearchDocuments", RouteAuthUser},
+
+	"RegenerateGPTReply":  {"RegenerateGPTReply", RouteAuthUser},
+	"EditGPTMessage":      {"EditGPTMessage", RouteAuthUser},
+	"SwitchGPTChatBranch": {"SwitchGPTChatBranch", RouteAuthUser},
+
+	"GetCurrentWeather":  {"GetCurrentWeather", RouteAuthUser},
+	"GetWeatherForecast": {"GetWeatherForecast", RouteAuthUser},
+	"GeocodeCity":        {"GeocodeCity", RouteAuthUser},
 }
 
 /* ———————————————————————————————— — — — GET REQUEST'S ROUTE — — — ———————————————————————————————— */
@@ -78,7 +143,13 @@ var Routes = map[string]Route{
 //
 //	Method = /pbs.Service/Signup
 //	Route  = Signup
+//
+// The standard grpc.health.v1 service isn't ours, so its methods are matched in full, see grpcHealthRoutes.
 func GetRouteFromGRPCMethod(method string) Route {
+	if route, ok := grpcHealthRoutes[method]; ok {
+		return route
+	}
+
 	lastSlashIndex := strings.LastIndex(method, "/")
 	if lastSlashIndex != -1 {
 		return Routes[method[lastSlashIndex+1:]]
@@ -95,3 +166,10 @@ func GetRouteFromCtx(ctx context.Context) Route {
 }
 
 var InvalidRoute = Route{"Invalid", RouteAuthInvalid}
+
+// Its names are too common to be on Routes, where any of our services' methods with the same name would be public.
+var grpcHealthRoutes = map[string]Route{
+	"/grpc.health.v1.Health/Check": {"HealthCheck", RouteAuthPublic},
+	"/grpc.health.v1.Health/Watch": {"HealthWatch", RouteAuthPublic},
+	"/grpc.health.v1.Health/List":  {"HealthList", RouteAuthPublic},
+}
@@ -6,16 +6,76 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 )
 
 func POST(ctx context.Context, url string, payload any, bearer string, client *http.Client) (int, []byte, error) {
+	return POSTWithHeaders(ctx, url, payload, bearerHeaders(bearer), client)
+}
+
+// Like POST, for APIs that don't authenticate with a bearer token.
+func POSTWithHeaders(ctx context.Context, url string, payload any, headers map[string]string, client *http.Client) (int, []byte, error) {
+
+	// Prepare request
+	req, err := preparePOST(ctx, url, payload, headers)
+	if err != nil {
+		return 0, nil, fmt.Errorf("error preparing POST %s: %w", url, err)
+	}
+
+	return sendPOST(req, client)
+}
+
+// A file sent on a multipart form.
+type MultipartFile struct {
+	Field       string
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+// Like POST, but the payload goes as a multipart form, for APIs that take file uploads.
+func POSTMultipart(ctx context.Context, url string, fields map[string]string, files []MultipartFile, bearer string, client *http.Client) (int, []byte, error) {
 
 	// Prepare request
-	req, err := preparePOST(ctx, url, payload, bearer)
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+	for name, value := range fields {
+		if err := form.WriteField(name, value); err != nil {
+			return 0, nil, fmt.Errorf("error preparing POST %s: %w", url, err)
+		}
+	}
+	for _, file := range files {
+		partHeader := textproto.MIMEHeader{}
+		partHeader.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, file.Field, file.FileName))
+		partHeader.Set("Content-Type", file.ContentType)
+		part, err := form.CreatePart(partHeader)
+		if err == nil {
+			_, err = part.Write(file.Content)
+		}
+		if err != nil {
+			return 0, nil, fmt.Errorf("error preparing POST %s: %w", url, err)
+		}
+	}
+	if err := form.Close(); err != nil {
+		return 0, nil, fmt.Errorf("error preparing POST %s: %w", url, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, body)
 	if err != nil {
 		return 0, nil, fmt.Errorf("error preparing POST %s: %w", url, err)
 	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	for key, value := range bearerHeaders(bearer) {
+		req.Header.Set(key, value)
+	}
+
+	return sendPOST(req, client)
+}
+
+func sendPOST(req *http.Request, client *http.Client) (int, []byte, error) {
+	url := req.URL.String()
 
 	// Send request
 	resp, err := client.Do(req)
@@ -33,7 +93,27 @@ func POST(ctx context.Context, url string, payload any, bearer string, client *h
 	return resp.StatusCode, respBody, nil
 }
 
-func preparePOST(ctx context.Context, url string, payload any, bearer string) (*http.Request, error) {
+// Like POST, but returns the response as soon as its headers arrive, without reading the body.
+// Used for streamed responses, the caller must close the body.
+func POSTStream(ctx context.Context, url string, payload any, bearer string, client *http.Client) (*http.Response, error) {
+	return POSTStreamWithHeaders(ctx, url, payload, bearerHeaders(bearer), client)
+}
+
+func POSTStreamWithHeaders(ctx context.Context, url string, payload any, headers map[string]string, client *http.Client) (*http.Response, error) {
+	req, err := preparePOST(ctx, url, payload, headers)
+	if err != nil {
+		return nil, fmt.Errorf("error preparing POST %s: %w", url, err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil || resp == nil {
+		return nil, fmt.Errorf("error sending POST %s: %w", url, err)
+	}
+
+	return resp, nil
+}
+
+func preparePOST(ctx context.Context, url string, payload any, headers map[string]string) (*http.Request, error) {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling payload: %w", err)
@@ -45,9 +125,16 @@ func preparePOST(ctx context.Context, url string, payload any, bearer string) (*
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	if bearer != "" {
-		req.Header.Set("Authorization", "Bearer "+bearer)
+	for key, value := range headers {
+		req.Header.Set(key, value)
 	}
 
 	return req, nil
 }
+
+func bearerHeaders(bearer string) map[string]string {
+	if bearer == "" {
+		return nil
+	}
+	return map[string]string{"Authorization": "Bearer " + bearer}
+}
@@ -15,6 +15,7 @@ import (
 	"go.uber.org/zap"
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -46,6 +47,11 @@ func NewGormDB(cfg *core.DBCfg, hashPwdFn func(string) string) (*DB, error) {
 
 	// Wrap connection function to match the signature of utils.RetryFunc
 	connectToDB := func() (any, error) {
+		if cfg.SQLiteFile != "" {
+			gormDB, err := gorm.Open(sqlite.Open(cfg.SQLiteFile), gormCfg)
+			return gormDB, err
+		}
+
 		if cfg.IsPostgres() {
 			dsnFormat := "host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=%s"
 			dsn := fmt.Sprintf(dsnFormat,
@@ -99,6 +105,14 @@ func NewGormDB(cfg *core.DBCfg, hashPwdFn func(string) string) (*DB, error) {
 
 // setupDBPostConnection handles post-connection setup like migrations and admin creation
 func setupDBPostConnection(db *DB, cfg *core.DBCfg, hashPwdFn func(string) string) error {
+	// Without this, GORM migrates users_in_groups as a plain join table, without the role and the rest.
+	if err := db.db.SetupJoinTable(&models.User{}, "Groups", &models.UsersInGroup{}); err != nil {
+		return err
+	}
+	if err := db.db.SetupJoinTable(&models.Group{}, "Members", &models.UsersInGroup{}); err != nil {
+		return err
+	}
+
 	for _, model := range models.AllModels {
 		if cfg.EraseAllData {
 			tableName := ""
@@ -183,6 +197,15 @@ func (g *DB) CloseDB() error {
 	return sqlDB.Close()
 }
 
+// Actually reaches the database, unlike getting the connection, which is always there once we're connected.
+func (g *DB) PingDB(ctx context.Context) error {
+	sqlDB, err := g.db.DB()
+	if err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToGetSQLDB}
+	}
+	return sqlDB.PingContext(ctx)
+}
+
 // Additional helpers for repositories to use
 
 func (g *DB) FirstError(out any, where ...any) error {
@@ -213,10 +236,30 @@ func (g *DB) Where(query any, args ...any) core.DBOperations {
 	return &DB{db: g.db.Where(query, args...)}
 }
 
+func (g *DB) Order(value any) core.DBOperations {
+	return &DB{db: g.db.Order(value)}
+}
+
+func (g *DB) Omit(columns ...string) core.DBOperations {
+	return &DB{db: g.db.Omit(columns...)}
+}
+
 func (g *DB) Preload(query string, args ...any) core.DBOperations {
 	return &DB{db: g.db.Preload(query, args...)}
 }
 
+func (g *DB) Select(query any, args ...any) core.DBOperations {
+	return &DB{db: g.db.Select(query, args...)}
+}
+
+func (g *DB) Group(name string) core.DBOperations {
+	return &DB{db: g.db.Group(name)}
+}
+
+func (g *DB) ScanError(dest any) error {
+	return g.db.Scan(dest).Error
+}
+
 func (g *DB) Association(column string) *gorm.Association {
 	return g.db.Association(column)
 }
@@ -231,6 +274,11 @@ func (g *DB) CountError(value *int64) error {
 	return g.db.Count(value).Error
 }
 
+func (g *DB) Exec(sql string, values ...any) (int64, error) {
+	result := g.db.Exec(sql, values...)
+	return result.RowsAffected, result.Error
+}
+
 func (g *DB) Error() error {
 	return g.db.Error
 }
@@ -10,7 +10,7 @@ import (
 
 // Type constraint including all models
 type AllModels interface {
-	models.User | models.Group | models.UsersInGroup | models.GPTChat | models.GPTMessage
+	models.User | models.Group | models.GroupActivity | models.GroupInvite | models.GroupInviteLink | models.GroupInviteLinkJoin | models.UsageBudget | models.UsersInGroup | models.GPTChat | models.GPTMessage | models.LLMUsage | models.SystemPrompt | models.GPTMedia | models.ModerationEvent | models.ImageJob | models.ImageBatch | models.DocumentCollection | models.Document | models.DocumentChunk
 }
 
 type UserDB interface {
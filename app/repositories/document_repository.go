@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"slices"
+
+	"github.com/gilperopiola/god"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*       - Document Repository -       */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// GormDocumentRepository implements the DocumentRepository interface using GORM
+type GormDocumentRepository struct {
+	db core.DBOperations
+}
+
+// Verify that GormDocumentRepository implements the core.DocumentRepository interface
+var _ core.DocumentRepository = (*GormDocumentRepository)(nil)
+
+// NewGormDocumentRepository creates a new GormDocumentRepository
+func NewGormDocumentRepository(db core.DBOperations) *GormDocumentRepository {
+	return &GormDocumentRepository{db: db}
+}
+
+// CreateDocumentCollection creates a new document collection
+func (r *GormDocumentRepository) CreateDocumentCollection(ctx god.Ctx, collection *models.DocumentCollection) error {
+	if err := r.db.WithContext(ctx).CreateError(collection); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToCreateDocumentCollection}
+	}
+	return nil
+}
+
+// GetDocumentCollection retrieves a document collection by its ID
+func (r *GormDocumentRepository) GetDocumentCollection(ctx god.Ctx, collectionID int) (*models.DocumentCollection, error) {
+	var collection models.DocumentCollection
+	if err := r.db.WithContext(ctx).FirstError(&collection, collectionID); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.DocumentCollectionNotFound}
+	}
+	return &collection, nil
+}
+
+// GetDocumentCollectionsByOwnerID returns the user's collections with their documents, oldest first
+func (r *GormDocumentRepository) GetDocumentCollectionsByOwnerID(ctx god.Ctx, ownerID int) ([]*models.DocumentCollection, error) {
+	var collections []*models.DocumentCollection
+	err := r.db.WithContext(ctx).Preload("Documents").Order("created_at ASC, id ASC").
+		FindError(&collections, "owner_id = ?", ownerID)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchDocuments}
+	}
+	return collections, nil
+}
+
+// DeleteDocumentCollection deletes the collection with all of its documents and chunks
+func (r *GormDocumentRepository) DeleteDocumentCollection(ctx god.Ctx, collectionID int) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx core.DBOperations) error {
+		if err := tx.DeleteError(&models.DocumentChunk{}, "collection_id = ?", collectionID); err != nil {
+			return err
+		}
+		if err := tx.DeleteError(&models.Document{}, "collection_id = ?", collectionID); err != nil {
+			return err
+		}
+		return tx.DeleteError(&models.DocumentCollection{}, collectionID)
+	})
+	if err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToDeleteDocument}
+	}
+	return nil
+}
+
+/* -~-~-~- Documents -~-~-~- */
+
+// CreateDocument creates the document and its chunks, all or nothing.
+// Chunks are inserted a few at a time, as a big document can have thousands.
+func (r *GormDocumentRepository) CreateDocument(ctx god.Ctx, document *models.Document, chunks []*models.DocumentChunk) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx core.DBOperations) error {
+		if err := tx.CreateError(document); err != nil {
+			return err
+		}
+		for _, chunk := range chunks {
+			chunk.DocumentID = document.ID
+			chunk.CollectionID = document.CollectionID
+		}
+		for batch := range slices.Chunk(chunks, 500) {
+			if err := tx.CreateError(&batch); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToCreateDocument}
+	}
+	return nil
+}
+
+// GetDocument retrieves a document by its ID
+func (r *GormDocumentRepository) GetDocument(ctx god.Ctx, documentID int) (*models.Document, error) {
+	var document models.Document
+	if err := r.db.WithContext(ctx).FirstError(&document, documentID); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.DocumentNotFound}
+	}
+	return &document, nil
+}
+
+// GetDocumentsByCollectionID returns the collection's documents, oldest first
+func (r *GormDocumentRepository) GetDocumentsByCollectionID(ctx god.Ctx, collectionID int) ([]*models.Document, error) {
+	var documents []*models.Document
+	if err := r.db.WithContext(ctx).Order("created_at ASC, id ASC").FindError(&documents, "collection_id = ?", collectionID); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchDocuments}
+	}
+	return documents, nil
+}
+
+// DeleteDocument deletes the document and its chunks
+func (r *GormDocumentRepository) DeleteDocument(ctx god.Ctx, documentID int) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx core.DBOperations) error {
+		if err := tx.DeleteError(&models.DocumentChunk{}, "document_id = ?", documentID); err != nil {
+			return err
+		}
+		return tx.DeleteError(&models.Document{}, documentID)
+	})
+	if err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToDeleteDocument}
+	}
+	return nil
+}
+
+// GetDocumentChunksByIDs returns the chunks with those IDs, in no particular order
+func (r *GormDocumentRepository) GetDocumentChunksByIDs(ctx god.Ctx, chunkIDs []int) ([]*models.DocumentChunk, error) {
+	var chunks []*models.DocumentChunk
+	if len(chunkIDs) == 0 {
+		return chunks, nil
+	}
+	if err := r.db.WithContext(ctx).FindError(&chunks, "id IN ?", chunkIDs); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchDocuments}
+	}
+	return chunks, nil
+}
@@ -5,6 +5,9 @@ import (
 	"github.com/gilperopiola/grpc-gateway-impl/app/core"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 /* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
@@ -24,12 +27,17 @@ func NewGormGPTChatRepository(db core.DBOperations) *GormGPTChatRepository {
 	return &GormGPTChatRepository{db: db}
 }
 
-// GetChatByID retrieves a GPT chat by its ID
+// GetChatByID retrieves a non deleted GPT chat by its ID, with its owner and its messages in order
 func (r *GormGPTChatRepository) GetChatByID(ctx god.Ctx, id int) (*models.GPTChat, error) {
 	var chat models.GPTChat
 
 	// Get the chat along with its messages
-	err := r.db.WithContext(ctx).Preload("Messages").FirstError(&chat, id)
+	err := r.db.WithContext(ctx).
+		Preload("Owner").
+		Preload("Messages", func(db *gorm.DB) *gorm.DB { return db.Order("id ASC") }).
+		Preload("Messages.Sender").
+		Preload("Messages.Media").
+		FirstError(&chat, "id = ? AND deleted = ?", id, false)
 	if err != nil {
 		return nil, &errs.DBErr{Err: err, Context: errs.ChatNotFound}
 	}
@@ -37,26 +45,254 @@ func (r *GormGPTChatRepository) GetChatByID(ctx god.Ctx, id int) (*models.GPTCha
 	return &chat, nil
 }
 
-// CreateChat creates a new GPT chat with the specified title
-func (r *GormGPTChatRepository) CreateChat(ctx god.Ctx, title string) (*models.GPTChat, error) {
-	chat := models.GPTChat{
-		Title: title,
+// GetChatsByGroupID retrieves a paginated list of the chats shared with a group, most recently updated first
+func (r *GormGPTChatRepository) GetChatsByGroupID(ctx god.Ctx, groupID, page, pageSize int) ([]*models.GPTChat, int, error) {
+	var chats []*models.GPTChat
+	var count int64
+
+	where := "group_id = ? AND visibility = ? AND deleted = ?"
+
+	err := r.db.WithContext(ctx).Model(&models.GPTChat{}).Where(where, groupID, models.GPTChatGroup, false).CountError(&count)
+	if err != nil {
+		return nil, 0, &errs.DBErr{Err: err, Context: errs.FailedToFetchChats}
+	}
+
+	offset := (page - 1) * pageSize
+
+	err = r.db.WithContext(ctx).Preload("Owner").Order("updated_at DESC").
+		Offset(offset).Limit(pageSize).FindError(&chats, where, groupID, models.GPTChatGroup, false)
+	if err != nil {
+		return nil, 0, &errs.DBErr{Err: err, Context: errs.FailedToFetchChats}
+	}
+
+	return chats, int(count), nil
+}
+
+// GetChatsByOwnerID retrieves a paginated list of the user's own chats, newest first
+func (r *GormGPTChatRepository) GetChatsByOwnerID(ctx god.Ctx, ownerID, page, pageSize int) ([]*models.GPTChat, int, error) {
+	var chats []*models.GPTChat
+	var count int64
+
+	where := "owner_id = ? AND deleted = ?"
+
+	err := r.db.WithContext(ctx).Model(&models.GPTChat{}).Where(where, ownerID, false).CountError(&count)
+	if err != nil {
+		return nil, 0, &errs.DBErr{Err: err, Context: errs.FailedToFetchChats}
 	}
 
-	err := r.db.WithContext(ctx).CreateError(&chat)
+	offset := (page - 1) * pageSize
+
+	err = r.db.WithContext(ctx).Order("created_at DESC, id DESC").
+		Offset(offset).Limit(pageSize).FindError(&chats, where, ownerID, false)
+	if err != nil {
+		return nil, 0, &errs.DBErr{Err: err, Context: errs.FailedToFetchChats}
+	}
+
+	return chats, int(count), nil
+}
+
+// GetFullChatsByOwnerID retrieves all of the user's own chats with their messages, oldest first
+func (r *GormGPTChatRepository) GetFullChatsByOwnerID(ctx god.Ctx, ownerID int) ([]*models.GPTChat, error) {
+	var chats []*models.GPTChat
+
+	err := r.db.WithContext(ctx).
+		Preload("Owner").
+		Preload("Messages", func(db *gorm.DB) *gorm.DB { return db.Order("id ASC") }).
+		Preload("Messages.Sender").
+		Preload("Messages.Media").
+		Order("created_at ASC, id ASC").
+		FindError(&chats, "owner_id = ? AND deleted = ?", ownerID, false)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchChats}
+	}
+
+	return chats, nil
+}
+
+// CreateChat creates a new GPT chat with its title, owner and settings already set. It starts as private
+func (r *GormGPTChatRepository) CreateChat(ctx god.Ctx, chat *models.GPTChat) (*models.GPTChat, error) {
+	chat.Visibility = models.GPTChatPrivate
+
+	err := r.db.WithContext(ctx).CreateError(chat)
 	if err != nil {
 		return nil, &errs.DBErr{Err: err, Context: errs.FailedToCreateChat}
 	}
 
-	return &chat, nil
+	return chat, nil
 }
 
-// CreateMessage creates a new GPT message in a chat
+// UpdateChat saves the chat's own columns, its messages are left untouched.
+// Chats are deleted through here too, by setting Deleted.
+// Its head isn't saved, as messages may have been added since it was loaded, see SetChatHead
+func (r *GormGPTChatRepository) UpdateChat(ctx god.Ctx, chat *models.GPTChat) error {
+	if err := r.db.WithContext(ctx).Omit(clause.Associations, "HeadMessageID").SaveError(chat); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToUpdateChat}
+	}
+	return nil
+}
+
+// ShareChatWithGroup saves the chat and stores the group's activity event on the same transaction
+func (r *GormGPTChatRepository) ShareChatWithGroup(ctx god.Ctx, chat *models.GPTChat, activity *models.GroupActivity) error {
+	return r.db.WithContext(ctx).Transaction(func(tx core.DBOperations) error {
+		if err := NewGormGPTChatRepository(tx).UpdateChat(ctx, chat); err != nil {
+			return err
+		}
+		return NewGormGroupRepository(tx).CreateGroupActivity(ctx, activity)
+	})
+}
+
+// CreateMessage creates a new GPT message in a chat, along with its media if it has any.
+// Unless it's a summary, it becomes the chat's head. Without a parent it follows the current head,
+// or the chat's last message on chats from before branches
 func (r *GormGPTChatRepository) CreateMessage(ctx god.Ctx, message *models.GPTMessage) (*models.GPTMessage, error) {
-	err := r.db.WithContext(ctx).CreateError(message)
+	if message.From == models.GPTMessageSummary {
+		if err := r.db.WithContext(ctx).CreateError(message); err != nil {
+			return nil, &errs.DBErr{Err: err, Context: errs.FailedToCreateMessage}
+		}
+		return message, nil
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx core.DBOperations) error {
+		if message.ParentID == nil {
+			parentID, err := chatHeadID(tx, message.ChatID)
+			if err != nil {
+				return err
+			}
+			message.ParentID = parentID
+		}
+		if err := tx.CreateError(message); err != nil {
+			return err
+		}
+		_, err := tx.Exec("UPDATE gpt_chats SET head_message_id = ? WHERE id = ?", message.ID, message.ChatID)
+		return err
+	})
 	if err != nil {
 		return nil, &errs.DBErr{Err: err, Context: errs.FailedToCreateMessage}
 	}
 
 	return message, nil
 }
+
+// DeleteHeadMessage deletes the chat's head and makes its parent the head again.
+// It's only for the head, as any message after it would be left without a parent
+func (r *GormGPTChatRepository) DeleteHeadMessage(ctx god.Ctx, message *models.GPTMessage) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx core.DBOperations) error {
+		if err := tx.DeleteError(message); err != nil {
+			return err
+		}
+		_, err := tx.Exec("UPDATE gpt_chats SET head_message_id = ? WHERE id = ?", message.ParentID, message.ChatID)
+		return err
+	})
+	if err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToDeleteMessage}
+	}
+	return nil
+}
+
+// SetChatHead makes the message the last one of the chat's branch, the one new messages follow
+func (r *GormGPTChatRepository) SetChatHead(ctx god.Ctx, chatID, messageID int) error {
+	_, err := r.db.WithContext(ctx).Exec("UPDATE gpt_chats SET head_message_id = ? WHERE id = ?", messageID, chatID)
+	if err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToUpdateChat}
+	}
+	return nil
+}
+
+// Returns the chat's head, or its last message that isn't a summary if it has none yet.
+// Summaries are few and far between, so the last few messages are enough to find it.
+func chatHeadID(tx core.DBOperations, chatID int) (*int, error) {
+	var chat models.GPTChat
+	if err := tx.FirstError(&chat, chatID); err != nil {
+		return nil, err
+	}
+	if chat.HeadMessageID != nil {
+		return chat.HeadMessageID, nil
+	}
+
+	var lastMessages []models.GPTMessage
+	if err := tx.Where("chat_id = ?", chatID).Order("id DESC").Limit(5).FindError(&lastMessages); err != nil {
+		return nil, err
+	}
+	for _, msg := range lastMessages {
+		if msg.From != models.GPTMessageSummary {
+			return &msg.ID, nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdateMessage saves the message's own columns, its media is left untouched
+func (r *GormGPTChatRepository) UpdateMessage(ctx god.Ctx, message *models.GPTMessage) error {
+	if err := r.db.WithContext(ctx).Omit(clause.Associations).SaveError(message); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToUpdateMessage}
+	}
+	return nil
+}
+
+// CreateMedia creates a new media row for a message, usually still pending to be downloaded
+func (r *GormGPTChatRepository) CreateMedia(ctx god.Ctx, media *models.GPTMedia) error {
+	if err := r.db.WithContext(ctx).CreateError(media); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToCreateMedia}
+	}
+	return nil
+}
+
+// UpdateMedia saves the media's status and, once it's stored, where and what it is
+func (r *GormGPTChatRepository) UpdateMedia(ctx god.Ctx, media *models.GPTMedia) error {
+	if err := r.db.WithContext(ctx).SaveError(media); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToUpdateMedia}
+	}
+	return nil
+}
+
+// GetMediaByID retrieves a single media row
+func (r *GormGPTChatRepository) GetMediaByID(ctx god.Ctx, id int) (*models.GPTMedia, error) {
+	var media models.GPTMedia
+	if err := r.db.WithContext(ctx).FirstError(&media, "id = ?", id); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.MediaNotFound}
+	}
+	return &media, nil
+}
+
+// CreateSystemPrompt stores the prompt as the next version of its name, starting from 1
+func (r *GormGPTChatRepository) CreateSystemPrompt(ctx god.Ctx, prompt *models.SystemPrompt) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx core.DBOperations) error {
+		var lastVersion int
+		err := tx.Model(&models.SystemPrompt{}).Select("COALESCE(MAX(version), 0)").Where("name = ?", prompt.Name).ScanError(&lastVersion)
+		if err != nil {
+			return err
+		}
+
+		prompt.Version = lastVersion + 1
+		return tx.CreateError(prompt)
+	})
+	if err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToCreateSystemPrompt}
+	}
+	return nil
+}
+
+// GetSystemPromptByID retrieves a single version of a system prompt
+func (r *GormGPTChatRepository) GetSystemPromptByID(ctx god.Ctx, id int) (*models.SystemPrompt, error) {
+	var prompt models.SystemPrompt
+	if err := r.db.WithContext(ctx).FirstError(&prompt, "id = ?", id); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.SystemPromptNotFound}
+	}
+	return &prompt, nil
+}
+
+// GetSystemPrompts retrieves every version of the system prompts, or only of the ones with the given name.
+// They're ordered by name, newest version first
+func (r *GormGPTChatRepository) GetSystemPrompts(ctx god.Ctx, name string) ([]*models.SystemPrompt, error) {
+	var prompts []*models.SystemPrompt
+
+	query := r.db.WithContext(ctx).Order("name ASC, version DESC")
+	if name != "" {
+		query = query.Where("name = ?", name)
+	}
+
+	if err := query.FindError(&prompts); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchSystemPrompts}
+	}
+	return prompts, nil
+}
@@ -1,10 +1,15 @@
 package repositories
 
 import (
+	"time"
+
 	"github.com/gilperopiola/god"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 /* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
@@ -24,34 +29,62 @@ func NewGormGroupRepository(db core.DBOperations) *GormGroupRepository {
 	return &GormGroupRepository{db: db}
 }
 
-// CreateGroup creates a new group with the specified owner and invited users
+// CreateGroup creates a new group with the specified owner as its first member.
+// Invited users are not added to the group, they get a pending invite instead.
 func (r *GormGroupRepository) CreateGroup(ctx god.Ctx, name string, ownerID int, invitedUserIDs []int) (*models.Group, error) {
 	group := models.Group{
 		Name:    name,
 		OwnerID: ownerID,
 	}
 
-	err := r.db.WithContext(ctx).CreateError(&group)
-	if err != nil {
-		return nil, &errs.DBErr{Err: err, Context: errs.FailedToCreateGroup}
-	}
+	err := r.InTransaction(ctx, func(txRepo core.GroupRepository) error {
+		tx := txRepo.(*GormGroupRepository)
+		if err := tx.db.WithContext(ctx).CreateError(&group); err != nil {
+			return &errs.DBErr{Err: err, Context: errs.FailedToCreateGroup}
+		}
+
+		if err := tx.AddGroupMember(ctx, group.ID, ownerID, models.GroupOwnerRole); err != nil {
+			return err
+		}
+
+		activity := models.NewGroupActivity(group.ID, ownerID, models.GroupCreatedActivity, 0, map[string]string{"name": name})
+		if err := tx.CreateGroupActivity(ctx, activity); err != nil {
+			return err
+		}
+
+		invites := make([]*models.GroupInvite, 0, len(invitedUserIDs))
+		for _, userID := range invitedUserIDs {
+			if userID != ownerID {
+				invites = append(invites, models.NewGroupInvite(group.ID, userID, ownerID))
+			}
+		}
+		if err := tx.CreateGroupInvites(ctx, invites); err != nil {
+			return err
+		}
 
-	// Add invited users to the group
-	if len(invitedUserIDs) > 0 {
-		err = r.addInvitedUsersToGroup(ctx, &group, invitedUserIDs)
-		if err != nil {
-			return nil, err
+		for _, invite := range invites {
+			activity := models.NewGroupActivity(group.ID, ownerID, models.MemberInvitedActivity, invite.UserID, nil)
+			if err := tx.CreateGroupActivity(ctx, activity); err != nil {
+				return err
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &group, nil
 }
 
-// GetGroupByID retrieves a group by its ID
+// GetGroupByID retrieves a non deleted group by its ID, with its members and their roles
 func (r *GormGroupRepository) GetGroupByID(ctx god.Ctx, id int) (*models.Group, error) {
 	var group models.Group
 
-	err := r.db.WithContext(ctx).FirstError(&group, id)
+	err := r.db.WithContext(ctx).
+		Preload("Memberships", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		Preload("Memberships.User").
+		FirstError(&group, "id = ? AND deleted = ?", id, false)
 	if err != nil {
 		return nil, &errs.DBErr{Err: err, Context: errs.GroupNotFound}
 	}
@@ -59,40 +92,380 @@ func (r *GormGroupRepository) GetGroupByID(ctx god.Ctx, id int) (*models.Group,
 	return &group, nil
 }
 
+// UpdateGroup saves the group's own columns, its members are left untouched
+func (r *GormGroupRepository) UpdateGroup(ctx god.Ctx, group *models.Group) error {
+	if err := r.db.WithContext(ctx).Omit(clause.Associations).SaveError(group); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToUpdateGroup}
+	}
+	return nil
+}
+
 // GetGroupsByUserID retrieves all groups where the specified user is a member or owner
 func (r *GormGroupRepository) GetGroupsByUserID(ctx god.Ctx, userID int) ([]*models.Group, error) {
 	var groups []*models.Group
 
-	// Find groups where the user is the owner
-	err := r.db.WithContext(ctx).FindError(&groups, "owner_id = ?", userID)
+	query := "deleted = ? AND (owner_id = ? OR id IN (SELECT group_id FROM users_in_groups WHERE user_id = ?))"
+	err := r.db.WithContext(ctx).FindError(&groups, query, false, userID, userID)
 	if err != nil {
 		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchGroups}
 	}
 
-	// Find groups where the user is an invited member
-	var invitedGroups []*models.Group
-	err = r.db.WithContext(ctx).FindError(&invitedGroups, "EXISTS (SELECT 1 FROM group_users WHERE group_users.group_id = groups.id AND group_users.user_id = ?)", userID)
+	return groups, nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*              - Members -            */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// AddGroupMember adds the user to the group's users_in_groups table with the given role
+func (r *GormGroupRepository) AddGroupMember(ctx god.Ctx, groupID, userID int, role models.GroupRole) error {
+	member := models.UsersInGroup{
+		UserID:  userID,
+		GroupID: groupID,
+		Role:    role,
+	}
+	if err := r.db.WithContext(ctx).CreateError(&member); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToAddUserToGroup}
+	}
+	return nil
+}
+
+// IsGroupMember returns true if the user is on the group's users_in_groups table
+func (r *GormGroupRepository) IsGroupMember(ctx god.Ctx, groupID, userID int) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.UsersInGroup{}).
+		Where("group_id = ? AND user_id = ?", groupID, userID).
+		CountError(&count)
 	if err != nil {
-		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchGroups}
+		return false, &errs.DBErr{Err: err, Context: errs.FailedToFetchGroups}
 	}
+	return count > 0, nil
+}
 
-	// Combine both sets of groups
-	groups = append(groups, invitedGroups...)
+// GetGroupMember retrieves the user's membership (and role) on the group
+func (r *GormGroupRepository) GetGroupMember(ctx god.Ctx, groupID, userID int) (*models.UsersInGroup, error) {
+	var member models.UsersInGroup
 
-	return groups, nil
+	err := r.db.WithContext(ctx).Preload("User").FirstError(&member, "group_id = ? AND user_id = ?", groupID, userID)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.GroupMemberNotFound}
+	}
+
+	return &member, nil
 }
 
-// addInvitedUsersToGroup is a helper method to add invited users to a group
-func (r *GormGroupRepository) addInvitedUsersToGroup(ctx god.Ctx, group *models.Group, invitedUserIDs []int) error {
-	for _, userID := range invitedUserIDs {
-		invitedUser := models.UsersInGroup{
-			UserID:  userID,
-			GroupID: group.ID,
-		}
-		err := r.db.WithContext(ctx).CreateError(&invitedUser)
-		if err != nil {
-			return &errs.DBErr{Err: err, Context: errs.FailedToAddUserToGroup}
-		}
+// UpdateGroupMember saves the membership as it is, used to change roles
+func (r *GormGroupRepository) UpdateGroupMember(ctx god.Ctx, member *models.UsersInGroup) error {
+	if err := r.db.WithContext(ctx).Omit(clause.Associations).SaveError(member); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToUpdateMember}
+	}
+	return nil
+}
+
+// RemoveGroupMember deletes the user from the group's users_in_groups table
+func (r *GormGroupRepository) RemoveGroupMember(ctx god.Ctx, groupID, userID int) error {
+	err := r.db.WithContext(ctx).DeleteError(&models.UsersInGroup{}, "group_id = ? AND user_id = ?", groupID, userID)
+	if err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToRemoveMember}
+	}
+	return nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*              - Invites -            */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// CreateGroupInvites stores the given invites, doing nothing if there are none
+func (r *GormGroupRepository) CreateGroupInvites(ctx god.Ctx, invites []*models.GroupInvite) error {
+	if len(invites) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).CreateError(&invites); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToCreateGroupInvite}
+	}
+	return nil
+}
+
+// GetLastGroupInvite retrieves the most recent invite of a user to a group, whatever its status
+func (r *GormGroupRepository) GetLastGroupInvite(ctx god.Ctx, groupID, userID int) (*models.GroupInvite, error) {
+	var invite models.GroupInvite
+
+	err := r.db.WithContext(ctx).
+		Preload("Group").Preload("User").Preload("Inviter").
+		Order("id DESC").
+		FirstError(&invite, "group_id = ? AND user_id = ?", groupID, userID)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.GroupInviteNotFound}
+	}
+
+	return &invite, nil
+}
+
+// GetPendingGroupInvitesByUserID retrieves the not yet answered and not expired invites of a user
+func (r *GormGroupRepository) GetPendingGroupInvitesByUserID(ctx god.Ctx, userID int) ([]*models.GroupInvite, error) {
+	var invites []*models.GroupInvite
+
+	err := r.db.WithContext(ctx).
+		Preload("Group").Preload("User").Preload("Inviter").
+		Order("id DESC").
+		FindError(&invites, "user_id = ? AND status = ? AND expires_at > ?", userID, models.GroupInvitePending, time.Now())
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchGroupInvites}
+	}
+
+	return invites, nil
+}
+
+// UpdateGroupInvite saves the invite as it is
+func (r *GormGroupRepository) UpdateGroupInvite(ctx god.Ctx, invite *models.GroupInvite) error {
+	if err := r.db.WithContext(ctx).Omit(clause.Associations).SaveError(invite); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToUpdateGroupInvite}
+	}
+	return nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*           - Invite Links -          */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// CreateGroupInviteLink stores a new invite link
+func (r *GormGroupRepository) CreateGroupInviteLink(ctx god.Ctx, link *models.GroupInviteLink) error {
+	if err := r.db.WithContext(ctx).CreateError(link); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToCreateInviteLink}
+	}
+	return nil
+}
+
+// GetGroupInviteLink retrieves one of the group's invite links, with its joins
+func (r *GormGroupRepository) GetGroupInviteLink(ctx god.Ctx, groupID, linkID int) (*models.GroupInviteLink, error) {
+	var link models.GroupInviteLink
+
+	err := r.db.WithContext(ctx).
+		Preload("Creator").Preload("Joins.User").
+		FirstError(&link, "id = ? AND group_id = ?", linkID, groupID)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.InviteLinkNotFound}
+	}
+
+	return &link, nil
+}
+
+// GetGroupInviteLinkByTokenHash retrieves the invite link with the given token hash, whatever its state
+func (r *GormGroupRepository) GetGroupInviteLinkByTokenHash(ctx god.Ctx, tokenHash string) (*models.GroupInviteLink, error) {
+	var link models.GroupInviteLink
+
+	err := r.db.WithContext(ctx).FirstError(&link, "token_hash = ?", tokenHash)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.InviteLinkNotFound}
+	}
+
+	return &link, nil
+}
+
+// GetGroupInviteLinks retrieves the group's invite links, newest first, with their joins
+func (r *GormGroupRepository) GetGroupInviteLinks(ctx god.Ctx, groupID int, onlyActive bool) ([]*models.GroupInviteLink, error) {
+	var links []*models.GroupInviteLink
+
+	query := r.db.WithContext(ctx).Preload("Creator").Preload("Joins.User").Where("group_id = ?", groupID)
+	if onlyActive {
+		query = query.
+			Where("revoked_at IS NULL").
+			Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+			Where("max_uses = 0 OR uses < max_uses")
+	}
+
+	if err := query.Order("id DESC").FindError(&links); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchInviteLinks}
+	}
+
+	return links, nil
+}
+
+// UpdateGroupInviteLink saves the invite link as it is, used to revoke it
+func (r *GormGroupRepository) UpdateGroupInviteLink(ctx god.Ctx, link *models.GroupInviteLink) error {
+	if err := r.db.WithContext(ctx).Omit(clause.Associations).SaveError(link); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToUpdateInviteLink}
 	}
 	return nil
 }
+
+// UseGroupInviteLink adds one use to the link and records the join, only if the link is still active.
+// The check and the increment are done on the same UPDATE, so concurrent joins can't go over MaxUses.
+// Returns false if the link couldn't be used.
+func (r *GormGroupRepository) UseGroupInviteLink(ctx god.Ctx, linkID, userID int) (bool, error) {
+	now := time.Now()
+	rowsAffected, err := r.db.WithContext(ctx).Exec(
+		"UPDATE group_invite_links SET uses = uses + 1, updated_at = ? "+
+			"WHERE id = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > ?) AND (max_uses = 0 OR uses < max_uses)",
+		now, linkID, now,
+	)
+	if err != nil {
+		return false, &errs.DBErr{Err: err, Context: errs.FailedToUpdateInviteLink}
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	var link models.GroupInviteLink
+	if err := r.db.WithContext(ctx).FirstError(&link, linkID); err != nil {
+		return false, &errs.DBErr{Err: err, Context: errs.InviteLinkNotFound}
+	}
+
+	join := models.GroupInviteLinkJoin{LinkID: linkID, GroupID: link.GroupID, UserID: userID}
+	if err := r.db.WithContext(ctx).CreateError(&join); err != nil {
+		return false, &errs.DBErr{Err: err, Context: errs.FailedToUpdateInviteLink}
+	}
+
+	return true, nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*             - Activity -            */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// CreateGroupActivity stores a group event. It should be called from inside
+// of InTransaction, together with the change it describes.
+func (r *GormGroupRepository) CreateGroupActivity(ctx god.Ctx, activity *models.GroupActivity) error {
+	if err := r.db.WithContext(ctx).CreateError(activity); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToCreateGroupActivity}
+	}
+	return nil
+}
+
+// GetGroupActivities retrieves up to limit group events, newest first.
+// Only events with an ID lower than beforeID are returned, unless it's 0. Empty types means all of them.
+func (r *GormGroupRepository) GetGroupActivities(ctx god.Ctx, groupID, beforeID int, types []models.GroupActivityType, limit int) ([]*models.GroupActivity, error) {
+	var activities []*models.GroupActivity
+
+	query := r.db.WithContext(ctx).Preload("Actor").Preload("TargetUser").Where("group_id = ?", groupID)
+	if beforeID > 0 {
+		query = query.Where("id < ?", beforeID)
+	}
+	if len(types) > 0 {
+		query = query.Where("type IN ?", types)
+	}
+
+	if err := query.Order("id DESC").Limit(limit).FindError(&activities); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchGroupActivity}
+	}
+
+	return activities, nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*           - Usage Budgets -         */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// GetUsageBudgets retrieves all of the group's budgets, the group-wide ones first
+func (r *GormGroupRepository) GetUsageBudgets(ctx god.Ctx, groupID int) ([]*models.UsageBudget, error) {
+	var budgets []*models.UsageBudget
+
+	err := r.db.WithContext(ctx).Where("group_id = ?", groupID).Order("user_id ASC, unit ASC").FindError(&budgets)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchBudgets}
+	}
+
+	return budgets, nil
+}
+
+// GetUsageBudgetsForUser retrieves the budgets a user's usage on the group counts against:
+// the group-wide one and the user's own one, if they exist
+func (r *GormGroupRepository) GetUsageBudgetsForUser(ctx god.Ctx, groupID, userID int, unit models.BudgetUnit) ([]*models.UsageBudget, error) {
+	var budgets []*models.UsageBudget
+
+	err := r.db.WithContext(ctx).
+		Where("group_id = ? AND unit = ? AND user_id IN ?", groupID, unit, []int{0, userID}).
+		Order("user_id ASC").
+		FindError(&budgets)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchBudgets}
+	}
+
+	return budgets, nil
+}
+
+// GetMemberUsageBudgets retrieves the user's own budgets on every group they're still on,
+// which also cap what they use outside of those groups
+func (r *GormGroupRepository) GetMemberUsageBudgets(ctx god.Ctx, userID int, unit models.BudgetUnit) ([]*models.UsageBudget, error) {
+	groups, err := r.GetGroupsByUserID(ctx, userID)
+	if err != nil || len(groups) == 0 {
+		return nil, err
+	}
+	groupIDs := make([]int, 0, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+	}
+
+	var budgets []*models.UsageBudget
+
+	err = r.db.WithContext(ctx).
+		Where("user_id = ? AND unit = ? AND group_id IN ?", userID, unit, groupIDs).
+		Order("group_id ASC").
+		FindError(&budgets)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchBudgets}
+	}
+
+	return budgets, nil
+}
+
+// GetUsageBudget retrieves a budget by its ID, only if it belongs to the group
+func (r *GormGroupRepository) GetUsageBudget(ctx god.Ctx, groupID, budgetID int) (*models.UsageBudget, error) {
+	var budget models.UsageBudget
+
+	if err := r.db.WithContext(ctx).FirstError(&budget, "id = ? AND group_id = ?", budgetID, groupID); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.UsageBudgetNotFound}
+	}
+
+	return &budget, nil
+}
+
+// SaveUsageBudget creates the budget if it doesn't have an ID, or saves it as it is
+func (r *GormGroupRepository) SaveUsageBudget(ctx god.Ctx, budget *models.UsageBudget) error {
+	var err error
+	if budget.ID == 0 {
+		err = r.db.WithContext(ctx).CreateError(budget)
+	} else {
+		err = r.db.WithContext(ctx).SaveError(budget)
+	}
+	if err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToSaveUsageBudget}
+	}
+	return nil
+}
+
+// DeleteUsageBudget deletes the budget, leaving that usage uncapped
+func (r *GormGroupRepository) DeleteUsageBudget(ctx god.Ctx, budget *models.UsageBudget) error {
+	if err := r.db.WithContext(ctx).DeleteError(budget); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToDeleteBudget}
+	}
+	return nil
+}
+
+// ConsumeUsageBudgets adds amount to the budgets' usage on the given period.
+// Budgets still counting a past period are reset to amount. It's a single UPDATE,
+// so concurrent calls don't overwrite each other.
+func (r *GormGroupRepository) ConsumeUsageBudgets(ctx god.Ctx, budgetIDs []int, amount int64, period string) error {
+	if len(budgetIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.db.WithContext(ctx).Exec(
+		"UPDATE usage_budgets SET used = CASE WHEN period = ? THEN used + ? ELSE ? END, period = ?, updated_at = ? WHERE id IN ?",
+		period, amount, amount, period, time.Now(), budgetIDs,
+	)
+	if err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToConsumeBudgets}
+	}
+	return nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// InTransaction runs fn with a GormGroupRepository bound to a single DB transaction.
+// If fn returns an error, everything done through txRepo is rolled back.
+func (r *GormGroupRepository) InTransaction(ctx god.Ctx, fn func(txRepo core.GroupRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx core.DBOperations) error {
+		return fn(NewGormGroupRepository(tx))
+	})
+}
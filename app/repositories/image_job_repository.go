@@ -0,0 +1,188 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/gilperopiola/god"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*       - Image Job Repository -      */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// GormImageJobRepository implements the ImageJobRepository interface using GORM
+type GormImageJobRepository struct {
+	db core.DBOperations
+}
+
+// Verify that GormImageJobRepository implements the core.ImageJobRepository interface
+var _ core.ImageJobRepository = (*GormImageJobRepository)(nil)
+
+// NewGormImageJobRepository creates a new GormImageJobRepository
+func NewGormImageJobRepository(db core.DBOperations) *GormImageJobRepository {
+	return &GormImageJobRepository{db: db}
+}
+
+// CreateImageJob queues a new image job
+func (r *GormImageJobRepository) CreateImageJob(ctx god.Ctx, job *models.ImageJob) error {
+	if err := r.db.WithContext(ctx).CreateError(job); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToCreateImageJob}
+	}
+	return nil
+}
+
+// GetImageJob retrieves an image job by its operation ID
+func (r *GormImageJobRepository) GetImageJob(ctx god.Ctx, operationID string) (*models.ImageJob, error) {
+	var job models.ImageJob
+	if err := r.db.WithContext(ctx).FirstError(&job, "operation_id = ?", operationID); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.ImageJobNotFound}
+	}
+	return &job, nil
+}
+
+// ClaimImageJob marks the oldest queued job that's due as running and returns it, counting the attempt.
+// Returns nil if there's none, or if another worker claimed it first.
+func (r *GormImageJobRepository) ClaimImageJob(ctx god.Ctx) (*models.ImageJob, error) {
+	now := time.Now()
+
+	var jobs []*models.ImageJob
+	err := r.db.WithContext(ctx).Order("next_attempt_at ASC, id ASC").Limit(1).
+		FindError(&jobs, "status = ? AND next_attempt_at <= ?", models.ImageJobQueued, now)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToClaimImageJob}
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+
+	rowsAffected, err := r.db.WithContext(ctx).Exec(
+		"UPDATE image_jobs SET status = ?, attempts = attempts + 1, updated_at = ? WHERE id = ? AND status = ?",
+		models.ImageJobRunning, now, jobs[0].ID, models.ImageJobQueued,
+	)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToClaimImageJob}
+	}
+	if rowsAffected == 0 {
+		return nil, nil
+	}
+
+	var job models.ImageJob
+	if err := r.db.WithContext(ctx).FirstError(&job, jobs[0].ID); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.ImageJobNotFound}
+	}
+	return &job, nil
+}
+
+// UpdateRunningImageJob saves the job only if it's still running, so cancelled jobs stay cancelled.
+// Returns whether it was saved.
+func (r *GormImageJobRepository) UpdateRunningImageJob(ctx god.Ctx, job *models.ImageJob) (bool, error) {
+	saved := false
+	err := r.db.WithContext(ctx).Transaction(func(tx core.DBOperations) error {
+		var current models.ImageJob
+		if err := tx.FirstError(&current, job.ID); err != nil {
+			return err
+		}
+		if current.Status != models.ImageJobRunning {
+			return nil
+		}
+		saved = true
+		return tx.SaveError(job)
+	})
+	if err != nil {
+		return false, &errs.DBErr{Err: err, Context: errs.FailedToUpdateImageJob}
+	}
+	return saved, nil
+}
+
+// CancelImageJob cancels the job if it isn't done yet. Returns whether it was cancelled.
+func (r *GormImageJobRepository) CancelImageJob(ctx god.Ctx, operationID string) (bool, error) {
+	now := time.Now()
+	rowsAffected, err := r.db.WithContext(ctx).Exec(
+		"UPDATE image_jobs SET status = ?, updated_at = ?, finished_at = ? WHERE operation_id = ? AND status IN (?, ?)",
+		models.ImageJobCancelled, now, now, operationID, models.ImageJobQueued, models.ImageJobRunning,
+	)
+	if err != nil {
+		return false, &errs.DBErr{Err: err, Context: errs.FailedToUpdateImageJob}
+	}
+	return rowsAffected > 0, nil
+}
+
+// RequeueRunningImageJobs queues again the jobs left running, like when the app stops in the middle of one.
+// Returns how many there were.
+func (r *GormImageJobRepository) RequeueRunningImageJobs(ctx god.Ctx) (int, error) {
+	rowsAffected, err := r.db.WithContext(ctx).Exec(
+		"UPDATE image_jobs SET status = ?, next_attempt_at = ?, updated_at = ? WHERE status = ?",
+		models.ImageJobQueued, time.Now(), time.Now(), models.ImageJobRunning,
+	)
+	if err != nil {
+		return 0, &errs.DBErr{Err: err, Context: errs.FailedToUpdateImageJob}
+	}
+	return int(rowsAffected), nil
+}
+
+// LastScheduledImageJobAt returns when the user's queued job that's due last is due, or nil if they have none queued.
+func (r *GormImageJobRepository) LastScheduledImageJobAt(ctx god.Ctx, userID int) (*time.Time, error) {
+	var jobs []*models.ImageJob
+	err := r.db.WithContext(ctx).Order("next_attempt_at DESC").Limit(1).
+		FindError(&jobs, "user_id = ? AND status = ?", userID, models.ImageJobQueued)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchImageJobs}
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return &jobs[0].NextAttemptAt, nil
+}
+
+/* -~-~-~- Batches -~-~-~- */
+
+// CreateImageBatch creates the batch and queues its jobs, all or nothing.
+func (r *GormImageJobRepository) CreateImageBatch(ctx god.Ctx, batch *models.ImageBatch, jobs []*models.ImageJob) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx core.DBOperations) error {
+		if err := tx.CreateError(batch); err != nil {
+			return err
+		}
+		for _, job := range jobs {
+			job.BatchID = &batch.ID
+		}
+		return tx.CreateError(&jobs)
+	})
+	if err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToCreateImageBatch}
+	}
+	return nil
+}
+
+// GetImageBatch retrieves an image batch by its ID
+func (r *GormImageJobRepository) GetImageBatch(ctx god.Ctx, batchID int) (*models.ImageBatch, error) {
+	var batch models.ImageBatch
+	if err := r.db.WithContext(ctx).FirstError(&batch, batchID); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.ImageBatchNotFound}
+	}
+	return &batch, nil
+}
+
+// GetImageBatchJobs returns the batch's jobs in their batch order
+func (r *GormImageJobRepository) GetImageBatchJobs(ctx god.Ctx, batchID int) ([]*models.ImageJob, error) {
+	var jobs []*models.ImageJob
+	if err := r.db.WithContext(ctx).Order("batch_index ASC").FindError(&jobs, "batch_id = ?", batchID); err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchImageJobs}
+	}
+	return jobs, nil
+}
+
+// CancelImageBatch cancels the batch's jobs that aren't done yet. Returns how many it cancelled.
+func (r *GormImageJobRepository) CancelImageBatch(ctx god.Ctx, batchID int) (int, error) {
+	now := time.Now()
+	rowsAffected, err := r.db.WithContext(ctx).Exec(
+		"UPDATE image_jobs SET status = ?, updated_at = ?, finished_at = ? WHERE batch_id = ? AND status IN (?, ?)",
+		models.ImageJobCancelled, now, now, batchID, models.ImageJobQueued, models.ImageJobRunning,
+	)
+	if err != nil {
+		return 0, &errs.DBErr{Err: err, Context: errs.FailedToUpdateImageJob}
+	}
+	return int(rowsAffected), nil
+}
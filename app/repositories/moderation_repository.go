@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"strings"
+
+	"github.com/gilperopiola/god"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*      - Moderation Repository -      */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// GormModerationRepository implements the ModerationRepository interface using GORM
+type GormModerationRepository struct {
+	db core.DBOperations
+}
+
+// Verify that GormModerationRepository implements the core.ModerationRepository interface
+var _ core.ModerationRepository = (*GormModerationRepository)(nil)
+
+// NewGormModerationRepository creates a new GormModerationRepository
+func NewGormModerationRepository(db core.DBOperations) *GormModerationRepository {
+	return &GormModerationRepository{db: db}
+}
+
+// CreateModerationEvent stores a moderation decision
+func (r *GormModerationRepository) CreateModerationEvent(ctx god.Ctx, event *models.ModerationEvent) error {
+	if err := r.db.WithContext(ctx).CreateError(event); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToCreateModerationEvent}
+	}
+	return nil
+}
+
+// GetModerationEvents retrieves a paginated list of moderation decisions, newest first
+func (r *GormModerationRepository) GetModerationEvents(ctx god.Ctx, filter core.ModerationEventFilter, page, pageSize int) ([]*models.ModerationEvent, int, error) {
+	var events []*models.ModerationEvent
+	var count int64
+
+	conditions, args := []string{"1 = 1"}, []any{}
+	if filter.UserID != 0 {
+		conditions, args = append(conditions, "user_id = ?"), append(args, filter.UserID)
+	}
+	if filter.Action != "" {
+		conditions, args = append(conditions, "action = ?"), append(args, filter.Action)
+	}
+	if filter.Stage != "" {
+		conditions, args = append(conditions, "stage = ?"), append(args, filter.Stage)
+	}
+	where := strings.Join(conditions, " AND ")
+
+	err := r.db.WithContext(ctx).Model(&models.ModerationEvent{}).Where(where, args...).CountError(&count)
+	if err != nil {
+		return nil, 0, &errs.DBErr{Err: err, Context: errs.FailedToFetchModerationEvents}
+	}
+
+	offset := (page - 1) * pageSize
+
+	err = r.db.WithContext(ctx).Order("id DESC").Offset(offset).Limit(pageSize).
+		FindError(&events, append([]any{where}, args...)...)
+	if err != nil {
+		return nil, 0, &errs.DBErr{Err: err, Context: errs.FailedToFetchModerationEvents}
+	}
+
+	return events, int(count), nil
+}
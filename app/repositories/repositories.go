@@ -13,6 +13,11 @@ type RepositoryRegistry struct {
 	UserRepository    core.UserRepository
 	GroupRepository   core.GroupRepository
 	GPTChatRepository core.GPTChatRepository
+	UsageRepository   core.UsageRepository
+
+	ModerationRepository core.ModerationRepository
+	ImageJobRepository   core.ImageJobRepository
+	DocumentRepository   core.DocumentRepository
 }
 
 // NewRepositoryRegistry creates a new RepositoryRegistry with all repositories
@@ -21,5 +26,10 @@ func NewRepositoryRegistry(db core.DBOperations) *RepositoryRegistry {
 		UserRepository:    NewGormUserRepository(db),
 		GroupRepository:   NewGormGroupRepository(db),
 		GPTChatRepository: NewGormGPTChatRepository(db),
+		UsageRepository:   NewGormUsageRepository(db),
+
+		ModerationRepository: NewGormModerationRepository(db),
+		ImageJobRepository:   NewGormImageJobRepository(db),
+		DocumentRepository:   NewGormDocumentRepository(db),
 	}
 }
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"github.com/gilperopiola/god"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*         - Usage Repository -        */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// GormUsageRepository implements the UsageRepository interface using GORM
+type GormUsageRepository struct {
+	db core.DBOperations
+}
+
+// Verify that GormUsageRepository implements the core.UsageRepository interface
+var _ core.UsageRepository = (*GormUsageRepository)(nil)
+
+// NewGormUsageRepository creates a new GormUsageRepository
+func NewGormUsageRepository(db core.DBOperations) *GormUsageRepository {
+	return &GormUsageRepository{db: db}
+}
+
+// CreateUsage stores what a single LLM call used
+func (r *GormUsageRepository) CreateUsage(ctx god.Ctx, usage *models.LLMUsage) error {
+	if err := r.db.WithContext(ctx).CreateError(usage); err != nil {
+		return &errs.DBErr{Err: err, Context: errs.FailedToCreateUsage}
+	}
+	return nil
+}
+
+// GetUsageReport aggregates the usage records by user, model and day, ordered by day
+func (r *GormUsageRepository) GetUsageReport(ctx god.Ctx, filter core.UsageReportFilter) ([]*core.UsageReportRow, error) {
+	var rows []*core.UsageReportRow
+
+	query := r.db.WithContext(ctx).Model(&models.LLMUsage{}).
+		Select(`user_id, model, DATE(created_at) AS day, COUNT(*) AS calls,
+			SUM(prompt_tokens) AS prompt_tokens, SUM(completion_tokens) AS completion_tokens,
+			SUM(reasoning_tokens) AS reasoning_tokens, SUM(total_tokens) AS total_tokens,
+			SUM(images) AS images, SUM(cost_usd) AS cost_usd, AVG(latency_ms) AS avg_latency_ms,
+			SUM(CASE WHEN cached THEN 1 ELSE 0 END) AS cached_calls`).
+		Where("created_at >= ? AND created_at < ?", filter.From, filter.To)
+
+	if filter.UserID != 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Model != "" {
+		query = query.Where("model = ?", filter.Model)
+	}
+
+	err := query.Group("user_id, model, DATE(created_at)").Order("day ASC, user_id ASC, model ASC").ScanError(&rows)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchUsageReport}
+	}
+
+	return rows, nil
+}
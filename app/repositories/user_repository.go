@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"strings"
+
 	"github.com/gilperopiola/god"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
@@ -84,3 +86,17 @@ func (r *GormUserRepository) GetUsers(ctx god.Ctx, page, pageSize int) ([]*model
 
 	return users, int(count), nil
 }
+
+// SearchUsers retrieves up to limit users whose username contains the query, shortest usernames first
+func (r *GormUserRepository) SearchUsers(ctx god.Ctx, query string, limit int) ([]*models.User, error) {
+	var users []*models.User
+
+	pattern := "%" + strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(query) + "%"
+	err := r.db.WithContext(ctx).Where("deleted = ? AND username LIKE ? ESCAPE '!'", false, pattern).
+		Order("LENGTH(username), username").Limit(limit).FindError(&users)
+	if err != nil {
+		return nil, &errs.DBErr{Err: err, Context: errs.FailedToFetchUsers}
+	}
+
+	return users, nil
+}
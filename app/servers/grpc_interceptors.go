@@ -138,3 +138,135 @@ func newCtxCancelledInterceptor() grpc.UnaryServerInterceptor {
 		return next(c, req)
 	}
 }
+
+/* ———————————————————————————————— — — — GRPC STREAM INTERCEPTORS — — — ———————————————————————————————— */
+
+// Same chain as getInterceptors, but for streaming RPCs.
+//
+// The request of a stream is only received after the interceptors run, so the auth and the
+// request validation are done on the stream's first RecvMsg instead. See wrappedServerStream.
+func getStreamInterceptors(tools core.Tools) []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		newStreamRateLimitingInterceptor(tools),
+		newStreamPanicRecovererInterceptor(),
+		newStreamXRequestIDInterceptor(tools),
+		logStreamInterceptor(),
+		validateStreamRouteAuthInterceptor(tools),
+		validateStreamRequestInterceptor(tools),
+		newStreamCtxCancelledInterceptor(),
+	}
+}
+
+/* — — ———————————————————————— — — */
+
+func newStreamRateLimitingInterceptor(tools core.Tools) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+		if ok := tools.AllowRate(); !ok {
+			logs.LogStrange("rate limit exceeded")
+			return status.Error(codes.ResourceExhausted, errs.RateLimitedMsg)
+		}
+		return next(srv, ss)
+	}
+}
+
+func newStreamPanicRecovererInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, next grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				stackBuf := make([]byte, 2048)
+				stackBuf = stackBuf[:runtime.Stack(stackBuf, false)]
+				zap.L().Error("GRPC Stream Panic", zap.Any("error", r), zap.ByteString("stack", stackBuf))
+				err = status.Error(codes.Internal, errs.PanicMsg)
+			}
+		}()
+		return next(srv, ss) // <- Panics happen here
+	}
+}
+
+func newStreamXRequestIDInterceptor(tools core.Tools) grpc.StreamServerInterceptor {
+	const CtxKeyXReqID = "CtxKeyXRequestID"
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+		newID := tools.GenerateID()
+		return next(srv, &wrappedServerStream{ServerStream: ss, ctx: tools.AddToCtx(ss.Context(), CtxKeyXReqID, newID)})
+	}
+}
+
+// The logged duration is the stream's whole lifetime.
+func logStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, i *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+		start := time.Now()
+		err := next(srv, ss)
+		logs.LogGRPC(i.FullMethod, time.Since(start), err)
+		return err
+	}
+}
+
+// Validates the auth when the request is received, and adds the user's info to the stream's context.
+func validateStreamRouteAuthInterceptor(tools core.Tools) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, i *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+		route := core.GetRouteFromGRPCMethod(i.FullMethod)
+
+		// If it's a public endpoint, just go ahead.
+		if route.Auth == core.RouteAuthPublic {
+			return next(srv, ss)
+		}
+
+		return next(srv, &wrappedServerStream{ServerStream: ss, onRecv: func(c context.Context, req any) (context.Context, error) {
+			claims, err := tools.ValidateToken(c, req, route)
+			if err != nil {
+				return nil, err
+			}
+			userID, username := claims.GetUserInfo()
+			return tools.AddUserInfoToCtx(c, userID, username), nil
+		}})
+	}
+}
+
+func validateStreamRequestInterceptor(tools core.Tools) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+		return next(srv, &wrappedServerStream{ServerStream: ss, onRecv: func(c context.Context, req any) (context.Context, error) {
+			return c, tools.ValidateRequest(req)
+		}})
+	}
+}
+
+func newStreamCtxCancelledInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, next grpc.StreamHandler) error {
+		if err := ss.Context().Err(); err != nil {
+			return status.Error(codes.Canceled, err.Error())
+		}
+		return next(srv, ss)
+	}
+}
+
+/* — — ———————————————————————— — — */
+
+// Lets stream interceptors replace the stream's context, and run onRecv on every received message.
+// If onRecv returns a new context, it's used from then on.
+type wrappedServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context // nil means the wrapped stream's one.
+	onRecv func(c context.Context, msg any) (context.Context, error)
+}
+
+func (s *wrappedServerStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return s.ServerStream.Context()
+}
+
+func (s *wrappedServerStream) RecvMsg(msg any) error {
+	if err := s.ServerStream.RecvMsg(msg); err != nil {
+		return err
+	}
+	if s.onRecv == nil {
+		return nil
+	}
+	c, err := s.onRecv(s.Context(), msg)
+	if err != nil {
+		return err
+	}
+	s.ctx = c
+	return nil
+}
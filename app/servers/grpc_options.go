@@ -12,8 +12,10 @@ import (
 /* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
 
 // Basically TLS + Interceptors.
+// Uploaded documents can be up to 20 MB, way more than the 4 MB a server receives by default.
 func getGRPCServerOpts(tools core.Tools, tls bool) []grpc.ServerOption {
-	serverOpts := []grpc.ServerOption{}
+	const maxRecvMsgSize = 32 << 20
+	serverOpts := []grpc.ServerOption{grpc.MaxRecvMsgSize(maxRecvMsgSize)}
 
 	if tls {
 		serverOpts = append(serverOpts, grpc.Creds(tools.GetServerCreds()))
@@ -21,15 +23,19 @@ func getGRPCServerOpts(tools core.Tools, tls bool) []grpc.ServerOption {
 
 	// Chain all Interceptors together under a single Server Option.
 	serverOpts = append(serverOpts, grpc.ChainUnaryInterceptor(getInterceptors(tools)...))
+	serverOpts = append(serverOpts, grpc.ChainStreamInterceptor(getStreamInterceptors(tools)...))
 
 	return serverOpts
 }
 
 // Dial Options are used by the HTTP Gateway when connecting to the GRPC Server.
+// Some responses, like the image batches' zips, are way bigger than the 4 MB a client receives by default.
 func getGRPCDialOpts(tlsClientCreds credentials.TransportCredentials) []grpc.DialOption {
 	const userAgent = "gilperopiola"
+	const maxRecvMsgSize = 256 << 20
 	return []grpc.DialOption{
 		grpc.WithTransportCredentials(tlsClientCreds),
 		grpc.WithUserAgent(userAgent),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxRecvMsgSize)),
 	}
 }
@@ -0,0 +1,51 @@
+package servers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/service"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+/* -~-~-~-~-~ Health Probes -~-~-~-~-~- */
+
+// /livez and /readyz, for orchestrators. They're plain HTTP routes on the Mux, so they don't go through GRPC
+// and its interceptors: no auth and no rate limiting.
+//
+//	/livez  -> 200 while the process can answer. Failing it means we should be restarted.
+//	/readyz -> 200 while every critical check passes, 503 otherwise. Failing it means no traffic should be sent our way.
+func registerHealthProbes(mux *runtime.ServeMux, healthSvc *service.HealthSvc) {
+	logs.LogFatalIfErr(mux.HandlePath(http.MethodGet, "/livez", func(rw http.ResponseWriter, _ *http.Request, _ map[string]string) {
+		body, _ := json.Marshal(map[string]string{"status": "alive", "info": core.G.AppName + " " + core.G.Version})
+		writeProbeResponse(rw, http.StatusOK, body)
+	}))
+
+	// Same as CheckHealth, but it answers with the checks even when we're not ready.
+	readyzMarshaler := &runtime.JSONPb{MarshalOptions: protojson.MarshalOptions{EmitUnpopulated: true}}
+	logs.LogFatalIfErr(mux.HandlePath(http.MethodGet, "/readyz", func(rw http.ResponseWriter, _ *http.Request, _ map[string]string) {
+		report := healthSvc.HealthReport()
+		httpStatus := http.StatusOK
+		if !report.Ready {
+			httpStatus = http.StatusServiceUnavailable
+		}
+
+		body, err := readyzMarshaler.Marshal(report)
+		if err != nil {
+			logs.LogUnexpected(err)
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writeProbeResponse(rw, httpStatus, body)
+	}))
+}
+
+func writeProbeResponse(rw http.ResponseWriter, httpStatus int, body []byte) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(httpStatus)
+	rw.Write(body)
+}
@@ -2,6 +2,7 @@ package servers
 
 import (
 	"net/http"
+	"slices"
 
 	"github.com/gilperopiola/god"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
@@ -64,6 +65,9 @@ var setResponseHeaders middlewareFunc = func(handler http.Handler) http.Handler
 		for key, value := range defaultHeaders {
 			rw.Header().Set(key, value)
 		}
+		if req.Header.Get("Accept") == sseContentType {
+			rw.Header().Set("Cache-Control", "no-cache")
+		}
 		handler.ServeHTTP(rw, req)
 		deleteGRPCHeader(rw)
 	})
@@ -79,10 +83,13 @@ func deleteGRPCHeader(rw http.ResponseWriter) {
 
 // Returns our ServeMuxOptions.
 // ServeMuxOptions are applied to the HTTP Gateway's Mux on creation.
-// For now there's only an error handler.
+// For now there's an error handler, the Server-Sent Events marshaler for streams
+// and the matcher that lets the Svcs set some HTTP headers.
 func getHTTPMuxOpts() []runtime.ServeMuxOption {
 	return []runtime.ServeMuxOption{
 		runtime.WithErrorHandler(handleHTTPError),
+		runtime.WithMarshalerOption(sseContentType, newSSEMarshaler()),
+		runtime.WithOutgoingHeaderMatcher(matchOutgoingHeader),
 		runtime.WithForwardResponseOption(func(_ god.Ctx, rw http.ResponseWriter, _ protoreflect.ProtoMessage) error {
 			deleteGRPCHeader(rw)
 			return nil
@@ -90,6 +97,15 @@ func getHTTPMuxOpts() []runtime.ServeMuxOption {
 	}
 }
 
+// The GRPC header metadata is sent as Grpc-Metadata-* headers, except for the ones on passThroughHeaders,
+// which are sent as they are. Like Content-Disposition, so the downloads have a file name.
+func matchOutgoingHeader(key string) (string, bool) {
+	if slices.Contains(passThroughHeaders, key) {
+		return key, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 /* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
 
 var (
@@ -108,6 +124,9 @@ var (
 		"Access-Control-Allow-Headers": "Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization",
 	}
 
+	// Set by the Svcs as GRPC header metadata, always lowercase.
+	passThroughHeaders = []string{"content-disposition"}
+
 	// Deleted before sending HTTP Response back.
 	grpcHeader = "Grpc-Metadata-Content-Type"
 )
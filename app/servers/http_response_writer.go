@@ -36,6 +36,13 @@ func (rw *httpRespWriter) WriteHeader(statusCode int) {
 	rw.ResponseWriter.WriteHeader(statusCode)
 }
 
+// The HTTP Gateway needs this to send streamed responses as they come.
+func (rw *httpRespWriter) Flush() {
+	if flusher, ok := rw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
 /* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
 
 // Returns nil if the response has not been written yet.
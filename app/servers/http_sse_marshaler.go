@@ -0,0 +1,62 @@
+package servers
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*     - Server-Sent Events (SSE) -    */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+const sseContentType = "text/event-stream"
+
+// Used by the HTTP Gateway on streaming RPCs when the request has 'Accept: text/event-stream'.
+// Requests are still read as JSON.
+//
+// Each streamed message is sent as a 'message' event, and errors as an 'error' event:
+//
+//	event: message
+//	data: {"delta":"Hello"}
+type sseMarshaler struct {
+	runtime.JSONPb
+}
+
+func newSSEMarshaler() runtime.Marshaler {
+	return &sseMarshaler{runtime.JSONPb{
+		UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+	}}
+}
+
+// The Gateway wraps each streamed message as {"result": msg} and errors as {"error": status},
+// we unwrap them and use the key for the event's name.
+func (m *sseMarshaler) Marshal(v any) ([]byte, error) {
+	event := "message"
+	switch chunk := v.(type) {
+	case map[string]any:
+		if result, ok := chunk["result"]; ok {
+			v = result
+		}
+	case map[string]proto.Message:
+		if status, ok := chunk["error"]; ok {
+			event, v = "error", status
+		}
+	}
+
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte("event: "+event+"\ndata: "), append(data, '\n')...), nil
+}
+
+func (m *sseMarshaler) ContentType(_ any) string {
+	return sseContentType
+}
+
+// Events end with an empty line.
+func (m *sseMarshaler) Delimiter() []byte {
+	return []byte("\n")
+}
@@ -66,6 +66,7 @@ func setupGRPC(service *service.Service, serverOpts []grpc.ServerOption) *grpc.S
 func setupHTTP(service *service.Service, muxOpts []runtime.ServeMuxOption, mw middlewareFunc, dialOpts ...grpc.DialOption) *http.Server {
 	mux := runtime.NewServeMux(muxOpts...)
 	service.RegisterInHTTP(mux, dialOpts...)
+	registerHealthProbes(mux, &service.HealthSvc)
 	return &http.Server{
 		Addr:    core.G.HTTPPort,
 		Handler: mw(mux),
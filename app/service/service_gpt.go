@@ -2,121 +2,969 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gilperopiola/grpc-gateway-impl/app/core"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
-	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
 )
 
 type GPTSvc struct {
 	pbs.UnimplementedGPTServiceServer
 	Clients core.Clients
 	Tools   core.Tools
+
+	ImageJobsCfg *core.ImageJobsCfg // For the image batches.
 }
 
 func (svc *GPTSvc) NewGPTChat(ctx context.Context, req *pbs.NewGPTChatRequest) (*pbs.NewGPTChatResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
 
-	gptResponse, err := svc.Clients.SendRequestToGPT(ctx, req.Message)
+	if req.Message, err = svc.moderatePrompt(ctx, userID, req.Message); err != nil {
+		return nil, err
+	}
+
+	budgets, err := svc.checkBudgets(ctx, req.GroupId, userID, models.BudgetTokens)
 	if err != nil {
-		return nil, fmt.Errorf("error calling GPT API: %w", err)
+		return nil, err
 	}
 
-	dbGPTChat, err := svc.Clients.GPTChatRepository().CreateChat(ctx, req.Message)
+	settings := newChatSettings{req.Temperature, req.MaxTokens, req.SystemPromptId, req.PromptVariables}
+	dbGPTChat, err := svc.newChat(ctx, promptTitle(req.Message), userID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	llmReq := newLLMChatRequest(dbGPTChat, req.GetProvider(), req.GetModel(), req.Message)
+	if llmReq.Tools, err = getGPTTools(req.Tools); err != nil {
+		return nil, err
+	}
+	citations, retrievalUsage, err := svc.addDocumentExcerpts(ctx, &llmReq, req.Retrieval, userID)
+	if err != nil {
+		return nil, err
+	}
+	charge := llmCharge{userID, req.GroupId, budgets}
+	contextInfo, err := svc.fitChatHistory(ctx, dbGPTChat, &llmReq, charge)
 	if err != nil {
+		return nil, err
+	}
+
+	callStart := time.Now()
+	gptResponse, toolMessages, err := svc.sendWithTools(ctx, llmReq)
+	if err != nil {
+		return nil, errs.GRPCFromLLM(err)
+	}
+	gptResponse.Content = svc.moderateAnswer(ctx, userID, gptResponse.Content)
+	usage := newChatUsage(models.LLMChat, gptResponse, callStart)
+	svc.consumeBudgets(ctx, budgets, int64(gptResponse.Usage.InTotal))
+
+	answerTitle, answer := splitTitle(gptResponse.Content)
+	var titleUsage *models.LLMUsage
+	dbGPTChat.Title, titleUsage = svc.chatTitle(ctx, answerTitle, req.Message, gptResponse, charge)
+
+	dbGPTChat.Provider, dbGPTChat.Model = string(gptResponse.Provider), string(gptResponse.Model)
+	if dbGPTChat, err = svc.Clients.GPTChatRepository().CreateChat(ctx, dbGPTChat); err != nil {
 		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
 	}
 
+	dbAnswer := newAnswerMessage(answerTitle, answer)
 	dbMessages := []*models.GPTMessage{
-		{Title: "Instructions", From: "user", Content: "You are a highly...", ChatID: dbGPTChat.ID},
-		{Title: "User prompt", From: "user", Content: req.Message, ChatID: dbGPTChat.ID},
-		{Title: "GPT response", From: "assistant", Content: gptResponse, ChatID: dbGPTChat.ID},
+		{Title: "Instructions", From: "system", Content: dbGPTChat.SystemPrompt},
+		{Title: "User prompt", From: "user", Content: req.Message, SenderID: &userID},
 	}
+	dbMessages = append(dbMessages, toolMessages...)
+	dbMessages = append(dbMessages, dbAnswer)
 
-	for _, msg := range dbMessages {
-		if _, err := svc.Clients.GPTChatRepository().CreateMessage(ctx, msg); err != nil {
-			return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
-		}
+	if err := svc.createMessages(ctx, dbGPTChat.ID, dbMessages); err != nil {
+		return nil, err
 	}
 
-	return &pbs.NewGPTChatResponse{GptMessage: gptResponse, Chat: &pbs.GPTChatInfo{Id: int32(dbGPTChat.ID), Title: dbGPTChat.Title}}, nil
+	svc.recordUsage(ctx, usage, userID, req.GroupId, dbAnswer)
+	svc.recordRetrievalUsage(ctx, retrievalUsage, charge, dbAnswer)
+	if titleUsage != nil {
+		svc.recordUsage(ctx, titleUsage, userID, req.GroupId, dbAnswer)
+	}
+
+	return &pbs.NewGPTChatResponse{
+		GptMessage:   dbAnswer.Content,
+		Chat:         svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat),
+		Context:      contextInfo,
+		ToolMessages: svc.toolMessagesInfo(toolMessages),
+		Cached:       gptResponse.Cached,
+		Citations:    citations,
+	}, nil
 }
 
+// ReplyToGPTChat continues a chat. Its owner can always do it, and so can the members
+// of the group it's shared with if the owner allowed it.
+// Each message is attributed to the user who sent it.
 func (svc *GPTSvc) ReplyToGPTChat(ctx context.Context, req *pbs.ReplyToGPTChatRequest) (*pbs.ReplyToGPTChatResponse, error) {
-	dbGPTChat, err := svc.Clients.GPTChatRepository().GetChatByID(ctx, int(req.ChatId))
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
 	if err != nil {
-		if errs.IsDBNotFound(err) {
-			return nil, errs.GRPCNotFound("GPT Chat", int(req.ChatId))
+		return nil, err
+	}
+
+	if req.Message, err = svc.moderatePrompt(ctx, userID, req.Message); err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, err := svc.getChatForUser(ctx, int(req.ChatId), userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	chargedGroupID := req.GroupId
+	if chargedGroupID == nil && dbGPTChat.IsSharedWithGroup() {
+		groupID := int32(*dbGPTChat.GroupID)
+		chargedGroupID = &groupID
+	}
+
+	budgets, err := svc.checkBudgets(ctx, chargedGroupID, userID, models.BudgetTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	llmReq := newLLMChatRequest(dbGPTChat, req.GetProvider(), req.GetModel(), req.Message)
+	if llmReq.Tools, err = getGPTTools(req.Tools); err != nil {
+		return nil, err
+	}
+	citations, retrievalUsage, err := svc.addDocumentExcerpts(ctx, &llmReq, req.Retrieval, userID)
+	if err != nil {
+		return nil, err
+	}
+	charge := llmCharge{userID, chargedGroupID, budgets}
+	contextInfo, err := svc.fitChatHistory(ctx, dbGPTChat, &llmReq, charge)
+	if err != nil {
+		return nil, err
+	}
+
+	callStart := time.Now()
+	gptResponse, toolMessages, err := svc.sendWithTools(ctx, llmReq)
+	if err != nil {
+		return nil, errs.GRPCFromLLM(err)
+	}
+	gptResponse.Content = svc.moderateAnswer(ctx, userID, gptResponse.Content)
+	usage := newChatUsage(models.LLMChat, gptResponse, callStart)
+	svc.consumeBudgets(ctx, budgets, int64(gptResponse.Usage.InTotal))
+
+	dbAnswer := newAnswerMessage(splitTitle(gptResponse.Content))
+	dbMessages := []*models.GPTMessage{{Title: "User response", From: "user", Content: req.Message, SenderID: &userID}}
+	dbMessages = append(dbMessages, toolMessages...)
+	dbMessages = append(dbMessages, dbAnswer)
+
+	if err := svc.createMessages(ctx, dbGPTChat.ID, dbMessages); err != nil {
+		return nil, err
+	}
+
+	svc.recordUsage(ctx, usage, userID, chargedGroupID, dbAnswer)
+	svc.recordRetrievalUsage(ctx, retrievalUsage, charge, dbAnswer)
+
+	return &pbs.ReplyToGPTChatResponse{
+		GptMessage:   dbAnswer.Content,
+		Chat:         svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat),
+		Context:      contextInfo,
+		ToolMessages: svc.toolMessagesInfo(toolMessages),
+		Cached:       gptResponse.Cached,
+		Citations:    citations,
+	}, nil
+}
+
+// StreamGPTChat starts a new chat, or replies to one if ChatId is set, sending GPT's answer as it's written.
+// The same rules as ReplyToGPTChat apply. The answer is stored once it's complete, and if that doesn't happen
+// the prompt isn't kept either, see undoStreamedPrompt.
+// As it's sent before it's complete, the deltas only go through our own rules, see moderatedDeltas.
+// The provider's moderation, and rules that match across sentences, only apply to the stored answer,
+// so the last event may differ from the deltas.
+func (svc *GPTSvc) StreamGPTChat(req *pbs.StreamGPTChatRequest, stream pbs.GPTService_StreamGPTChatServer) error {
+	ctx := stream.Context()
+
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return err
+	}
+
+	if req.Message, err = svc.moderatePrompt(ctx, userID, req.Message); err != nil {
+		return err
+	}
+
+	var dbGPTChat *models.GPTChat
+	chargedGroupID := req.GroupId
+
+	if req.ChatId != nil {
+		if dbGPTChat, err = svc.getChatForUser(ctx, int(req.GetChatId()), userID, true); err != nil {
+			return err
 		}
-		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+		if chargedGroupID == nil && dbGPTChat.IsSharedWithGroup() {
+			groupID := int32(*dbGPTChat.GroupID)
+			chargedGroupID = &groupID
+		}
+	}
+
+	budgets, err := svc.checkBudgets(ctx, chargedGroupID, userID, models.BudgetTokens)
+	if err != nil {
+		return err
 	}
 
-	var prevMsgs []apimodels.GPTChatMsg
-	for _, msg := range dbGPTChat.Messages {
-		prevMsgs = append(prevMsgs, apimodels.GPTChatMsg{Role: msg.From, Content: msg.Content})
+	// On new chats we store the chat and the prompt first, so the chat can be sent on the first event.
+	// Its provider and model are only known after the call if they weren't requested, so they're saved then.
+	isNewChat := dbGPTChat == nil
+	dbMessages := []*models.GPTMessage{{Title: "User response", From: "user", Content: req.Message, SenderID: &userID}}
+	if isNewChat {
+		settings := newChatSettings{req.Temperature, req.MaxTokens, req.SystemPromptId, req.PromptVariables}
+		if dbGPTChat, err = svc.newChat(ctx, promptTitle(req.Message), userID, settings); err != nil {
+			return err
+		}
+		dbGPTChat.Provider, dbGPTChat.Model = req.GetProvider(), req.GetModel()
+		dbMessages = []*models.GPTMessage{
+			{Title: "Instructions", From: "system", Content: dbGPTChat.SystemPrompt},
+			{Title: "User prompt", From: "user", Content: req.Message, SenderID: &userID},
+		}
 	}
 
-	gptResponse, err := svc.Clients.SendRequestToGPT(ctx, req.Message, prevMsgs...)
+	llmReq := newLLMChatRequest(dbGPTChat, req.GetProvider(), req.GetModel(), req.Message)
+	charge := llmCharge{userID, chargedGroupID, budgets}
+	contextInfo, err := svc.fitChatHistory(ctx, dbGPTChat, &llmReq, charge)
 	if err != nil {
-		return nil, fmt.Errorf("error calling GPT API: %w", err)
+		return err
 	}
 
-	dbMessages := []*models.GPTMessage{
-		{Title: "User response", From: "user", Content: req.Message, ChatID: dbGPTChat.ID},
-		{Title: "GPT response", From: "assistant", Content: gptResponse, ChatID: dbGPTChat.ID},
+	if isNewChat {
+		if dbGPTChat, err = svc.Clients.GPTChatRepository().CreateChat(ctx, dbGPTChat); err != nil {
+			return errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+		}
 	}
 
+	// Until the answer is stored, any error takes the prompt back.
+	answered := false
+	defer func() {
+		if !answered {
+			svc.undoStreamedPrompt(ctx, dbGPTChat, isNewChat, dbMessages[len(dbMessages)-1])
+		}
+	}()
+
 	for _, msg := range dbMessages {
+		msg.ChatID = dbGPTChat.ID
 		if _, err := svc.Clients.GPTChatRepository().CreateMessage(ctx, msg); err != nil {
-			return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+			return errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+		}
+	}
+
+	chatEvent := &pbs.StreamGPTChatResponse_Chat{Chat: svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat)}
+	if err := stream.Send(&pbs.StreamGPTChatResponse{Event: chatEvent}); err != nil {
+		return err
+	}
+	contextEvent := &pbs.StreamGPTChatResponse_Context{Context: contextInfo}
+	if err := stream.Send(&pbs.StreamGPTChatResponse{Event: contextEvent}); err != nil {
+		return err
+	}
+
+	sendDelta := func(delta string) error {
+		return stream.Send(&pbs.StreamGPTChatResponse{Event: &pbs.StreamGPTChatResponse_Delta{Delta: delta}})
+	}
+	var moderated *moderatedDeltas
+	if svc.Tools.ModerationEnabled() {
+		moderated = &moderatedDeltas{send: sendDelta, moderate: svc.Tools.ModerateText}
+		sendDelta = moderated.write
+	}
+	deltas := &titleStripper{send: sendDelta}
+
+	callStart := time.Now()
+	gptResponse, err := svc.Clients.StreamRequestToGPT(ctx, llmReq, deltas.write)
+	if err != nil {
+		return errs.GRPCFromLLM(err)
+	}
+	if err := deltas.flush(); err != nil {
+		return err
+	}
+	if moderated != nil {
+		if err := moderated.flush(); err != nil {
+			return err
+		}
+	}
+	usage := newChatUsage(models.LLMChatStream, gptResponse, callStart)
+	svc.consumeBudgets(ctx, budgets, int64(gptResponse.Usage.InTotal))
+	gptResponse.Content = svc.moderateAnswer(ctx, userID, gptResponse.Content)
+	answerTitle, answer := splitTitle(gptResponse.Content)
+
+	var titleUsage *models.LLMUsage
+	if isNewChat {
+		dbGPTChat.Title, titleUsage = svc.chatTitle(ctx, answerTitle, req.Message, gptResponse, charge)
+		dbGPTChat.Provider, dbGPTChat.Model = string(gptResponse.Provider), string(gptResponse.Model)
+		if err := svc.Clients.GPTChatRepository().UpdateChat(ctx, dbGPTChat); err != nil {
+			return errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
 		}
 	}
 
-	return &pbs.ReplyToGPTChatResponse{GptMessage: gptResponse, Chat: &pbs.GPTChatInfo{Id: int32(dbGPTChat.ID), Title: dbGPTChat.Title}}, nil
+	dbGPTMessage := newAnswerMessage(answerTitle, answer)
+	dbGPTMessage.ChatID = dbGPTChat.ID
+	if dbGPTMessage, err = svc.Clients.GPTChatRepository().CreateMessage(ctx, dbGPTMessage); err != nil {
+		return errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	answered = true
+
+	svc.recordUsage(ctx, usage, userID, chargedGroupID, dbGPTMessage)
+	if titleUsage != nil {
+		svc.recordUsage(ctx, titleUsage, userID, chargedGroupID, dbGPTMessage)
+	}
+
+	// New chats only got their title now.
+	if isNewChat {
+		chatEvent := &pbs.StreamGPTChatResponse_Chat{Chat: svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat)}
+		if err := stream.Send(&pbs.StreamGPTChatResponse{Event: chatEvent}); err != nil {
+			return err
+		}
+	}
+
+	messageEvent := &pbs.StreamGPTChatResponse_Message{Message: svc.Tools.GPTMessagesToGPTMessagesInfoPB([]models.GPTMessage{*dbGPTMessage})[0]}
+	return stream.Send(&pbs.StreamGPTChatResponse{Event: messageEvent})
 }
 
+// Streams store the prompt before the answer, so when the answer doesn't get stored the prompt is deleted,
+// and the chat is left as it was. New chats would have nothing else, so they're deleted instead.
+// The stream may have failed because the client left, so its ctx can't be used as it is.
+func (svc *GPTSvc) undoStreamedPrompt(ctx context.Context, dbGPTChat *models.GPTChat, isNewChat bool, dbPrompt *models.GPTMessage) {
+	ctx = context.WithoutCancel(ctx)
+
+	if isNewChat {
+		dbGPTChat.Deleted = true
+		if err := svc.Clients.GPTChatRepository().UpdateChat(ctx, dbGPTChat); err != nil {
+			logs.LogUnexpected(err)
+		}
+		return
+	}
+
+	if dbPrompt.ID == 0 {
+		return
+	}
+	if err := svc.Clients.GPTChatRepository().DeleteHeadMessage(ctx, dbPrompt); err != nil {
+		logs.LogUnexpected(err)
+	}
+}
+
+// NewGPTImage makes an image with DALL-E on a new chat. Async requests are answered right away, see newAsyncGPTImage.
 func (svc *GPTSvc) NewGPTImage(ctx context.Context, req *pbs.NewGPTImageRequest) (*pbs.NewGPTImageResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Message, err = svc.moderatePrompt(ctx, userID, req.Message); err != nil {
+		return nil, err
+	}
 
+	if req.Async {
+		return svc.newAsyncGPTImage(ctx, req, userID)
+	}
+
+	budgets, err := svc.checkBudgets(ctx, req.GroupId, userID, models.BudgetImages)
+	if err != nil {
+		return nil, err
+	}
+
+	callStart := time.Now()
 	dallEResponse, err := svc.Clients.SendRequestToDallE(ctx, req.Message, req.Size)
 	if err != nil {
-		return nil, fmt.Errorf("error calling DALL-E API: %w", err)
+		return nil, errs.GRPCFromLLM(err)
 	}
+	usage := newImageUsage(models.LLMImage, dallEResponse, callStart)
+	svc.consumeBudgets(ctx, budgets, 1)
+
+	dbGPTChat := &models.GPTChat{Title: req.Message, OwnerID: userID, Provider: string(apimodels.LLMOpenAI), Model: string(dallEResponse.Model)}
 
-	// example URL: https://oaidalleapiprodscus.blob.core.windows.net/private/org-QSC7lV...
-	generatedImageURL := dallEResponse.URL
-	if len(strings.Trim(generatedImageURL, " ")) == 0 {
-		return nil, fmt.Errorf("error: empty image URL returned from DALL-E")
+	dbGPTChat, imageInfo, err := svc.addImageToChat(ctx, dbGPTChat, newImagePrompts(req.Message, userID), dallEResponse, usage, userID, req.GroupId)
+	if err != nil {
+		return nil, err
 	}
 
-	// Download image async
-	go func() {
-		if filePath, fileSize, err := svc.Tools.DownloadFileToDisk(generatedImageURL, "png", nil); err != nil {
-			zap.S().Errorf("error downloading image from URL: %v", err)
-		} else {
-			zap.S().Infof("image downloaded successfully: %s (%d bytes)", filePath, fileSize)
+	return &pbs.NewGPTImageResponse{ImageUrl: imageInfo.Url, Image: imageInfo, Chat: svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat)}, nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*           - Chat Settings -         */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// What a new chat is started with, besides its provider and model.
+// Both NewGPTChat and StreamGPTChat can start chats.
+type newChatSettings struct {
+	temperature     *float64
+	maxTokens       *int32
+	systemPromptID  *int32
+	promptVariables map[string]string
+}
+
+// Returns a new chat, not stored yet, with the settings and the rendered system prompt.
+// Without a template, the chat gets our default instructions.
+func (svc *GPTSvc) newChat(ctx context.Context, title string, ownerID int, settings newChatSettings) (*models.GPTChat, error) {
+	dbGPTChat := &models.GPTChat{
+		Title:        title,
+		OwnerID:      ownerID,
+		Temperature:  settings.temperature,
+		SystemPrompt: apimodels.DefaultSystemPrompt,
+	}
+	if settings.maxTokens != nil {
+		maxTokens := int(*settings.maxTokens)
+		dbGPTChat.MaxTokens = &maxTokens
+	}
+
+	if settings.systemPromptID != nil {
+		dbSystemPrompt, err := svc.getSystemPrompt(ctx, int(*settings.systemPromptID))
+		if err != nil {
+			return nil, err
 		}
-	}()
+		if dbGPTChat.SystemPrompt, err = dbSystemPrompt.Render(settings.promptVariables); err != nil {
+			return nil, errs.NewGRPCError(codes.InvalidArgument, err)
+		}
+		dbGPTChat.SystemPromptID = &dbSystemPrompt.ID
+	}
+	dbGPTChat.SetPromptVariables(settings.promptVariables)
+
+	return dbGPTChat, nil
+}
+
+// Builds the request with the chat's settings and the new prompt. Its messages so far are added by fitChatHistory.
+// A requested provider or model is used instead of the chat's. Switching providers without
+// picking a model leaves it to the new provider, as the chat's model is probably not one of its own.
+func newLLMChatRequest(chat *models.GPTChat, provider, model, prompt string) apimodels.LLMChatRequest {
+	llmReq := apimodels.LLMChatRequest{
+		Provider:    apimodels.LLMProviderName(chat.Provider),
+		Model:       apimodels.GPTs(chat.Model),
+		System:      chat.SystemPrompt,
+		Temperature: chat.Temperature,
+		MaxTokens:   chat.MaxTokens,
+	}
+	if provider != "" && provider != chat.Provider {
+		llmReq.Provider, llmReq.Model = apimodels.LLMProviderName(provider), ""
+	}
+	if model != "" {
+		llmReq.Model = apimodels.GPTs(model)
+	}
+
+	llmReq.Messages = []apimodels.GPTChatMsg{{Role: apimodels.LLMRoleUser, Content: prompt}}
+
+	return llmReq
+}
+
+// Stores the messages on the chat, in order.
+func (svc *GPTSvc) createMessages(ctx context.Context, chatID int, dbMessages []*models.GPTMessage) error {
+	for _, msg := range dbMessages {
+		msg.ChatID = chatID
+		if _, err := svc.Clients.GPTChatRepository().CreateMessage(ctx, msg); err != nil {
+			return errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+		}
+	}
+	return nil
+}
+
+func (svc *GPTSvc) toolMessagesInfo(toolMessages []*models.GPTMessage) []*pbs.GPTMessageInfo {
+	msgs := make([]models.GPTMessage, 0, len(toolMessages))
+	for _, msg := range toolMessages {
+		msgs = append(msgs, *msg)
+	}
+	return svc.Tools.GPTMessagesToGPTMessagesInfoPB(msgs)
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - System Prompts -         */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// CreateSystemPrompt stores the template as the next version of its name. Templates are never
+// changed once stored, so chats always know exactly what they were started with.
+func (svc *GPTSvc) CreateSystemPrompt(ctx context.Context, req *pbs.CreateSystemPromptRequest) (*pbs.CreateSystemPromptResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	dbSystemPrompt := &models.SystemPrompt{
+		Name:        req.Name,
+		Description: strings.TrimSpace(req.Description),
+		Template:    req.Template,
+		CreatedByID: userID,
+	}
+	if err := dbSystemPrompt.Validate(); err != nil {
+		return nil, errs.NewGRPCError(codes.InvalidArgument, err)
+	}
+
+	if err := svc.Clients.GPTChatRepository().CreateSystemPrompt(ctx, dbSystemPrompt); err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return &pbs.CreateSystemPromptResponse{Prompt: svc.Tools.SystemPromptToSystemPromptInfoPB(dbSystemPrompt)}, nil
+}
 
-	dbGPTChat, err := svc.Clients.GPTChatRepository().CreateChat(ctx, req.Message)
+// ListSystemPrompts returns every version of the system prompts, or only of the ones with the given name.
+func (svc *GPTSvc) ListSystemPrompts(ctx context.Context, req *pbs.ListSystemPromptsRequest) (*pbs.ListSystemPromptsResponse, error) {
+	dbSystemPrompts, err := svc.Clients.GPTChatRepository().GetSystemPrompts(ctx, req.GetName())
 	if err != nil {
 		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
 	}
 
-	dbMessages := []*models.GPTMessage{
-		{Title: "Instructions", From: "user", Content: "You are a highly accurate image generator AI...", ChatID: dbGPTChat.ID},
-		{Title: "User prompt", From: "user", Content: req.Message, ChatID: dbGPTChat.ID},
-		{Title: "DALL-E response", From: "assistant", Content: generatedImageURL, ChatID: dbGPTChat.ID},
+	return &pbs.ListSystemPromptsResponse{Prompts: svc.Tools.SystemPromptsToSystemPromptsInfoPB(dbSystemPrompts)}, nil
+}
+
+func (svc *GPTSvc) GetSystemPrompt(ctx context.Context, req *pbs.GetSystemPromptRequest) (*pbs.GetSystemPromptResponse, error) {
+	dbSystemPrompt, err := svc.getSystemPrompt(ctx, int(req.PromptId))
+	if err != nil {
+		return nil, err
 	}
 
-	for _, msg := range dbMessages {
-		if _, err := svc.Clients.GPTChatRepository().CreateMessage(ctx, msg); err != nil {
+	return &pbs.GetSystemPromptResponse{Prompt: svc.Tools.SystemPromptToSystemPromptInfoPB(dbSystemPrompt)}, nil
+}
+
+func (svc *GPTSvc) getSystemPrompt(ctx context.Context, promptID int) (*models.SystemPrompt, error) {
+	dbSystemPrompt, err := svc.Clients.GPTChatRepository().GetSystemPromptByID(ctx, promptID)
+	if err != nil {
+		if errs.IsDBNotFound(err) {
+			return nil, errs.GRPCNotFound("System Prompt", promptID)
+		}
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	return dbSystemPrompt, nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*         - Chats Management -        */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// ListMyGPTChats returns the user's own chats, newest first. Chats shared by others aren't included.
+func (svc *GPTSvc) ListMyGPTChats(ctx context.Context, req *pbs.ListMyGPTChatsRequest) (*pbs.ListMyGPTChatsResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := svc.Tools.PaginatedRequest(req)
+
+	dbGPTChats, totalMatches, err := svc.Clients.GPTChatRepository().GetChatsByOwnerID(ctx, userID, page, pageSize)
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return &pbs.ListMyGPTChatsResponse{
+		Chats:      svc.Tools.GPTChatsToGPTChatsInfoPB(dbGPTChats),
+		Pagination: svc.Tools.PaginatedResponse(page, pageSize, totalMatches),
+	}, nil
+}
+
+// RenameGPTChat changes the chat's title. Only its owner can do this.
+func (svc *GPTSvc) RenameGPTChat(ctx context.Context, req *pbs.RenameGPTChatRequest) (*pbs.RenameGPTChatResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, err := svc.getOwnChat(ctx, int(req.ChatId), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChat.Title = strings.TrimSpace(req.Title)
+	if dbGPTChat.Title == "" {
+		return nil, errs.NewGRPCError(codes.InvalidArgument, errors.New("title can't be blank"))
+	}
+
+	if err := svc.Clients.GPTChatRepository().UpdateChat(ctx, dbGPTChat); err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return &pbs.RenameGPTChatResponse{Chat: svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat)}, nil
+}
+
+// DeleteGPTChat soft deletes the chat. Only its owner can do this.
+// If it was shared with a group, its members can't see it anymore either.
+func (svc *GPTSvc) DeleteGPTChat(ctx context.Context, req *pbs.DeleteGPTChatRequest) (*pbs.DeleteGPTChatResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, err := svc.getOwnChat(ctx, int(req.ChatId), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChat.Deleted = true
+	if err := svc.Clients.GPTChatRepository().UpdateChat(ctx, dbGPTChat); err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return &pbs.DeleteGPTChatResponse{ChatId: int32(dbGPTChat.ID)}, nil
+}
+
+// GetGPTChat returns the chat and the messages on its branch, if the user can read it.
+func (svc *GPTSvc) GetGPTChat(ctx context.Context, req *pbs.GetGPTChatRequest) (*pbs.GetGPTChatResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, err := svc.getChatForUser(ctx, int(req.ChatId), userID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.GetGPTChatResponse{
+		Chat:     svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat),
+		Messages: svc.branchMessagesInfo(dbGPTChat),
+	}, nil
+}
+
+// ShareGPTChat shares the chat with a group. Only the chat's owner can do it, and only with
+// a group they are a member of. Sharing it with a new group leaves a chat_shared event on its activity.
+func (svc *GPTSvc) ShareGPTChat(ctx context.Context, req *pbs.ShareGPTChatRequest) (*pbs.ShareGPTChatResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, err := svc.getOwnChat(ctx, int(req.ChatId), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupID := int(req.GroupId)
+	if isMember, err := svc.isGroupMember(ctx, groupID, userID); err != nil || !isMember {
+		return nil, errs.GRPCPermissionDenied("not a member of the group")
+	}
+
+	alreadyShared := dbGPTChat.IsSharedWithGroup() && *dbGPTChat.GroupID == groupID
+
+	dbGPTChat.Visibility = models.GPTChatGroup
+	dbGPTChat.GroupID = &groupID
+	dbGPTChat.GroupCanReply = req.GroupCanReply
+
+	if alreadyShared {
+		err = svc.Clients.GPTChatRepository().UpdateChat(ctx, dbGPTChat)
+	} else {
+		details := map[string]string{"chat_id": strconv.Itoa(dbGPTChat.ID), "title": dbGPTChat.Title}
+		activity := models.NewGroupActivity(groupID, userID, models.ChatSharedActivity, 0, details)
+		err = svc.Clients.GPTChatRepository().ShareChatWithGroup(ctx, dbGPTChat, activity)
+	}
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return &pbs.ShareGPTChatResponse{Chat: svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat)}, nil
+}
+
+// UnshareGPTChat makes the chat private again. Only the chat's owner can do it.
+func (svc *GPTSvc) UnshareGPTChat(ctx context.Context, req *pbs.UnshareGPTChatRequest) (*pbs.UnshareGPTChatResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, err := svc.getOwnChat(ctx, int(req.ChatId), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChat.Visibility = models.GPTChatPrivate
+	dbGPTChat.GroupID = nil
+	dbGPTChat.GroupCanReply = false
+
+	if err := svc.Clients.GPTChatRepository().UpdateChat(ctx, dbGPTChat); err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return &pbs.UnshareGPTChatResponse{Chat: svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat)}, nil
+}
+
+// ListGroupChats returns the chats shared with the group. Only its members can see them.
+func (svc *GPTSvc) ListGroupChats(ctx context.Context, req *pbs.ListGroupChatsRequest) (*pbs.ListGroupChatsResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	groupID := int(req.GroupId)
+	if isMember, err := svc.isGroupMember(ctx, groupID, userID); err != nil || !isMember {
+		return nil, errs.GRPCPermissionDenied("not a member of the group")
+	}
+
+	page, pageSize := svc.Tools.PaginatedRequest(req)
+
+	dbGPTChats, totalMatches, err := svc.Clients.GPTChatRepository().GetChatsByGroupID(ctx, groupID, page, pageSize)
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return &pbs.ListGroupChatsResponse{
+		Chats:      svc.Tools.GPTChatsToGPTChatsInfoPB(dbGPTChats),
+		Pagination: svc.Tools.PaginatedResponse(page, pageSize, totalMatches),
+	}, nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*            - Chats Auth -           */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Gets the chat if the user can read it, or reply to it if toReply is true.
+//
+// The owner can do everything. If the chat is shared with a group, its members can read it
+// and reply only if GroupCanReply. Anyone else gets a NotFound, so we don't leak which chats exist.
+func (svc *GPTSvc) getChatForUser(ctx context.Context, chatID, userID int, toReply bool) (*models.GPTChat, error) {
+	dbGPTChat, err := svc.Clients.GPTChatRepository().GetChatByID(ctx, chatID)
+	if err != nil {
+		if errs.IsDBNotFound(err) {
+			return nil, errs.GRPCNotFound("GPT Chat", chatID)
+		}
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	if dbGPTChat.OwnerID == userID {
+		return dbGPTChat, nil
+	}
+
+	if dbGPTChat.IsSharedWithGroup() {
+		isMember, err := svc.isGroupMember(ctx, *dbGPTChat.GroupID, userID)
+		if err != nil {
 			return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
 		}
+		if isMember {
+			if toReply && !dbGPTChat.GroupCanReply {
+				return nil, errs.GRPCPermissionDenied("chat is read-only for the group")
+			}
+			return dbGPTChat, nil
+		}
+	}
+
+	return nil, errs.GRPCNotFound("GPT Chat", chatID)
+}
+
+// Gets the chat only if the user is its owner.
+func (svc *GPTSvc) getOwnChat(ctx context.Context, chatID, userID int) (*models.GPTChat, error) {
+	dbGPTChat, err := svc.getChatForUser(ctx, chatID, userID, false)
+	if err != nil {
+		return nil, err
+	}
+	if dbGPTChat.OwnerID != userID {
+		return nil, errs.GRPCPermissionDenied("only the chat's owner can do this")
+	}
+	return dbGPTChat, nil
+}
+
+// Deleted groups have no members.
+func (svc *GPTSvc) isGroupMember(ctx context.Context, groupID, userID int) (bool, error) {
+	group, err := svc.Clients.GroupRepository().GetGroupByID(ctx, groupID)
+	if err != nil {
+		if errs.IsDBNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if group.OwnerID == userID {
+		return true, nil
+	}
+	for _, member := range group.Memberships {
+		if member.UserID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - Usage Budgets -          */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Returns the budgets the user's call counts against, or a ResourceExhausted
+// if any of them has nothing left for this month.
+// On behalf of a group, those are the group-wide one and the user's own one there. Otherwise,
+// the user's own ones on every group they're on, so not sending a group doesn't get around them.
+//
+// What the call uses is only charged once it's done, see consumeBudgets, so calls made
+// at the same time can all pass this check and together go over the budget by what they use.
+func (svc *GPTSvc) checkBudgets(ctx context.Context, groupID *int32, userID int, unit models.BudgetUnit) ([]*models.UsageBudget, error) {
+	var budgets []*models.UsageBudget
+	var err error
+
+	if groupID == nil {
+		budgets, err = svc.Clients.GroupRepository().GetMemberUsageBudgets(ctx, userID, unit)
+	} else {
+		if isMember, err := svc.isGroupMember(ctx, int(*groupID), userID); err != nil || !isMember {
+			return nil, errs.GRPCPermissionDenied("not a member of the group")
+		}
+		budgets, err = svc.Clients.GroupRepository().GetUsageBudgetsForUser(ctx, int(*groupID), userID, unit)
+	}
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	now := time.Now()
+	for _, budget := range budgets {
+		if budget.RemainingIn(models.BudgetPeriod(now)) <= 0 {
+			return nil, errBudgetExhausted(budget, budgets, now)
+		}
+	}
+
+	return budgets, nil
+}
+
+// Charges what the call used to its budgets. The call was already made and paid for,
+// so if this fails we just log it instead of failing the request.
+func (svc *GPTSvc) consumeBudgets(ctx context.Context, budgets []*models.UsageBudget, amount int64) {
+	if len(budgets) == 0 || amount <= 0 {
+		return
+	}
+
+	budgetIDs := make([]int, 0, len(budgets))
+	for _, budget := range budgets {
+		budgetIDs = append(budgetIDs, budget.ID)
+	}
+
+	period := models.BudgetPeriod(time.Now())
+	if err := svc.Clients.GroupRepository().ConsumeUsageBudgets(ctx, budgetIDs, amount, period); err != nil {
+		logs.LogUnexpected(err)
+	}
+}
+
+// The QuotaFailure says how much is left of every budget the call counts against,
+// and the ErrorInfo has the one that ran out in a machine readable way.
+func errBudgetExhausted(exhausted *models.UsageBudget, budgets []*models.UsageBudget, now time.Time) error {
+	period := models.BudgetPeriod(now)
+	resetsAt := models.BudgetResetsAt(now).Format(time.RFC3339)
+
+	quotaFailure := &errdetails.QuotaFailure{}
+	for _, budget := range budgets {
+		quotaFailure.Violations = append(quotaFailure.Violations, &errdetails.QuotaFailure_Violation{
+			Subject: budgetSubject(budget),
+			Description: fmt.Sprintf("%d of %d %s left until %s",
+				budget.RemainingIn(period), budget.MonthlyLimit, budget.Unit, resetsAt),
+		})
+	}
+
+	errorInfo := &errdetails.ErrorInfo{
+		Reason: "BUDGET_EXHAUSTED",
+		Domain: core.G.AppName,
+		Metadata: map[string]string{
+			"budget_id":     strconv.Itoa(exhausted.ID),
+			"group_id":      strconv.Itoa(exhausted.GroupID),
+			"user_id":       strconv.Itoa(exhausted.UserID),
+			"unit":          string(exhausted.Unit),
+			"monthly_limit": strconv.FormatInt(exhausted.MonthlyLimit, 10),
+			"remaining":     strconv.FormatInt(exhausted.RemainingIn(period), 10),
+			"resets_at":     resetsAt,
+		},
+	}
+
+	reason := fmt.Sprintf("monthly %s budget of %s exhausted, it resets at %s", exhausted.Unit, budgetSubject(exhausted), resetsAt)
+	return errs.GRPCResourceExhausted(reason, quotaFailure, errorInfo)
+}
+
+func budgetSubject(budget *models.UsageBudget) string {
+	if budget.IsGroupWide() {
+		return fmt.Sprintf("group:%d", budget.GroupID)
+	}
+	return fmt.Sprintf("group:%d/user:%d", budget.GroupID, budget.UserID)
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - Usage Accounting -       */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// GetUsageReport aggregates the usage by user, model and day. Both dates are inclusive,
+// and without them we report the current month.
+func (svc *GPTSvc) GetUsageReport(ctx context.Context, req *pbs.GetUsageReportRequest) (*pbs.GetUsageReportResponse, error) {
+	now := time.Now().UTC()
+	filter := core.UsageReportFilter{
+		From:   time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1),
+		UserID: int(req.GetUserId()),
+		Model:  req.GetModel(),
+	}
+
+	if req.From != nil {
+		from, err := time.Parse(time.DateOnly, req.GetFrom())
+		if err != nil {
+			return nil, errs.NewGRPCError(codes.InvalidArgument, fmt.Errorf("invalid from date: %w", err))
+		}
+		filter.From = from
+	}
+	if req.To != nil {
+		to, err := time.Parse(time.DateOnly, req.GetTo())
+		if err != nil {
+			return nil, errs.NewGRPCError(codes.InvalidArgument, fmt.Errorf("invalid to date: %w", err))
+		}
+		filter.To = to.AddDate(0, 0, 1)
+	}
+	if !filter.From.Before(filter.To) {
+		return nil, errs.NewGRPCError(codes.InvalidArgument, errors.New("from date must not be after to date"))
 	}
 
-	return &pbs.NewGPTImageResponse{ImageUrl: generatedImageURL, Chat: &pbs.GPTChatInfo{Id: int32(dbGPTChat.ID), Title: dbGPTChat.Title}}, nil
+	rows, err := svc.Clients.UsageRepository().GetUsageReport(ctx, filter)
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	rowsPB, total := svc.Tools.UsageReportToUsageReportPB(rows)
+	return &pbs.GetUsageReportResponse{Rows: rowsPB, Total: total}, nil
+}
+
+func newChatUsage(operation models.LLMOperation, result apimodels.GPTChatResult, callStart time.Time) *models.LLMUsage {
+	return &models.LLMUsage{
+		Operation:        operation,
+		Provider:         string(result.Provider),
+		Model:            string(result.Model),
+		PromptTokens:     result.Usage.InPrompt,
+		CompletionTokens: result.Usage.InCompletion,
+		ReasoningTokens:  result.Usage.CompletionDetails.InReasoning,
+		TotalTokens:      result.Usage.InTotal,
+		LatencyMs:        time.Since(callStart).Milliseconds(),
+		CostUSD:          result.Usage.CostUSD(result.Model),
+		Cached:           result.Cached,
+	}
+}
+
+func newImageUsage(operation models.LLMOperation, image apimodels.GPTImageMsg, callStart time.Time) *models.LLMUsage {
+	return &models.LLMUsage{
+		Operation: operation,
+		Provider:  string(apimodels.LLMOpenAI),
+		Model:     string(image.Model),
+		Images:    1,
+		LatencyMs: time.Since(callStart).Milliseconds(),
+		CostUSD:   image.CostUSD(),
+	}
+}
+
+// Embeddings only use prompt tokens.
+func newEmbeddingsUsage(result apimodels.EmbeddingsResult, callStart time.Time) *models.LLMUsage {
+	return &models.LLMUsage{
+		Operation:    models.LLMEmbedding,
+		Provider:     string(result.Provider),
+		Model:        string(result.Model),
+		PromptTokens: result.Usage.InPrompt,
+		TotalTokens:  result.Usage.InTotal,
+		LatencyMs:    time.Since(callStart).Milliseconds(),
+		CostUSD:      result.Usage.CostUSD(result.Model),
+	}
+}
+
+// Stores the call's usage against the user, the group it was charged to and the message with the answer.
+// Like with the budgets, the call was already made, so if this fails we just log it.
+func (svc *GPTSvc) recordUsage(ctx context.Context, usage *models.LLMUsage, userID int, groupID *int32, answer *models.GPTMessage) {
+	usage.UserID = userID
+	usage.ChatID = answer.ChatID
+	usage.MessageID = answer.ID
+	if groupID != nil {
+		chargedGroupID := int(*groupID)
+		usage.GroupID = &chargedGroupID
+	}
+
+	if err := svc.Clients.UsageRepository().CreateUsage(ctx, usage); err != nil {
+		logs.LogUnexpected(err)
+	}
 }
@@ -0,0 +1,389 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - Image Batches -          */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// CreateImageBatch renders the template with each combination of values and queues an image job for each prompt,
+// all of them going to a new chat. The workers make them like any other async image.
+//
+// The jobs are due one BatchInterval after the other, starting after the user's last queued job,
+// so no matter how many batches a user creates we don't go over DALL-E's rate limits.
+func (svc *GPTSvc) CreateImageBatch(ctx context.Context, req *pbs.CreateImageBatchRequest) (*pbs.ImageBatchInfo, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	prompts, err := renderImageBatchPrompts(req, svc.ImageJobsCfg.MaxBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	for _, prompt := range prompts {
+		if prompt.text, err = svc.moderatePrompt(ctx, userID, prompt.text); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := svc.checkBudgets(ctx, req.GroupId, userID, models.BudgetImages); err != nil {
+		return nil, err
+	}
+
+	dbGPTChat := &models.GPTChat{Title: req.Template, OwnerID: userID, Provider: string(apimodels.LLMOpenAI), Model: string(apimodels.DALL_E3)}
+	dbGPTChat, err = svc.Clients.GPTChatRepository().CreateChat(ctx, dbGPTChat)
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	if err := svc.createMessages(ctx, dbGPTChat.ID, newImagePrompts(req.Template, userID)); err != nil {
+		return nil, err
+	}
+
+	startAt, err := svc.nextImageBatchStart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*models.ImageJob, 0, len(prompts))
+	for i, prompt := range prompts {
+		variablesJSON, _ := json.Marshal(prompt.variables)
+		jobs = append(jobs, &models.ImageJob{
+			OperationID:   svc.Tools.GenerateID(),
+			UserID:        userID,
+			GroupID:       int32PtrToIntPtr(req.GroupId),
+			ChatID:        dbGPTChat.ID,
+			Prompt:        prompt.text,
+			Size:          int32(req.Size),
+			Status:        models.ImageJobQueued,
+			NextAttemptAt: startAt.Add(time.Duration(i) * svc.ImageJobsCfg.BatchInterval),
+			BatchIndex:    i,
+			Variables:     string(variablesJSON),
+		})
+	}
+
+	batch := &models.ImageBatch{
+		UserID:   userID,
+		GroupID:  int32PtrToIntPtr(req.GroupId),
+		ChatID:   dbGPTChat.ID,
+		Template: req.Template,
+		Mode:     imageBatchModeFromPB(req.Mode),
+		Total:    len(jobs),
+	}
+	if err := svc.Clients.ImageJobRepository().CreateImageBatch(ctx, batch, jobs); err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	logs.LogEvent("Image batch created", "batch_id", batch.ID, "user_id", userID, "mode", batch.Mode,
+		"total", batch.Total, "starts_at", startAt.Format(time.RFC3339))
+
+	return svc.Tools.ImageBatchToImageBatchInfoPB(batch, dbGPTChat, jobs), nil
+}
+
+// GetImageBatch returns a batch with its progress. Other users' batches don't exist for the caller.
+func (svc *GPTSvc) GetImageBatch(ctx context.Context, req *pbs.GetImageBatchRequest) (*pbs.ImageBatchInfo, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, jobs, err := svc.getImageBatchForUser(ctx, int(req.BatchId), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, err := svc.Clients.GPTChatRepository().GetChatByID(ctx, batch.ChatID)
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return svc.Tools.ImageBatchToImageBatchInfoPB(batch, dbGPTChat, jobs), nil
+}
+
+// CancelImageBatch cancels the batch's jobs that aren't done yet, like CancelGPTOperation does with each one.
+func (svc *GPTSvc) CancelImageBatch(ctx context.Context, req *pbs.CancelImageBatchRequest) (*pbs.ImageBatchInfo, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := svc.getImageBatchForUser(ctx, int(req.BatchId), userID); err != nil {
+		return nil, err
+	}
+
+	cancelled, err := svc.Clients.ImageJobRepository().CancelImageBatch(ctx, int(req.BatchId))
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	if cancelled > 0 {
+		logs.LogEvent("Image batch cancelled", "batch_id", req.BatchId, "user_id", userID, "jobs", cancelled)
+	}
+
+	return svc.GetImageBatch(ctx, &pbs.GetImageBatchRequest{BatchId: req.BatchId})
+}
+
+// DownloadImageBatch zips the batch's images made so far with a manifest.json describing all of them.
+// Images are named by their index and prompt, like images/007_an-illustration-of-a-furious-boxer.png.
+func (svc *GPTSvc) DownloadImageBatch(ctx context.Context, req *pbs.DownloadImageBatchRequest) (*httpbody.HttpBody, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, jobs, err := svc.getImageBatchForUser(ctx, int(req.BatchId), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var zipped bytes.Buffer
+	zipWriter := zip.NewWriter(&zipped)
+
+	manifest := imageBatchManifest{
+		BatchID:   batch.ID,
+		ChatID:    batch.ChatID,
+		Template:  batch.Template,
+		Mode:      string(batch.Mode),
+		Total:     batch.Total,
+		CreatedAt: batch.CreatedAt.Format(time.RFC3339),
+		Items:     make([]imageBatchManifestItem, 0, len(jobs)),
+	}
+
+	for _, job := range jobs {
+		item := imageBatchManifestItem{
+			Index:       job.BatchIndex,
+			OperationID: job.OperationID,
+			Prompt:      job.Prompt,
+			Variables:   json.RawMessage(cmp.Or(job.Variables, "{}")),
+			Status:      string(job.Status),
+			Error:       job.LastError,
+		}
+		if job.Status == models.ImageJobSucceeded && job.MediaID != nil {
+			item.File, item.Error = svc.zipBatchImage(ctx, zipWriter, job)
+		}
+		manifest.Items = append(manifest.Items, item)
+	}
+
+	manifestJSON, _ := json.MarshalIndent(manifest, "", "  ")
+	manifestFile, err := zipWriter.Create("manifest.json")
+	if err == nil {
+		_, err = manifestFile.Write(manifestJSON)
+	}
+	if err == nil {
+		err = zipWriter.Close()
+	}
+	if err != nil {
+		return nil, errs.NewGRPCError(codes.Internal, err)
+	}
+
+	setDownloadFileName(ctx, fmt.Sprintf("image-batch-%d.zip", batch.ID))
+	return &httpbody.HttpBody{ContentType: "application/zip", Data: zipped.Bytes()}, nil
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+type imageBatchPrompt struct {
+	text      string
+	variables map[string]string
+}
+
+// Returns the prompts in their batch order, which is the order of the combinations,
+// the last variable changing first. Sampled ones keep that order too.
+func renderImageBatchPrompts(req *pbs.CreateImageBatchRequest, maxSize int) ([]*imageBatchPrompt, error) {
+	tmpl, err := template.New("batch").Option("missingkey=error").Parse(req.Template)
+	if err != nil {
+		return nil, errs.GRPCInvalidArgument("invalid template: " + err.Error())
+	}
+
+	combinations := int64(1)
+	seen := map[string]bool{}
+	for _, variable := range req.Variables {
+		if seen[variable.Name] {
+			return nil, errs.GRPCInvalidArgument("variable " + variable.Name + " is repeated")
+		}
+		seen[variable.Name] = true
+		combinations *= int64(len(variable.Values))
+	}
+
+	var indexes []int64
+	switch req.Mode {
+	case pbs.ImageBatchMode_IMAGE_BATCH_MODE_SAMPLE:
+		if req.SampleSize == 0 {
+			return nil, errs.GRPCInvalidArgument("sample_size is required when sampling")
+		}
+		indexes = sampleImageBatchIndexes(combinations, min(int64(req.SampleSize), combinations))
+	default:
+		if combinations > int64(maxSize) {
+			return nil, errs.GRPCInvalidArgument(fmt.Sprintf("the batch would have %d images and the most is %d, try sampling them", combinations, maxSize))
+		}
+		for i := range combinations {
+			indexes = append(indexes, i)
+		}
+	}
+	if len(indexes) > maxSize {
+		return nil, errs.GRPCInvalidArgument(fmt.Sprintf("the batch would have %d images and the most is %d", len(indexes), maxSize))
+	}
+
+	prompts := make([]*imageBatchPrompt, 0, len(indexes))
+	for _, index := range indexes {
+		variables := make(map[string]string, len(req.Variables))
+		for i := len(req.Variables) - 1; i >= 0; i-- {
+			values := req.Variables[i].Values
+			variables[req.Variables[i].Name] = values[index%int64(len(values))]
+			index /= int64(len(values))
+		}
+
+		var rendered strings.Builder
+		if err := tmpl.Execute(&rendered, variables); err != nil {
+			return nil, errs.GRPCInvalidArgument("the template can't be rendered with the variables: " + err.Error())
+		}
+		prompts = append(prompts, &imageBatchPrompt{text: rendered.String(), variables: variables})
+	}
+	return prompts, nil
+}
+
+// Returns n different indexes out of total, sorted. n is small, so picking again the repeated ones is fine.
+func sampleImageBatchIndexes(total, n int64) []int64 {
+	picked := make(map[int64]bool, n)
+	indexes := make([]int64, 0, n)
+	for int64(len(indexes)) < n {
+		index := rand.Int63n(total)
+		if !picked[index] {
+			picked[index] = true
+			indexes = append(indexes, index)
+		}
+	}
+	slices.Sort(indexes)
+	return indexes
+}
+
+// A new batch starts right away, or a BatchInterval after the user's last queued job if that's later.
+func (svc *GPTSvc) nextImageBatchStart(ctx context.Context, userID int) (time.Time, error) {
+	startAt := time.Now()
+	lastAt, err := svc.Clients.ImageJobRepository().LastScheduledImageJobAt(ctx, userID)
+	if err != nil {
+		return startAt, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	if lastAt != nil && lastAt.Add(svc.ImageJobsCfg.BatchInterval).After(startAt) {
+		startAt = lastAt.Add(svc.ImageJobsCfg.BatchInterval)
+	}
+	return startAt, nil
+}
+
+func (svc *GPTSvc) getImageBatchForUser(ctx context.Context, batchID, userID int) (*models.ImageBatch, []*models.ImageJob, error) {
+	batch, err := svc.Clients.ImageJobRepository().GetImageBatch(ctx, batchID)
+	if err != nil {
+		if errs.IsDBNotFound(err) {
+			return nil, nil, errs.GRPCNotFound("Image Batch", batchID)
+		}
+		return nil, nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	if batch.UserID != userID {
+		return nil, nil, errs.GRPCNotFound("Image Batch", batchID)
+	}
+
+	jobs, err := svc.Clients.ImageJobRepository().GetImageBatchJobs(ctx, batchID)
+	if err != nil {
+		return nil, nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	return batch, jobs, nil
+}
+
+// Adds the job's image to the zip. Returns its file name, or why it's not there.
+func (svc *GPTSvc) zipBatchImage(ctx context.Context, zipWriter *zip.Writer, job *models.ImageJob) (string, string) {
+	dbMedia, err := svc.Clients.GPTChatRepository().GetMediaByID(ctx, *job.MediaID)
+	if err != nil {
+		logs.LogUnexpected(err)
+		return "", "the image couldn't be found"
+	}
+	if dbMedia.Status != models.GPTMediaStored {
+		return "", "the image isn't stored yet, download the batch again later"
+	}
+
+	content, err := svc.Tools.ReadMedia(dbMedia)
+	if err != nil {
+		logs.LogUnexpected(err)
+		return "", "the image couldn't be read"
+	}
+
+	fileName := fmt.Sprintf("images/%03d_%s%s", job.BatchIndex+1, slugify(job.Prompt, 60), imageExtension(dbMedia.ContentType))
+	file, err := zipWriter.Create(fileName)
+	if err == nil {
+		_, err = file.Write(content)
+	}
+	if err != nil {
+		logs.LogUnexpected(err)
+		return "", "the image couldn't be zipped"
+	}
+	return fileName, ""
+}
+
+type imageBatchManifest struct {
+	BatchID   int                      `json:"batch_id"`
+	ChatID    int                      `json:"chat_id"`
+	Template  string                   `json:"template"`
+	Mode      string                   `json:"mode"`
+	Total     int                      `json:"total"`
+	CreatedAt string                   `json:"created_at"`
+	Items     []imageBatchManifestItem `json:"items"`
+}
+
+type imageBatchManifestItem struct {
+	Index       int             `json:"index"`
+	OperationID string          `json:"operation_id"`
+	Prompt      string          `json:"prompt"`
+	Variables   json.RawMessage `json:"variables"`
+	Status      string          `json:"status"`
+	File        string          `json:"file,omitempty"` // Inside the zip, only if it's there.
+	Error       string          `json:"error,omitempty"`
+}
+
+func imageBatchModeFromPB(mode pbs.ImageBatchMode) models.ImageBatchMode {
+	if mode == pbs.ImageBatchMode_IMAGE_BATCH_MODE_SAMPLE {
+		return models.ImageBatchSample
+	}
+	return models.ImageBatchProduct
+}
+
+func imageExtension(contentType string) string {
+	switch contentType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/webp":
+		return ".webp"
+	default:
+		return ".png"
+	}
+}
+
+// ASCII lowercase letters and digits, with dashes between words. Cut to maxLen.
+func slugify(text string, maxLen int) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	})
+	slug := strings.Join(words, "-")
+	if len(slug) > maxLen {
+		slug = strings.TrimRight(slug[:maxLen], "-")
+	}
+	return slug
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"google.golang.org/grpc/codes"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*           - Chat Branches -         */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Each message follows a parent, and messages with the same parent are alternatives of each other.
+// Regenerating an answer or editing a prompt adds one, starting a new branch that becomes the chat's.
+// Only the chat's branch is shown and sent to GPT, see models.GPTChat.BranchMessages.
+
+// RegenerateGPTReply answers the last prompt on the chat's branch again. Anyone who can reply to the chat can do it.
+func (svc *GPTSvc) RegenerateGPTReply(ctx context.Context, req *pbs.RegenerateGPTReplyRequest) (*pbs.RegenerateGPTReplyResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, err := svc.getChatForUser(ctx, int(req.ChatId), userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// The new answer is an alternative of everything after the last prompt.
+	var prompt *models.GPTMessage
+	for _, msg := range dbGPTChat.BranchMessages() {
+		if msg.From == apimodels.LLMRoleUser {
+			prompt = &msg
+		}
+	}
+	if prompt == nil {
+		return nil, errs.NewGRPCError(codes.FailedPrecondition, errors.New("the chat has no prompt to answer again"))
+	}
+
+	reply := branchReply{prompt.ID, nil, prompt.Content, req.GroupId, req.GetProvider(), req.GetModel(), req.Tools}
+	answer, err := svc.answerOnNewBranch(ctx, dbGPTChat, userID, reply)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.RegenerateGPTReplyResponse{
+		Chat:         svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat),
+		GptMessage:   answer.dbAnswer.Content,
+		Context:      answer.contextInfo,
+		ToolMessages: svc.toolMessagesInfo(answer.toolMessages),
+		Cached:       answer.cached,
+		Messages:     svc.branchMessagesInfo(dbGPTChat),
+	}, nil
+}
+
+// EditGPTMessage sends a new version of the user's message as an alternative of it, and answers it.
+// Only its sender can do it, and only while they can reply to the chat.
+func (svc *GPTSvc) EditGPTMessage(ctx context.Context, req *pbs.EditGPTMessageRequest) (*pbs.EditGPTMessageResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Message, err = svc.moderatePrompt(ctx, userID, req.Message); err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, err := svc.getChatForUser(ctx, int(req.ChatId), userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(dbGPTChat.Messages, func(msg models.GPTMessage) bool { return msg.ID == int(req.MessageId) })
+	if i < 0 || dbGPTChat.Messages[i].From != apimodels.LLMRoleUser {
+		return nil, errs.GRPCNotFound("GPT Message", int(req.MessageId))
+	}
+	edited := dbGPTChat.Messages[i]
+	if edited.SenderID == nil || *edited.SenderID != userID {
+		return nil, errs.GRPCPermissionDenied("only its sender can edit a message")
+	}
+	parentID := dbGPTChat.ParentOf(edited.ID)
+	if parentID == 0 {
+		return nil, errs.GRPCInvalidArgument("the chat's first message can't be edited")
+	}
+
+	dbPrompt := &models.GPTMessage{Title: edited.Title, From: apimodels.LLMRoleUser, Content: req.Message, SenderID: &userID}
+	reply := branchReply{parentID, dbPrompt, req.Message, req.GroupId, req.GetProvider(), req.GetModel(), req.Tools}
+	answer, err := svc.answerOnNewBranch(ctx, dbGPTChat, userID, reply)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.EditGPTMessageResponse{
+		Chat:         svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat),
+		GptMessage:   answer.dbAnswer.Content,
+		Context:      answer.contextInfo,
+		ToolMessages: svc.toolMessagesInfo(answer.toolMessages),
+		Cached:       answer.cached,
+		Messages:     svc.branchMessagesInfo(dbGPTChat),
+	}, nil
+}
+
+// SwitchGPTChatBranch makes the chat's branch the newest one with the message on it.
+// Anyone who can reply to the chat can do it, it changes the chat for everyone.
+func (svc *GPTSvc) SwitchGPTChatBranch(ctx context.Context, req *pbs.SwitchGPTChatBranchRequest) (*pbs.SwitchGPTChatBranchResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, err := svc.getChatForUser(ctx, int(req.ChatId), userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID := int(req.MessageId)
+	if len(dbGPTChat.Alternatives(messageID)) == 0 {
+		return nil, errs.GRPCNotFound("GPT Message", messageID)
+	}
+
+	headID := dbGPTChat.LatestLeaf(messageID)
+	if err := svc.Clients.GPTChatRepository().SetChatHead(ctx, dbGPTChat.ID, headID); err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	dbGPTChat.HeadMessageID = &headID
+
+	return &pbs.SwitchGPTChatBranchResponse{
+		Chat:     svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat),
+		Messages: svc.branchMessagesInfo(dbGPTChat),
+	}, nil
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+// A prompt to answer on a new branch, after parentID. The prompt is stored as a new message if dbPrompt is set,
+// otherwise it's the parent and only the new answer is stored.
+type branchReply struct {
+	parentID int
+	dbPrompt *models.GPTMessage
+	prompt   string
+
+	// Same as on ReplyToGPTChat.
+	groupID         *int32
+	provider, model string
+	tools           []string
+}
+
+type branchAnswer struct {
+	dbAnswer     *models.GPTMessage
+	toolMessages []*models.GPTMessage
+	contextInfo  *pbs.GPTContextInfo
+	cached       bool
+}
+
+// Answers like ReplyToGPTChat, but with the history up to the parent. The new messages are added to the chat,
+// which is left with its new branch.
+func (svc *GPTSvc) answerOnNewBranch(ctx context.Context, chat *models.GPTChat, userID int, reply branchReply) (*branchAnswer, error) {
+	chargedGroupID := reply.groupID
+	if chargedGroupID == nil && chat.IsSharedWithGroup() {
+		groupID := int32(*chat.GroupID)
+		chargedGroupID = &groupID
+	}
+
+	budgets, err := svc.checkBudgets(ctx, chargedGroupID, userID, models.BudgetTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	llmReq := newLLMChatRequest(chat, reply.provider, reply.model, reply.prompt)
+	if llmReq.Tools, err = getGPTTools(reply.tools); err != nil {
+		return nil, err
+	}
+
+	// The history is the branch up to the prompt, which is sent apart. The head isn't saved by UpdateChat,
+	// so this doesn't reach the database even if the history is summarized.
+	historyEnd := reply.parentID
+	if reply.dbPrompt == nil {
+		historyEnd = chat.ParentOf(reply.parentID)
+	}
+	chat.HeadMessageID = &historyEnd
+	contextInfo, err := svc.fitChatHistory(ctx, chat, &llmReq, llmCharge{userID, chargedGroupID, budgets})
+	if err != nil {
+		return nil, err
+	}
+
+	callStart := time.Now()
+	gptResponse, toolMessages, err := svc.sendWithTools(ctx, llmReq)
+	if err != nil {
+		return nil, errs.GRPCFromLLM(err)
+	}
+	gptResponse.Content = svc.moderateAnswer(ctx, userID, gptResponse.Content)
+	usage := newChatUsage(models.LLMChat, gptResponse, callStart)
+	svc.consumeBudgets(ctx, budgets, int64(gptResponse.Usage.InTotal))
+
+	dbAnswer := newAnswerMessage(splitTitle(gptResponse.Content))
+	var dbMessages []*models.GPTMessage
+	if reply.dbPrompt != nil {
+		dbMessages = append(dbMessages, reply.dbPrompt)
+	}
+	dbMessages = append(dbMessages, toolMessages...)
+	dbMessages = append(dbMessages, dbAnswer)
+
+	// The rest follow the first one, as it becomes the chat's head.
+	dbMessages[0].ParentID = &reply.parentID
+	if err := svc.createMessages(ctx, chat.ID, dbMessages); err != nil {
+		return nil, err
+	}
+
+	svc.recordUsage(ctx, usage, userID, chargedGroupID, dbAnswer)
+
+	for _, msg := range dbMessages {
+		chat.Messages = append(chat.Messages, *msg)
+	}
+	chat.HeadMessageID = &dbAnswer.ID
+
+	return &branchAnswer{dbAnswer, toolMessages, contextInfo, gptResponse.Cached}, nil
+}
+
+// The chat's branch, with the alternatives of each message so they can be switched to.
+func (svc *GPTSvc) branchMessagesInfo(chat *models.GPTChat) []*pbs.GPTMessageInfo {
+	branch := chat.BranchMessages()
+	messagesInfo := svc.Tools.GPTMessagesToGPTMessagesInfoPB(branch)
+	for i, msgInfo := range messagesInfo {
+		msgInfo.ParentId = int32(chat.ParentOf(branch[i].ID))
+		for _, id := range chat.Alternatives(branch[i].ID) {
+			msgInfo.AlternativeIds = append(msgInfo.AlternativeIds, int32(id))
+		}
+	}
+	return messagesInfo
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"google.golang.org/grpc/codes"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - Context Window -         */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Who pays for the summaries made while fitting a chat, same as for the call itself.
+type llmCharge struct {
+	userID  int
+	groupID *int32
+	budgets []*models.UsageBudget
+}
+
+const (
+	summaryMaxTokens    = 1024
+	summaryInstructions = "You summarize conversations between a user and an assistant. " +
+		"Keep the facts, names, numbers, decisions and open questions, in the conversation's language. " +
+		"Answer only with the summary, in at most a few paragraphs."
+)
+
+// Adds to the request as much of the chat's history as fits in its model's context window, leaving room for the answer.
+// The system prompt and the new prompt are always sent, and the most recent messages go first.
+//
+// The messages that don't fit are summarized along with the previous summary. The new summary is stored as a message
+// and sent from then on with the system prompt, in place of all messages up to the ones it covers.
+// If summarizing fails, those messages are just not sent this time.
+//
+// Requests without a model get their provider's default here, as it's the one whose window we have to fit.
+func (svc *GPTSvc) fitChatHistory(ctx context.Context, chat *models.GPTChat, llmReq *apimodels.LLMChatRequest, charge llmCharge) (*pbs.GPTContextInfo, error) {
+	if llmReq.Model == "" {
+		llmReq.Model = svc.Clients.DefaultLLMModel(llmReq.Provider)
+	}
+	modelInfo := apimodels.GetLLMModelInfo(llmReq.Model)
+	inputTokens := modelInfo.InputTokens(llmReq.MaxTokens)
+	prompt := llmReq.Messages[len(llmReq.Messages)-1]
+
+	// Only the messages on the chat's branch are sent. The summary covers a branch too, so it's only
+	// used if the last message it covers is on this one.
+	branch := chat.BranchMessages()
+	summary, summaryUpToID := "", 0
+	if slices.ContainsFunc(branch, func(msg models.GPTMessage) bool { return msg.ID == chat.SummaryUpToID }) {
+		summary, summaryUpToID = latestSummary(chat), chat.SummaryUpToID
+	}
+
+	var history []models.GPTMessage
+	for _, msg := range branch {
+		if msg.From != apimodels.LLMRoleSystem && msg.ID > summaryUpToID {
+			history = append(history, msg)
+		}
+	}
+
+	contextInfo := &pbs.GPTContextInfo{Model: string(llmReq.Model), ContextTokens: int32(modelInfo.ContextTokens)}
+
+	kept, promptTokens, err := svc.fitRecentMessages(llmReq.Model, inputTokens, withSummary(llmReq.System, summary), prompt, history)
+	if err != nil {
+		return nil, err
+	}
+
+	if dropped := history[:len(history)-len(kept)]; len(dropped) > 0 {
+		contextInfo.Truncated = true
+		contextInfo.DroppedMessages = int32(len(dropped))
+
+		if newSummary, err := svc.summarizeMessages(ctx, chat, llmReq, summary, dropped, charge); err != nil {
+			logs.LogUnexpected(err)
+		} else {
+			summary, history = newSummary, history[len(dropped):]
+			contextInfo.Summarized = true
+
+			// The summary takes some room too, so fewer messages may fit now.
+			kept, promptTokens, err = svc.fitRecentMessages(llmReq.Model, inputTokens, withSummary(llmReq.System, summary), prompt, history)
+			if err != nil {
+				return nil, err
+			}
+			contextInfo.DroppedMessages += int32(len(history) - len(kept))
+		}
+	}
+
+	llmReq.System = withSummary(llmReq.System, summary)
+	llmReq.Messages = append(toLLMChatMsgs(kept), prompt)
+	contextInfo.PromptTokens = int32(promptTokens)
+
+	return contextInfo, nil
+}
+
+// Returns the newest messages that fit in the input tokens along with the system prompt and the new one,
+// and how many tokens all of that takes. It fails if not even the system prompt and the new one fit.
+func (svc *GPTSvc) fitRecentMessages(model apimodels.GPTs, inputTokens int, system string, prompt apimodels.GPTChatMsg, history []models.GPTMessage) ([]models.GPTMessage, int, error) {
+	usedTokens := svc.Tools.CountChatTokens(model, apimodels.GPTChatMsg{Role: apimodels.LLMRoleSystem, Content: system}, prompt)
+	if usedTokens > inputTokens {
+		err := fmt.Errorf("the prompt takes about %d tokens and the model only accepts %d", usedTokens, inputTokens)
+		return nil, 0, errs.NewGRPCError(codes.InvalidArgument, err, "prompt too long")
+	}
+
+	firstKept := len(history)
+	for firstKept > 0 {
+		msg := history[firstKept-1]
+		msgTokens := svc.Tools.CountChatTokens(model, apimodels.GPTChatMsg{Role: msg.From, Content: msg.Content})
+		if usedTokens+msgTokens > inputTokens {
+			break
+		}
+		usedTokens += msgTokens
+		firstKept--
+	}
+
+	return history[firstKept:], usedTokens, nil
+}
+
+// Asks the chat's model for a summary of the dropped messages that includes the previous summary, and stores it.
+// The call is charged and recorded like any other. If the dropped messages are too long to be summarized at once,
+// only the most recent of them are.
+func (svc *GPTSvc) summarizeMessages(ctx context.Context, chat *models.GPTChat, llmReq *apimodels.LLMChatRequest, summary string, dropped []models.GPTMessage, charge llmCharge) (string, error) {
+	maxTokens := summaryMaxTokens
+	summaryReq := apimodels.LLMChatRequest{
+		Provider:  llmReq.Provider,
+		Model:     llmReq.Model,
+		System:    summaryInstructions,
+		MaxTokens: &maxTokens,
+	}
+
+	inputTokens := apimodels.GetLLMModelInfo(llmReq.Model).InputTokens(&maxTokens)
+	transcript := make([]string, 0, len(dropped)+1)
+	if summary != "" {
+		transcript = append(transcript, "Summary of the earlier conversation: "+summary)
+	}
+	for _, msg := range dropped {
+		transcript = append(transcript, msg.From+": "+msg.Content)
+	}
+	for len(transcript) > 1 && svc.Tools.CountTokens(llmReq.Model, strings.Join(transcript, "\n\n")) > inputTokens {
+		transcript = transcript[1:]
+	}
+	summaryReq.Messages = []apimodels.GPTChatMsg{{Role: apimodels.LLMRoleUser, Content: strings.Join(transcript, "\n\n")}}
+
+	callStart := time.Now()
+	result, err := svc.Clients.SendRequestToGPT(ctx, summaryReq)
+	if err != nil {
+		return "", err
+	}
+	usage := newChatUsage(models.LLMSummary, result, callStart)
+	svc.consumeBudgets(ctx, charge.budgets, int64(result.Usage.InTotal))
+
+	dbSummary := &models.GPTMessage{Title: "Summary", From: models.GPTMessageSummary, Content: result.Content, ChatID: chat.ID}
+	if dbSummary, err = svc.Clients.GPTChatRepository().CreateMessage(ctx, dbSummary); err != nil {
+		return "", err
+	}
+	svc.recordUsage(ctx, usage, charge.userID, charge.groupID, dbSummary)
+
+	chat.SummaryUpToID = dropped[len(dropped)-1].ID
+	if err := svc.Clients.GPTChatRepository().UpdateChat(ctx, chat); err != nil {
+		return "", err
+	}
+
+	return result.Content, nil
+}
+
+func latestSummary(chat *models.GPTChat) string {
+	for i := len(chat.Messages) - 1; i >= 0; i-- {
+		if chat.Messages[i].From == models.GPTMessageSummary {
+			return chat.Messages[i].Content
+		}
+	}
+	return ""
+}
+
+func withSummary(system, summary string) string {
+	if summary == "" {
+		return system
+	}
+	return strings.TrimSpace(system + "\n\nSummary of the earlier conversation: " + summary)
+}
+
+// Tool calls go on the assistant message right before them, or on a new one. Tool results whose call
+// isn't being sent are skipped, as providers reject them.
+func toLLMChatMsgs(msgs []models.GPTMessage) []apimodels.GPTChatMsg {
+	llmMsgs := make([]apimodels.GPTChatMsg, 0, len(msgs))
+	for _, msg := range msgs {
+		switch msg.From {
+		case models.GPTMessageToolCall:
+			toolCall := apimodels.GPTToolCall{
+				ID: msg.ToolCallID, Type: "function",
+				Function: apimodels.GPTToolCallFunction{Name: msg.ToolName, Arguments: msg.Content},
+			}
+			if last := len(llmMsgs) - 1; last >= 0 && llmMsgs[last].Role == apimodels.LLMRoleAssistant {
+				llmMsgs[last].ToolCalls = append(llmMsgs[last].ToolCalls, toolCall)
+				continue
+			}
+			llmMsgs = append(llmMsgs, apimodels.GPTChatMsg{Role: apimodels.LLMRoleAssistant, ToolCalls: []apimodels.GPTToolCall{toolCall}})
+
+		case models.GPTMessageToolResult:
+			if !hasToolCall(llmMsgs, msg.ToolCallID) {
+				continue
+			}
+			llmMsgs = append(llmMsgs, apimodels.GPTChatMsg{Role: apimodels.LLMRoleTool, Content: msg.Content, ToolCallID: msg.ToolCallID})
+
+		default:
+			llmMsgs = append(llmMsgs, apimodels.GPTChatMsg{Role: msg.From, Content: msg.Content})
+		}
+	}
+	return llmMsgs
+}
+
+func hasToolCall(llmMsgs []apimodels.GPTChatMsg, toolCallID string) bool {
+	for _, llmMsg := range llmMsgs {
+		for _, toolCall := range llmMsg.ToolCalls {
+			if toolCall.ID == toolCallID {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"google.golang.org/grpc/codes"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*            - Documents -            */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+const (
+	defaultRetrievalTopK  = 4
+	retrievalInstructions = "Use these excerpts from the user's documents to answer when they're relevant, " +
+		"citing the ones you use by their number, like [1]. If they don't have the answer, say so instead of guessing."
+)
+
+func (svc *GPTSvc) CreateDocumentCollection(ctx context.Context, req *pbs.CreateDocumentCollectionRequest) (*pbs.CreateDocumentCollectionResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := &models.DocumentCollection{OwnerID: userID, Name: strings.TrimSpace(req.Name), Description: req.Description}
+	if err := svc.Clients.DocumentRepository().CreateDocumentCollection(ctx, collection); err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return &pbs.CreateDocumentCollectionResponse{Collection: svc.Tools.DocumentCollectionToDocumentCollectionInfoPB(collection)}, nil
+}
+
+func (svc *GPTSvc) ListDocumentCollections(ctx context.Context, req *pbs.ListDocumentCollectionsRequest) (*pbs.ListDocumentCollectionsResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	collections, err := svc.Clients.DocumentRepository().GetDocumentCollectionsByOwnerID(ctx, userID)
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return &pbs.ListDocumentCollectionsResponse{Collections: svc.Tools.DocumentCollectionsToDocumentCollectionsInfoPB(collections)}, nil
+}
+
+// The vectors are deleted after the rows, if that fails the file is left behind but nothing points to it.
+func (svc *GPTSvc) DeleteDocumentCollection(ctx context.Context, req *pbs.DeleteDocumentCollectionRequest) (*pbs.DeleteDocumentCollectionResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := svc.getOwnCollection(ctx, int(req.CollectionId), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.Clients.DocumentRepository().DeleteDocumentCollection(ctx, collection.ID); err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	if err := svc.Tools.DeleteVectorIndex(collection.ID); err != nil {
+		logs.LogUnexpected(err)
+	}
+
+	return &pbs.DeleteDocumentCollectionResponse{CollectionId: req.CollectionId}, nil
+}
+
+// UploadDocument reads the document's text, cuts it into chunks and embeds them all on the same call.
+// A document is only kept if its vectors could be saved too.
+func (svc *GPTSvc) UploadDocument(ctx context.Context, req *pbs.UploadDocumentRequest) (*pbs.UploadDocumentResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := svc.getOwnCollection(ctx, int(req.CollectionId), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := svc.Tools.ExtractText(req.Name, req.ContentType, req.Content)
+	if err != nil {
+		return nil, errs.GRPCInvalidArgument("can't read the document: " + err.Error())
+	}
+	chunks := svc.Tools.ChunkPages(text.Pages)
+
+	contents := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		contents[i] = chunk.Content
+	}
+
+	callStart := time.Now()
+	embeddings, err := svc.Clients.SendToEmbeddings(ctx, contents)
+	if err != nil {
+		return nil, errs.GRPCFromLLM(err)
+	}
+	if len(embeddings.Vectors) != len(chunks) {
+		return nil, errs.NewGRPCError(codes.Internal, fmt.Errorf("got %d embeddings for %d chunks", len(embeddings.Vectors), len(chunks)))
+	}
+
+	hash := sha256.Sum256(req.Content)
+	document := &models.Document{
+		CollectionID: collection.ID,
+		OwnerID:      userID,
+		Name:         req.Name,
+		ContentType:  text.ContentType,
+		SizeBytes:    int64(len(req.Content)),
+		SHA256:       hex.EncodeToString(hash[:]),
+		Pages:        text.Pages[len(text.Pages)-1].Number,
+		Chunks:       len(chunks),
+	}
+	dbChunks := make([]*models.DocumentChunk, len(chunks))
+	for i, chunk := range chunks {
+		dbChunks[i] = &models.DocumentChunk{Index: chunk.Index, Page: chunk.Page, Content: chunk.Content}
+	}
+
+	if err := svc.Clients.DocumentRepository().CreateDocument(ctx, document, dbChunks); err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	entries := make([]apimodels.VectorEntry, len(dbChunks))
+	for i, chunk := range dbChunks {
+		entries[i] = apimodels.VectorEntry{ChunkID: chunk.ID, DocumentID: document.ID, Vector: embeddings.Vectors[i]}
+	}
+	if err := svc.Tools.AddVectors(collection.ID, entries); err != nil {
+		if dbErr := svc.Clients.DocumentRepository().DeleteDocument(ctx, document.ID); dbErr != nil {
+			logs.LogUnexpected(dbErr)
+		}
+		return nil, errs.NewGRPCError(codes.Internal, err)
+	}
+
+	// Not made on any chat, so it's not on any message either.
+	svc.recordUsage(ctx, newEmbeddingsUsage(embeddings, callStart), userID, nil, &models.GPTMessage{})
+
+	return &pbs.UploadDocumentResponse{Document: svc.Tools.DocumentToDocumentInfoPB(document)}, nil
+}
+
+func (svc *GPTSvc) ListDocuments(ctx context.Context, req *pbs.ListDocumentsRequest) (*pbs.ListDocumentsResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := svc.getOwnCollection(ctx, int(req.CollectionId), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := svc.Clients.DocumentRepository().GetDocumentsByCollectionID(ctx, collection.ID)
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return &pbs.ListDocumentsResponse{Documents: svc.Tools.DocumentsToDocumentsInfoPB(documents)}, nil
+}
+
+// The vectors go first, so searches never find chunks that are no longer there.
+func (svc *GPTSvc) DeleteDocument(ctx context.Context, req *pbs.DeleteDocumentRequest) (*pbs.DeleteDocumentResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := svc.Clients.DocumentRepository().GetDocument(ctx, int(req.DocumentId))
+	if err != nil {
+		if errs.IsDBNotFound(err) {
+			return nil, errs.GRPCNotFound("Document", int(req.DocumentId))
+		}
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	if document.OwnerID != userID {
+		return nil, errs.GRPCNotFound("Document", int(req.DocumentId))
+	}
+
+	if err := svc.Tools.RemoveDocumentVectors(document.CollectionID, document.ID); err != nil {
+		return nil, errs.NewGRPCError(codes.Internal, err)
+	}
+	if err := svc.Clients.DocumentRepository().DeleteDocument(ctx, document.ID); err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return &pbs.DeleteDocumentResponse{DocumentId: req.DocumentId}, nil
+}
+
+func (svc *GPTSvc) SearchDocuments(ctx context.Context, req *pbs.SearchDocumentsRequest) (*pbs.SearchDocumentsResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	results, usage, err := svc.searchDocuments(ctx, int(req.CollectionId), int(req.GetTopK()), req.Query, userID)
+	if err != nil {
+		return nil, err
+	}
+	svc.recordUsage(ctx, usage, userID, nil, &models.GPTMessage{})
+
+	return &pbs.SearchDocumentsResponse{Results: results}, nil
+}
+
+/* -~-~-~- Retrieval -~-~-~- */
+
+// Sends the most relevant excerpts of the collection along with the prompt, which is the request's last message.
+// Only the request changes, the message we store is still the user's.
+// Returns nothing if there's no retrieval, and the embedding's usage to record it with the answer.
+func (svc *GPTSvc) addDocumentExcerpts(ctx context.Context, llmReq *apimodels.LLMChatRequest, retrieval *pbs.DocumentRetrieval, userID int) ([]*pbs.DocumentCitation, *models.LLMUsage, error) {
+	if retrieval == nil {
+		return nil, nil, nil
+	}
+
+	prompt := &llmReq.Messages[len(llmReq.Messages)-1]
+	citations, usage, err := svc.searchDocuments(ctx, int(retrieval.CollectionId), int(retrieval.GetTopK()), prompt.Content, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	prompt.Content = withDocumentExcerpts(prompt.Content, citations)
+
+	return citations, usage, nil
+}
+
+// Charges and records the retrieval's embedding like the call it was made for.
+func (svc *GPTSvc) recordRetrievalUsage(ctx context.Context, usage *models.LLMUsage, charge llmCharge, answer *models.GPTMessage) {
+	if usage == nil {
+		return
+	}
+	svc.consumeBudgets(ctx, charge.budgets, int64(usage.TotalTokens))
+	svc.recordUsage(ctx, usage, charge.userID, charge.groupID, answer)
+}
+
+// Returns the topK chunks closest to the query, numbered from 1, closest first.
+func (svc *GPTSvc) searchDocuments(ctx context.Context, collectionID, topK int, query string, userID int) ([]*pbs.DocumentCitation, *models.LLMUsage, error) {
+	collection, err := svc.getOwnCollection(ctx, collectionID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if topK <= 0 {
+		topK = defaultRetrievalTopK
+	}
+
+	callStart := time.Now()
+	embeddings, err := svc.Clients.SendToEmbeddings(ctx, []string{query})
+	if err != nil {
+		return nil, nil, errs.GRPCFromLLM(err)
+	}
+	if len(embeddings.Vectors) != 1 {
+		return nil, nil, errs.NewGRPCError(codes.Internal, fmt.Errorf("got %d embeddings for 1 query", len(embeddings.Vectors)))
+	}
+	usage := newEmbeddingsUsage(embeddings, callStart)
+
+	matches, err := svc.Tools.SearchVectors(collection.ID, embeddings.Vectors[0], topK)
+	if err != nil {
+		return nil, nil, errs.NewGRPCError(codes.Internal, err)
+	}
+
+	chunkIDs := make([]int, len(matches))
+	for i, match := range matches {
+		chunkIDs[i] = match.ChunkID
+	}
+	chunks, err := svc.Clients.DocumentRepository().GetDocumentChunksByIDs(ctx, chunkIDs)
+	if err != nil {
+		return nil, nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	documents, err := svc.Clients.DocumentRepository().GetDocumentsByCollectionID(ctx, collection.ID)
+	if err != nil {
+		return nil, nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	chunksByID := make(map[int]*models.DocumentChunk, len(chunks))
+	for _, chunk := range chunks {
+		chunksByID[chunk.ID] = chunk
+	}
+	documentNames := make(map[int]string, len(documents))
+	for _, document := range documents {
+		documentNames[document.ID] = document.Name
+	}
+
+	citations := make([]*pbs.DocumentCitation, 0, len(matches))
+	for _, match := range matches {
+		chunk, ok := chunksByID[match.ChunkID]
+		if !ok {
+			continue
+		}
+		citations = append(citations, &pbs.DocumentCitation{
+			Number:       int32(len(citations) + 1),
+			DocumentId:   int32(chunk.DocumentID),
+			DocumentName: documentNames[chunk.DocumentID],
+			ChunkId:      int32(chunk.ID),
+			ChunkIndex:   int32(chunk.Index),
+			Page:         int32(chunk.Page),
+			Score:        match.Score,
+			Excerpt:      chunk.Content,
+		})
+	}
+
+	return citations, usage, nil
+}
+
+// Collections that aren't the user's are just not found.
+func (svc *GPTSvc) getOwnCollection(ctx context.Context, collectionID, userID int) (*models.DocumentCollection, error) {
+	collection, err := svc.Clients.DocumentRepository().GetDocumentCollection(ctx, collectionID)
+	if err != nil {
+		if errs.IsDBNotFound(err) {
+			return nil, errs.GRPCNotFound("Document Collection", collectionID)
+		}
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	if collection.OwnerID != userID {
+		return nil, errs.GRPCNotFound("Document Collection", collectionID)
+	}
+	return collection, nil
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+// Puts the excerpts before the message, each one numbered and with where it's from.
+func withDocumentExcerpts(message string, citations []*pbs.DocumentCitation) string {
+	if len(citations) == 0 {
+		return message
+	}
+
+	var b strings.Builder
+	b.WriteString(retrievalInstructions + "\n\n")
+	for _, citation := range citations {
+		fmt.Fprintf(&b, "[%d] From %q, page %d:\n%s\n\n", citation.Number, citation.DocumentName, citation.Page, citation.Excerpt)
+	}
+	b.WriteString("Message:\n" + message)
+	return b.String()
+}
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"mime"
+	"strings"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*           - Chat Exports -          */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+const (
+	exportMarkdown = "markdown"
+	exportJSON     = "json"
+	exportHTML     = "html"
+)
+
+var exportContentTypes = map[string]string{
+	exportMarkdown: "text/markdown; charset=utf-8",
+	exportJSON:     "application/json",
+	exportHTML:     "text/html; charset=utf-8",
+}
+
+var exportExtensions = map[string]string{
+	exportMarkdown: ".md",
+	exportJSON:     ".json",
+	exportHTML:     ".html",
+}
+
+// ExportGPTChat renders the chat and the messages on its branch as a file. Anyone who can see the chat can export it.
+// Over HTTP it comes as a download, named after the chat.
+func (svc *GPTSvc) ExportGPTChat(ctx context.Context, req *pbs.ExportGPTChatRequest) (*httpbody.HttpBody, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, err := svc.getChatForUser(ctx, int(req.ChatId), userID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	format := cmp.Or(req.Format, exportMarkdown)
+	content, err := svc.renderChatExport(dbGPTChat, format)
+	if err != nil {
+		return nil, errs.NewGRPCError(codes.Internal, err)
+	}
+
+	setDownloadFileName(ctx, chatExportFileName(dbGPTChat, format))
+	return &httpbody.HttpBody{ContentType: exportContentTypes[format], Data: content}, nil
+}
+
+// ExportMyGPTChats zips all of the caller's own chats, each one rendered like ExportGPTChat does.
+func (svc *GPTSvc) ExportMyGPTChats(ctx context.Context, req *pbs.ExportMyGPTChatsRequest) (*httpbody.HttpBody, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChats, err := svc.Clients.GPTChatRepository().GetFullChatsByOwnerID(ctx, userID)
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	format := cmp.Or(req.Format, exportMarkdown)
+
+	var zipped bytes.Buffer
+	zipWriter := zip.NewWriter(&zipped)
+	for _, dbGPTChat := range dbGPTChats {
+		content, err := svc.renderChatExport(dbGPTChat, format)
+		if err != nil {
+			return nil, errs.NewGRPCError(codes.Internal, err)
+		}
+
+		file, err := zipWriter.Create(chatExportFileName(dbGPTChat, format))
+		if err == nil {
+			_, err = file.Write(content)
+		}
+		if err != nil {
+			return nil, errs.NewGRPCError(codes.Internal, err)
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		return nil, errs.NewGRPCError(codes.Internal, err)
+	}
+
+	logs.LogEvent("GPT chats exported", "user_id", userID, "chats", len(dbGPTChats), "format", format)
+
+	setDownloadFileName(ctx, fmt.Sprintf("gpt-chats-%s.zip", time.Now().Format(time.DateOnly)))
+	return &httpbody.HttpBody{ContentType: "application/zip", Data: zipped.Bytes()}, nil
+}
+
+/* -~-~-~- Renderers -~-~-~- */
+
+func (svc *GPTSvc) renderChatExport(chat *models.GPTChat, format string) ([]byte, error) {
+	switch format {
+	case exportJSON:
+		return protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(&pbs.GetGPTChatResponse{
+			Chat:     svc.Tools.GPTChatToGPTChatInfoPB(chat),
+			Messages: svc.branchMessagesInfo(chat),
+		})
+	case exportHTML:
+		return svc.renderChatHTML(chat)
+	default:
+		return renderChatMarkdown(chat), nil
+	}
+}
+
+// Images link to GetGPTImage, so they need the caller's token to be seen.
+func renderChatMarkdown(chat *models.GPTChat) []byte {
+	var md strings.Builder
+
+	fmt.Fprintf(&md, "# %s\n\n", chat.Title)
+	for _, detail := range chatExportDetails(chat) {
+		fmt.Fprintf(&md, "- **%s:** %s\n", detail[0], detail[1])
+	}
+	if chat.SystemPrompt != "" {
+		fmt.Fprintf(&md, "\n> %s\n", strings.ReplaceAll(chat.SystemPrompt, "\n", "\n> "))
+	}
+
+	for _, msg := range chat.BranchMessages() {
+		fmt.Fprintf(&md, "\n---\n\n### %s\n\n_%s · %s_\n\n", msg.Title, chatExportAuthor(chat, &msg), msg.CreatedAt.Format(time.RFC3339))
+
+		if isToolMessage(&msg) {
+			fmt.Fprintf(&md, "```json\n%s\n```\n", msg.Content)
+		} else if msg.Content != "" {
+			fmt.Fprintf(&md, "%s\n", msg.Content)
+		}
+
+		for _, media := range msg.Media {
+			fmt.Fprintf(&md, "\n![Image %d](%s)\n", media.ID, media.URL())
+		}
+	}
+
+	return []byte(md.String())
+}
+
+// A single page with everything in it, the images as data URLs, so it can be opened without us.
+func (svc *GPTSvc) renderChatHTML(chat *models.GPTChat) ([]byte, error) {
+	page := chatHTMLPage{Title: chat.Title, Details: chatExportDetails(chat), SystemPrompt: chat.SystemPrompt}
+
+	for _, msg := range chat.BranchMessages() {
+		htmlMsg := chatHTMLMessage{
+			Title:     msg.Title,
+			Author:    chatExportAuthor(chat, &msg),
+			CreatedAt: msg.CreatedAt.Format(time.RFC3339),
+			Content:   msg.Content,
+			IsCode:    isToolMessage(&msg),
+			FromUser:  msg.From == apimodels.LLMRoleUser,
+		}
+		for _, media := range msg.Media {
+			htmlMsg.Images = append(htmlMsg.Images, svc.embedImage(&media))
+		}
+		page.Messages = append(page.Messages, htmlMsg)
+	}
+
+	var rendered bytes.Buffer
+	if err := chatHTMLTemplate.Execute(&rendered, page); err != nil {
+		return nil, err
+	}
+	return rendered.Bytes(), nil
+}
+
+// Images that aren't stored, or can't be read, are left as a link to GetGPTImage.
+func (svc *GPTSvc) embedImage(media *models.GPTMedia) chatHTMLImage {
+	image := chatHTMLImage{Src: template.URL(media.URL()), Alt: fmt.Sprintf("Image %d", media.ID)}
+	if media.Status != models.GPTMediaStored {
+		return image
+	}
+
+	content, err := svc.Tools.ReadMedia(media)
+	if err != nil {
+		logs.LogUnexpected(err)
+		return image
+	}
+
+	// Only our own content types get here, so they're safe to put on a data URL.
+	image.Src = template.URL("data:" + media.ContentType + ";base64," + base64.StdEncoding.EncodeToString(content))
+	return image
+}
+
+type chatHTMLPage struct {
+	Title        string
+	Details      [][2]string
+	SystemPrompt string
+	Messages     []chatHTMLMessage
+}
+
+type chatHTMLMessage struct {
+	Title     string
+	Author    string
+	CreatedAt string
+	Content   string
+	IsCode    bool
+	FromUser  bool
+	Images    []chatHTMLImage
+}
+
+type chatHTMLImage struct {
+	Src template.URL
+	Alt string
+}
+
+var chatHTMLTemplate = template.Must(template.New("chat").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+  body { font-family: system-ui, sans-serif; max-width: 820px; margin: 2rem auto; padding: 0 1rem; color: #1f2328; background: #fff; }
+  h1 { margin-bottom: .5rem; }
+  dl { display: grid; grid-template-columns: max-content auto; gap: .25rem 1rem; color: #59636e; }
+  dt { font-weight: 600; }
+  dd { margin: 0; }
+  blockquote { border-left: 4px solid #d1d9e0; margin: 1rem 0; padding: .5rem 1rem; color: #59636e; white-space: pre-wrap; }
+  article { border: 1px solid #d1d9e0; border-radius: 8px; padding: 1rem; margin: 1rem 0; }
+  article.user { background: #f6f8fa; }
+  header { display: flex; justify-content: space-between; gap: 1rem; font-size: .875rem; color: #59636e; margin-bottom: .5rem; }
+  header strong { color: #1f2328; }
+  .content { white-space: pre-wrap; overflow-wrap: anywhere; }
+  pre { background: #f6f8fa; padding: .75rem; border-radius: 6px; overflow-x: auto; }
+  img { display: block; max-width: 100%; margin-top: .75rem; border-radius: 6px; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<dl>{{range .Details}}<dt>{{index . 0}}</dt><dd>{{index . 1}}</dd>{{end}}</dl>
+{{if .SystemPrompt}}<blockquote>{{.SystemPrompt}}</blockquote>{{end}}
+{{range .Messages}}<article{{if .FromUser}} class="user"{{end}}>
+  <header><span><strong>{{.Title}}</strong> · {{.Author}}</span><time>{{.CreatedAt}}</time></header>
+  {{if .IsCode}}<pre>{{.Content}}</pre>{{else if .Content}}<div class="content">{{.Content}}</div>{{end}}
+  {{range .Images}}<img src="{{.Src}}" alt="{{.Alt}}">{{end}}
+</article>
+{{end}}</body>
+</html>
+`))
+
+/* -~-~-~- Helpers -~-~-~- */
+
+// Over HTTP this is the Content-Disposition header, see the Gateway's matchOutgoingHeader.
+// It fails when the Svc isn't called through GRPC, but then there's nothing to name anyway.
+func setDownloadFileName(ctx context.Context, fileName string) {
+	contentDisposition := mime.FormatMediaType("attachment", map[string]string{"filename": fileName})
+	_ = grpc.SetHeader(ctx, metadata.Pairs("content-disposition", contentDisposition))
+}
+
+// Like chat-12-a-furious-boxer.md
+func chatExportFileName(chat *models.GPTChat, format string) string {
+	name := fmt.Sprintf("chat-%d", chat.ID)
+	if slug := slugify(chat.Title, 60); slug != "" {
+		name += "-" + slug
+	}
+	return name + exportExtensions[format]
+}
+
+func chatExportDetails(chat *models.GPTChat) [][2]string {
+	details := [][2]string{{"Chat", fmt.Sprint(chat.ID)}}
+	if chat.Owner != nil {
+		details = append(details, [2]string{"Owner", chat.Owner.Username})
+	}
+	if chat.Model != "" {
+		details = append(details, [2]string{"Model", strings.Trim(chat.Provider+" / "+chat.Model, " /")})
+	}
+	return append(details,
+		[2]string{"Created", chat.CreatedAt.Format(time.RFC3339)},
+		[2]string{"Exported", time.Now().Format(time.RFC3339)},
+	)
+}
+
+func chatExportAuthor(chat *models.GPTChat, msg *models.GPTMessage) string {
+	switch {
+	case msg.Sender != nil:
+		return msg.Sender.Username
+	case msg.From == apimodels.LLMRoleAssistant:
+		return cmp.Or(chat.Model, msg.From)
+	case msg.ToolName != "":
+		return msg.From + " · " + msg.ToolName
+	default:
+		return msg.From
+	}
+}
+
+func isToolMessage(msg *models.GPTMessage) bool {
+	return msg.From == models.GPTMessageToolCall || msg.From == models.GPTMessageToolResult
+}
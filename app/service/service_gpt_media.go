@@ -0,0 +1,341 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"strings"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*           - Stored Media -          */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+var (
+	// Tried before answering, so the image is usually stored by the time the client asks for it.
+	mediaRetryDelays = []time.Duration{0, time.Second, 2 * time.Second}
+
+	// If those fail, we keep trying in the background. DALL-E's URLs last about two hours.
+	mediaBackgroundRetryDelays = []time.Duration{30 * time.Second, 2 * time.Minute, 10 * time.Minute, 30 * time.Minute}
+)
+
+// GetGPTImage returns a stored image as it is, with its content type.
+// The HTTP Gateway sends it as the response's body instead of JSON.
+func (svc *GPTSvc) GetGPTImage(ctx context.Context, req *pbs.GetGPTImageRequest) (*httpbody.HttpBody, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	dbMedia, err := svc.getStoredMediaForUser(ctx, int(req.MediaId), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := svc.Tools.ReadMedia(dbMedia)
+	if err != nil {
+		return nil, errs.NewGRPCError(codes.Internal, err)
+	}
+
+	return &httpbody.HttpBody{ContentType: dbMedia.ContentType, Data: content}, nil
+}
+
+// EditGPTImage edits an image with DALL-E following the prompt, and adds the result to a chat.
+// See getImageChat for which one.
+func (svc *GPTSvc) EditGPTImage(ctx context.Context, req *pbs.EditGPTImageRequest) (*pbs.NewGPTImageResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Prompt, err = svc.moderatePrompt(ctx, userID, req.Prompt); err != nil {
+		return nil, err
+	}
+
+	img, dbSource, err := svc.loadImageSource(ctx, req.Image, userID)
+	if err != nil {
+		return nil, err
+	}
+	var mask image.Image
+	if req.Mask != nil {
+		if mask, _, err = svc.loadImageSource(ctx, req.Mask, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	dbGPTChat, chargedGroupID, err := svc.getImageChat(ctx, req.ChatId, req.GroupId, dbSource, userID, req.Prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := svc.checkBudgets(ctx, chargedGroupID, userID, models.BudgetImages)
+	if err != nil {
+		return nil, err
+	}
+
+	callStart := time.Now()
+	dallEResponse, err := svc.Clients.SendEditToDallE(ctx, img, mask, req.Prompt, req.Size)
+	if err != nil {
+		return nil, errs.GRPCFromLLM(err)
+	}
+	usage := newImageUsage(models.LLMImageEdit, dallEResponse, callStart)
+	svc.consumeBudgets(ctx, budgets, 1)
+
+	dbPrompt := &models.GPTMessage{Title: "Edit prompt", From: "user", Content: req.Prompt, SenderID: &userID}
+	if err := svc.attachImageSource(dbPrompt, img, dbSource); err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, imageInfo, err := svc.addImageToChat(ctx, dbGPTChat, []*models.GPTMessage{dbPrompt}, dallEResponse, usage, userID, chargedGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.NewGPTImageResponse{ImageUrl: imageInfo.Url, Image: imageInfo, Chat: svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat)}, nil
+}
+
+// NewGPTImageVariation makes a variation of an image with DALL-E, and adds it to a chat like EditGPTImage.
+func (svc *GPTSvc) NewGPTImageVariation(ctx context.Context, req *pbs.NewGPTImageVariationRequest) (*pbs.NewGPTImageResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	img, dbSource, err := svc.loadImageSource(ctx, req.Image, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, chargedGroupID, err := svc.getImageChat(ctx, req.ChatId, req.GroupId, dbSource, userID, "Image variation")
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := svc.checkBudgets(ctx, chargedGroupID, userID, models.BudgetImages)
+	if err != nil {
+		return nil, err
+	}
+
+	callStart := time.Now()
+	dallEResponse, err := svc.Clients.SendVariationToDallE(ctx, img, req.Size)
+	if err != nil {
+		return nil, errs.GRPCFromLLM(err)
+	}
+	usage := newImageUsage(models.LLMImageVariation, dallEResponse, callStart)
+	svc.consumeBudgets(ctx, budgets, 1)
+
+	dbPrompt := &models.GPTMessage{Title: "Variation request", From: "user", Content: "Make a variation of this image", SenderID: &userID}
+	if err := svc.attachImageSource(dbPrompt, img, dbSource); err != nil {
+		return nil, err
+	}
+
+	dbGPTChat, imageInfo, err := svc.addImageToChat(ctx, dbGPTChat, []*models.GPTMessage{dbPrompt}, dallEResponse, usage, userID, chargedGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.NewGPTImageResponse{ImageUrl: imageInfo.Url, Image: imageInfo, Chat: svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat)}, nil
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+// Stores the prompt messages and DALL-E's answer in the chat, creating the chat first if it's new.
+// The image is stored with the answer, which then points to our URL for it instead of DALL-E's.
+func (svc *GPTSvc) addImageToChat(ctx context.Context, dbGPTChat *models.GPTChat, dbPrompts []*models.GPTMessage, dallEResponse apimodels.GPTImageMsg, usage *models.LLMUsage, userID int, groupID *int32) (*models.GPTChat, *pbs.GPTMediaInfo, error) {
+
+	// example URL: https://oaidalleapiprodscus.blob.core.windows.net/private/org-QSC7lV...
+	generatedImageURL := dallEResponse.URL
+	if len(strings.Trim(generatedImageURL, " ")) == 0 {
+		return nil, nil, fmt.Errorf("error: empty image URL returned from DALL-E")
+	}
+
+	var err error
+	if dbGPTChat.ID == 0 {
+		if dbGPTChat, err = svc.Clients.GPTChatRepository().CreateChat(ctx, dbGPTChat); err != nil {
+			return nil, nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+		}
+	}
+
+	dbImage := models.GPTMedia{ChatID: dbGPTChat.ID, Status: models.GPTMediaPending, SourceURL: generatedImageURL}
+	dbAnswer := &models.GPTMessage{Title: "DALL-E response", From: "assistant", Media: []models.GPTMedia{dbImage}}
+
+	for _, msg := range append(dbPrompts, dbAnswer) {
+		msg.ChatID = dbGPTChat.ID
+		for i := range msg.Media {
+			msg.Media[i].ChatID = dbGPTChat.ID
+		}
+		if _, err := svc.Clients.GPTChatRepository().CreateMessage(ctx, msg); err != nil {
+			return nil, nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+		}
+	}
+
+	dbAnswer.Content = dbAnswer.Media[0].URL()
+	if err := svc.Clients.GPTChatRepository().UpdateMessage(ctx, dbAnswer); err != nil {
+		return nil, nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	svc.recordUsage(ctx, usage, userID, groupID, dbAnswer)
+
+	return dbGPTChat, svc.storeImage(ctx, dbAnswer.Media[0]), nil
+}
+
+// Edits and variations go to the requested chat, or else to the source image's one. For both the user must be
+// able to reply to it. Uploads without a chat get a new one, not stored yet.
+// Like with replies, chats shared with a group are charged to it unless another group is requested.
+func (svc *GPTSvc) getImageChat(ctx context.Context, chatID, groupID *int32, dbSource *models.GPTMedia, userID int, title string) (*models.GPTChat, *int32, error) {
+	var dbGPTChat *models.GPTChat
+	var err error
+
+	switch {
+	case chatID != nil:
+		dbGPTChat, err = svc.getChatForUser(ctx, int(*chatID), userID, true)
+	case dbSource != nil:
+		dbGPTChat, err = svc.getChatForUser(ctx, dbSource.ChatID, userID, true)
+	default:
+		return &models.GPTChat{Title: title, OwnerID: userID, Provider: string(apimodels.LLMOpenAI), Model: string(apimodels.DALL_E2)}, groupID, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if groupID == nil && dbGPTChat.IsSharedWithGroup() {
+		chatGroupID := int32(*dbGPTChat.GroupID)
+		groupID = &chatGroupID
+	}
+	return dbGPTChat, groupID, nil
+}
+
+// Returns the image and, if it was one we had stored, its media.
+func (svc *GPTSvc) loadImageSource(ctx context.Context, source *pbs.GPTImageSource, userID int) (image.Image, *models.GPTMedia, error) {
+	var img image.Image
+	var dbMedia *models.GPTMedia
+	var err error
+
+	switch source.Source.(type) {
+	case *pbs.GPTImageSource_MediaId:
+		if dbMedia, err = svc.getStoredMediaForUser(ctx, int(source.GetMediaId()), userID); err != nil {
+			return nil, nil, err
+		}
+		content, err := svc.Tools.ReadMedia(dbMedia)
+		if err != nil {
+			return nil, nil, errs.NewGRPCError(codes.Internal, err)
+		}
+		img, err = svc.Tools.LoadImgFromBytes(content)
+	case *pbs.GPTImageSource_Upload:
+		img, err = svc.Tools.LoadImgFromBytes(source.GetUpload())
+	case *pbs.GPTImageSource_Base64:
+		img, err = svc.Tools.LoadImgFromBase64(source.GetBase64())
+	}
+	if err != nil {
+		return nil, nil, errs.NewGRPCError(codes.InvalidArgument, err, "invalid image")
+	}
+
+	return img, dbMedia, nil
+}
+
+// The prompt message shows which image it was about. Uploaded ones are stored with it.
+func (svc *GPTSvc) attachImageSource(dbPrompt *models.GPTMessage, img image.Image, dbSource *models.GPTMedia) error {
+	if dbSource != nil {
+		dbPrompt.Content += "\n" + dbSource.URL()
+		return nil
+	}
+
+	var content bytes.Buffer
+	if err := png.Encode(&content, img); err != nil {
+		return errs.NewGRPCError(codes.Internal, err)
+	}
+
+	dbUpload := models.GPTMedia{Status: models.GPTMediaStored}
+	if err := svc.Tools.StoreMedia(&dbUpload, content.Bytes()); err != nil {
+		return errs.NewGRPCError(codes.Internal, err)
+	}
+	dbPrompt.Media = append(dbPrompt.Media, dbUpload)
+	return nil
+}
+
+// Media from chats the user can't see doesn't exist for them. It's only returned if it's stored.
+func (svc *GPTSvc) getStoredMediaForUser(ctx context.Context, mediaID, userID int) (*models.GPTMedia, error) {
+	dbMedia, err := svc.Clients.GPTChatRepository().GetMediaByID(ctx, mediaID)
+	if err != nil {
+		if errs.IsDBNotFound(err) {
+			return nil, errs.GRPCNotFound("GPT Image", mediaID)
+		}
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	if _, err := svc.getChatForUser(ctx, dbMedia.ChatID, userID, false); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, errs.GRPCNotFound("GPT Image", mediaID)
+		}
+		return nil, err
+	}
+
+	switch dbMedia.Status {
+	case models.GPTMediaPending:
+		return nil, errs.NewGRPCError(codes.Unavailable, errors.New("the image is still being downloaded, try again later"))
+	case models.GPTMediaFailed:
+		return nil, errs.GRPCFailedPrecondition("the image couldn't be downloaded from its provider")
+	}
+
+	return dbMedia, nil
+}
+
+// Downloads and stores the image, retrying a few times. If it still isn't stored,
+// it's left pending and retried in the background until we give up on it.
+// Returns the image's info as it's after the first tries.
+func (svc *GPTSvc) storeImage(ctx context.Context, dbMedia models.GPTMedia) *pbs.GPTMediaInfo {
+	if !svc.storeMediaWithRetries(ctx, &dbMedia, mediaRetryDelays, false) {
+		go svc.storeMediaWithRetries(context.WithoutCancel(ctx), &dbMedia, mediaBackgroundRetryDelays, true)
+		return svc.Tools.GPTMediaToGPTMediaInfoPB(&models.GPTMedia{ID: dbMedia.ID, Status: models.GPTMediaPending})
+	}
+	return svc.Tools.GPTMediaToGPTMediaInfoPB(&dbMedia)
+}
+
+// Tries to store the media once after each delay, saving how each attempt went.
+// If giveUp is set and every attempt fails, it's marked as failed.
+func (svc *GPTSvc) storeMediaWithRetries(ctx context.Context, dbMedia *models.GPTMedia, delays []time.Duration, giveUp bool) bool {
+	for i, delay := range delays {
+		time.Sleep(delay)
+
+		dbMedia.Attempts++
+		if err := svc.downloadAndStoreMedia(dbMedia); err != nil {
+			logs.WarnIfErr(fmt.Errorf("media %d on attempt %d: %w", dbMedia.ID, dbMedia.Attempts, err), "error storing media: %v")
+			dbMedia.LastError = err.Error()
+			if giveUp && i == len(delays)-1 {
+				dbMedia.Status = models.GPTMediaFailed
+			}
+		} else {
+			dbMedia.Status, dbMedia.LastError = models.GPTMediaStored, ""
+		}
+
+		if err := svc.Clients.GPTChatRepository().UpdateMedia(ctx, dbMedia); err != nil {
+			logs.LogUnexpected(fmt.Errorf("error saving media %d: %w", dbMedia.ID, err))
+		}
+		if dbMedia.Status == models.GPTMediaStored {
+			return true
+		}
+	}
+	return false
+}
+
+func (svc *GPTSvc) downloadAndStoreMedia(dbMedia *models.GPTMedia) error {
+	content, err := svc.Tools.DownloadFile(dbMedia.SourceURL, nil)
+	if err != nil {
+		return err
+	}
+	return svc.Tools.StoreMedia(dbMedia, content)
+}
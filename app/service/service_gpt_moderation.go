@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*            - Moderation -           */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Blocked answers are replaced by this, with their categories.
+const withheldAnswer = "This answer was withheld by our content filter (%s)."
+
+// Returns the prompt to send and store, with its redactions. Blocked prompts fail with an InvalidArgument
+// that says which categories blocked them, so nothing is sent or stored.
+func (svc *GPTSvc) moderatePrompt(ctx context.Context, userID int, prompt string) (string, error) {
+	result := svc.moderate(ctx, models.ModerationPrompt, userID, prompt)
+	if result.Action == apimodels.ModerationBlock {
+		return "", errContentBlocked(result)
+	}
+	return result.Text, nil
+}
+
+// Returns the answer to store and return, with its redactions. The call was already made and paid for,
+// so blocked answers are replaced by a notice instead of failing the request.
+func (svc *GPTSvc) moderateAnswer(ctx context.Context, userID int, answer string) string {
+	result := svc.moderate(ctx, models.ModerationAnswer, userID, answer)
+	if result.Action == apimodels.ModerationBlock {
+		return fmt.Sprintf(withheldAnswer, strings.Join(result.Categories(), ", "))
+	}
+	return result.Text
+}
+
+// Runs the text through our own rules and, if enabled and they didn't block it already, through the provider's
+// moderation endpoint. If the endpoint fails, we go on with our rules' decision.
+//
+// Every decision is logged, and the ones that block or redact something are also stored for review.
+func (svc *GPTSvc) moderate(ctx context.Context, stage models.ModerationStage, userID int, text string) apimodels.ModerationResult {
+	result, source := svc.Tools.ModerateText(text), "local"
+
+	if result.Action != apimodels.ModerationBlock && svc.Tools.ModeratesWithProvider() {
+		providerResult, err := svc.Clients.SendToModeration(ctx, result.Text)
+		if err != nil {
+			logs.LogUnexpected(err)
+		} else if providerResult.Action == apimodels.ModerationBlock {
+			providerResult.Matches = append(result.Matches, providerResult.Matches...)
+			result, source = providerResult, "provider"
+		}
+	}
+
+	route := core.GetRouteFromCtx(ctx).Name
+	categories := result.Categories()
+	logs.LogEvent("Moderation decision", "route", route, "stage", stage, "user_id", userID,
+		"action", result.Action, "source", source, "categories", categories)
+
+	if result.Action == apimodels.ModerationAllow {
+		return result
+	}
+
+	var rules []string
+	for _, match := range result.Matches {
+		if match.Action == result.Action && !slices.Contains(rules, match.Rule) {
+			rules = append(rules, match.Rule)
+		}
+	}
+
+	event := &models.ModerationEvent{
+		UserID:     userID,
+		Route:      route,
+		Stage:      stage,
+		Action:     string(result.Action),
+		Source:     source,
+		Categories: strings.Join(categories, ","),
+		Rules:      strings.Join(rules, ","),
+	}
+	if err := svc.Clients.ModerationRepository().CreateModerationEvent(ctx, event); err != nil {
+		logs.LogUnexpected(err)
+	}
+
+	return result
+}
+
+// Runs streamed answers through our own rules before they reach the client, a sentence or a line at a time,
+// so rules that match within one apply to the deltas too. Once one blocks, nothing else is sent.
+// The whole answer is still moderated before storing it, and that's the one the last event has.
+type moderatedDeltas struct {
+	send     func(delta string) error
+	moderate func(text string) apimodels.ModerationResult
+	held     string
+	blocked  bool
+}
+
+// Sentences longer than this aren't waited for, they're moderated and sent as they are.
+const maxHeldSentence = 2000
+
+func (md *moderatedDeltas) write(delta string) error {
+	if md.blocked {
+		return nil
+	}
+	md.held += delta
+
+	end := 0
+	for _, sep := range []string{"\n", ". ", "! ", "? "} {
+		if i := strings.LastIndex(md.held, sep); i >= 0 {
+			end = max(end, i+len(sep))
+		}
+	}
+	if end == 0 {
+		if len(md.held) < maxHeldSentence {
+			return nil
+		}
+		end = len(md.held)
+	}
+
+	text := md.held[:end]
+	md.held = md.held[end:]
+	return md.sendModerated(text)
+}
+
+// Sends what's still held back, the end of the answer.
+func (md *moderatedDeltas) flush() error {
+	if md.blocked || md.held == "" {
+		return nil
+	}
+	text := md.held
+	md.held = ""
+	return md.sendModerated(text)
+}
+
+func (md *moderatedDeltas) sendModerated(text string) error {
+	result := md.moderate(text)
+	if result.Action == apimodels.ModerationBlock {
+		md.blocked = true
+		return nil
+	}
+	return md.send(result.Text)
+}
+
+// ListModerationEvents returns the stored moderation decisions, newest first.
+func (svc *GPTSvc) ListModerationEvents(ctx context.Context, req *pbs.ListModerationEventsRequest) (*pbs.ListModerationEventsResponse, error) {
+	page, pageSize := svc.Tools.PaginatedRequest(req)
+
+	filter := core.ModerationEventFilter{UserID: int(req.GetUserId()), Action: req.GetAction(), Stage: req.GetStage()}
+	dbEvents, totalMatches, err := svc.Clients.ModerationRepository().GetModerationEvents(ctx, filter, page, pageSize)
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return &pbs.ListModerationEventsResponse{
+		Events:     svc.Tools.ModerationEventsToModerationEventsInfoPB(dbEvents),
+		Pagination: svc.Tools.PaginatedResponse(page, pageSize, totalMatches),
+	}, nil
+}
+
+// The ErrorInfo has the categories in a machine readable way.
+func errContentBlocked(result apimodels.ModerationResult) error {
+	categories := result.Categories()
+	errorInfo := &errdetails.ErrorInfo{
+		Reason:   "CONTENT_BLOCKED",
+		Domain:   core.G.AppName,
+		Metadata: map[string]string{"categories": strings.Join(categories, ",")},
+	}
+	return errs.GRPCInvalidArgument("prompt blocked by moderation: "+strings.Join(categories, ", "), errorInfo)
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"google.golang.org/grpc/status"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - Async Images -           */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+const (
+	defaultOperationWait  = 30 * time.Second
+	operationPollInterval = 500 * time.Millisecond
+	maxImageJobRetryDelay = 10 * time.Minute
+)
+
+var errImageJobCancelled = errors.New("the operation was cancelled while its image was being made")
+
+// Creates the chat with the prompts right away and queues the image, which the workers add to the chat when it's done.
+// The budgets are checked now so the request fails early, and again before the image is made.
+func (svc *GPTSvc) newAsyncGPTImage(ctx context.Context, req *pbs.NewGPTImageRequest, userID int) (*pbs.NewGPTImageResponse, error) {
+	if _, err := svc.checkBudgets(ctx, req.GroupId, userID, models.BudgetImages); err != nil {
+		return nil, err
+	}
+
+	dbGPTChat := &models.GPTChat{Title: req.Message, OwnerID: userID, Provider: string(apimodels.LLMOpenAI), Model: string(apimodels.DALL_E3)}
+	dbGPTChat, err := svc.Clients.GPTChatRepository().CreateChat(ctx, dbGPTChat)
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	if err := svc.createMessages(ctx, dbGPTChat.ID, newImagePrompts(req.Message, userID)); err != nil {
+		return nil, err
+	}
+
+	job := &models.ImageJob{
+		OperationID:   svc.Tools.GenerateID(),
+		UserID:        userID,
+		GroupID:       int32PtrToIntPtr(req.GroupId),
+		ChatID:        dbGPTChat.ID,
+		Prompt:        req.Message,
+		Size:          int32(req.Size),
+		Status:        models.ImageJobQueued,
+		NextAttemptAt: time.Now(),
+	}
+	if err := svc.Clients.ImageJobRepository().CreateImageJob(ctx, job); err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	return &pbs.NewGPTImageResponse{
+		Chat:      svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat),
+		Operation: svc.Tools.ImageJobToGPTOperationPB(job),
+	}, nil
+}
+
+// GetGPTOperation returns an async image's operation. Other users' operations don't exist for the caller.
+func (svc *GPTSvc) GetGPTOperation(ctx context.Context, req *pbs.GetGPTOperationRequest) (*pbs.GPTOperation, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := svc.getImageJobForUser(ctx, req.OperationId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.imageJobToOperation(ctx, job)
+}
+
+// WaitGPTOperation returns the operation once it's done, or as it is when the timeout passes.
+func (svc *GPTSvc) WaitGPTOperation(ctx context.Context, req *pbs.WaitGPTOperationRequest) (*pbs.GPTOperation, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := defaultOperationWait
+	if req.TimeoutSeconds > 0 {
+		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+	}
+	deadline := time.Now().Add(timeout)
+
+	for {
+		job, err := svc.getImageJobForUser(ctx, req.OperationId, userID)
+		if err != nil {
+			return nil, err
+		}
+		if job.IsDone() || time.Now().After(deadline) {
+			return svc.imageJobToOperation(ctx, job)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-time.After(min(operationPollInterval, time.Until(deadline))):
+		}
+	}
+}
+
+// CancelGPTOperation stops an operation that isn't done yet. Cancelling a done one changes nothing.
+func (svc *GPTSvc) CancelGPTOperation(ctx context.Context, req *pbs.CancelGPTOperationRequest) (*pbs.GPTOperation, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := svc.getImageJobForUser(ctx, req.OperationId, userID); err != nil {
+		return nil, err
+	}
+
+	cancelled, err := svc.Clients.ImageJobRepository().CancelImageJob(ctx, req.OperationId)
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	if cancelled {
+		logs.LogEvent("Image job cancelled", "operation_id", req.OperationId, "user_id", userID)
+	}
+
+	return svc.GetGPTOperation(ctx, &pbs.GetGPTOperationRequest{OperationId: req.OperationId})
+}
+
+/* -~-~-~- Workers -~-~-~- */
+
+// ClaimImageJob returns the next queued image job that's due, already marked as running.
+// Returns nil if there's none.
+func (svc *GPTSvc) ClaimImageJob(ctx context.Context) (*models.ImageJob, error) {
+	return svc.Clients.ImageJobRepository().ClaimImageJob(ctx)
+}
+
+// RequeueImageJobs queues again the jobs that were left running when the app stopped.
+func (svc *GPTSvc) RequeueImageJobs(ctx context.Context) error {
+	requeued, err := svc.Clients.ImageJobRepository().RequeueRunningImageJobs(ctx)
+	if requeued > 0 {
+		logs.LogEvent("Image jobs requeued", "count", requeued)
+	}
+	return err
+}
+
+// RunImageJob makes a claimed job's image and adds it to the job's chat.
+// If DALL-E fails in a way that could work later and the job has attempts left, it's queued again,
+// waiting twice as long after each attempt.
+func (svc *GPTSvc) RunImageJob(ctx context.Context, job *models.ImageJob, cfg *core.ImageJobsCfg) {
+	mediaID, retryable, err := svc.makeJobImage(ctx, job)
+
+	switch {
+	case err == nil:
+		job.Status, job.MediaID, job.LastError = models.ImageJobSucceeded, &mediaID, ""
+	case errors.Is(err, errImageJobCancelled):
+		logs.LogEvent("Image job discarded", "operation_id", job.OperationID)
+		return
+	case retryable && job.Attempts < cfg.MaxAttempts:
+		job.Status, job.LastError = models.ImageJobQueued, err.Error()
+		job.NextAttemptAt = time.Now().Add(min(cfg.RetryDelay<<(job.Attempts-1), maxImageJobRetryDelay))
+	default:
+		grpcStatus := status.Convert(err)
+		job.Status, job.ErrorCode, job.LastError = models.ImageJobFailed, int32(grpcStatus.Code()), grpcStatus.Message()
+	}
+
+	if job.IsDone() {
+		finishedAt := time.Now()
+		job.FinishedAt = &finishedAt
+	}
+
+	saved, dbErr := svc.Clients.ImageJobRepository().UpdateRunningImageJob(ctx, job)
+	if dbErr != nil {
+		logs.LogUnexpected(dbErr)
+		return
+	}
+
+	logs.LogEvent("Image job run", "operation_id", job.OperationID, "status", job.Status,
+		"attempt", job.Attempts, "saved", saved, "error", job.LastError)
+}
+
+// Returns the image's media ID or, if it failed, whether the job should be retried.
+func (svc *GPTSvc) makeJobImage(ctx context.Context, job *models.ImageJob) (int, bool, error) {
+	groupID := intPtrToInt32Ptr(job.GroupID)
+	budgets, err := svc.checkBudgets(ctx, groupID, job.UserID, models.BudgetImages)
+	if err != nil {
+		return 0, false, err
+	}
+
+	callStart := time.Now()
+	dallEResponse, err := svc.Clients.SendRequestToDallE(ctx, job.Prompt, pbs.GPTImageSize(job.Size))
+	if err != nil {
+		return 0, errs.IsRetryableLLMErr(err), errs.GRPCFromLLM(err)
+	}
+	usage := newImageUsage(models.LLMImage, dallEResponse, callStart)
+
+	// It may have been cancelled while DALL-E was making it. We still paid for it, but it isn't charged to the group.
+	if current, err := svc.Clients.ImageJobRepository().GetImageJob(ctx, job.OperationID); err == nil && current.Status == models.ImageJobCancelled {
+		return 0, false, errImageJobCancelled
+	}
+	svc.consumeBudgets(ctx, budgets, 1)
+
+	dbGPTChat, err := svc.Clients.GPTChatRepository().GetChatByID(ctx, job.ChatID)
+	if err != nil {
+		return 0, false, errs.GRPCFromDB(err, "RunImageJob")
+	}
+
+	_, imageInfo, err := svc.addImageToChat(ctx, dbGPTChat, nil, dallEResponse, usage, job.UserID, groupID)
+	if err != nil {
+		return 0, false, err
+	}
+	return int(imageInfo.Id), false, nil
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+func (svc *GPTSvc) getImageJobForUser(ctx context.Context, operationID string, userID int) (*models.ImageJob, error) {
+	job, err := svc.Clients.ImageJobRepository().GetImageJob(ctx, operationID)
+	if err != nil {
+		if errs.IsDBNotFound(err) {
+			return nil, errs.GRPCNotFound("GPT Operation", operationID)
+		}
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	if job.UserID != userID {
+		return nil, errs.GRPCNotFound("GPT Operation", operationID)
+	}
+	return job, nil
+}
+
+// Succeeded operations have their chat and image as the response.
+func (svc *GPTSvc) imageJobToOperation(ctx context.Context, job *models.ImageJob) (*pbs.GPTOperation, error) {
+	operation := svc.Tools.ImageJobToGPTOperationPB(job)
+	if job.Status != models.ImageJobSucceeded || job.MediaID == nil {
+		return operation, nil
+	}
+
+	dbGPTChat, err := svc.Clients.GPTChatRepository().GetChatByID(ctx, job.ChatID)
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+	dbMedia, err := svc.Clients.GPTChatRepository().GetMediaByID(ctx, *job.MediaID)
+	if err != nil {
+		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+	}
+
+	imageInfo := svc.Tools.GPTMediaToGPTMediaInfoPB(dbMedia)
+	operation.Result = &pbs.GPTOperation_Response{Response: &pbs.NewGPTImageResponse{
+		Chat:     svc.Tools.GPTChatToGPTChatInfoPB(dbGPTChat),
+		ImageUrl: imageInfo.Url,
+		Image:    imageInfo,
+	}}
+	return operation, nil
+}
+
+func newImagePrompts(prompt string, userID int) []*models.GPTMessage {
+	return []*models.GPTMessage{
+		{Title: "Instructions", From: "user", Content: "You are a highly accurate image generator AI..."},
+		{Title: "User prompt", From: "user", Content: prompt, SenderID: &userID},
+	}
+}
+
+func int32PtrToIntPtr(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	converted := int(*value)
+	return &converted
+}
+
+func intPtrToInt32Ptr(value *int) *int32 {
+	if value == nil {
+		return nil
+	}
+	converted := int32(*value)
+	return &converted
+}
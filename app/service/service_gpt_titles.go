@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*              - Titles -             */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Our default instructions ask GPT to start its answers with "Title:" and a short title. It's taken out of the answer
+// and kept as the message's title, and the first answer's is the chat's. Answers without one, like on chats started
+// with other system prompts, are just a "GPT response", and their chats get a title GPT writes just for that.
+
+const (
+	defaultAnswerTitle = "GPT response"
+	maxTitleLength     = 100 // In characters.
+
+	titleMaxTokens    = 32
+	titlePromptLength = 2000 // In characters, more than enough to tell what the chat is about.
+	titleInstructions = "You write titles for conversations between a user and an assistant. " +
+		"Answer only with a title of at most 6 words for the one starting with the next message, in its language, without quotes."
+)
+
+// What can go around a title, like on "**Title:** Something" or "# Title: Something".
+const titleMarkdown = "#*_ \t"
+
+// Returns the answer's title and the answer without it, if it starts with one.
+// Answers with nothing after their title are left as they are.
+func splitTitle(answer string) (title, content string) {
+	firstLine, rest, _ := strings.Cut(strings.TrimLeftFunc(answer, unicode.IsSpace), "\n")
+	title, ok := cutTitlePrefix(firstLine)
+	if !ok {
+		return "", answer
+	}
+	if content = strings.TrimLeftFunc(rest, unicode.IsSpace); content == "" {
+		return "", answer
+	}
+	return truncateTitle(strings.Trim(title, titleMarkdown+"\r")), content
+}
+
+// Returns what comes after "Title:" on the line, if it starts with it.
+func cutTitlePrefix(line string) (string, bool) {
+	const prefix = "title:"
+	line = strings.TrimLeft(line, titleMarkdown)
+	if len(line) < len(prefix) || !strings.EqualFold(line[:len(prefix)], prefix) {
+		return "", false
+	}
+	return line[len(prefix):], true
+}
+
+func newAnswerMessage(title, content string) *models.GPTMessage {
+	if title == "" {
+		title = defaultAnswerTitle
+	}
+	return &models.GPTMessage{Title: title, From: apimodels.LLMRoleAssistant, Content: content}
+}
+
+// Returns the title for a new chat: the one on its first answer, or one GPT writes for its prompt.
+// Writing it is charged like the answer, and its usage is returned to be recorded along with it.
+// If it can't be written, it's the start of the prompt.
+func (svc *GPTSvc) chatTitle(ctx context.Context, answerTitle, prompt string, answered apimodels.GPTChatResult, charge llmCharge) (string, *models.LLMUsage) {
+	if answerTitle != "" {
+		return answerTitle, nil
+	}
+
+	maxTokens := titleMaxTokens
+	titleReq := apimodels.LLMChatRequest{
+		Provider:  answered.Provider,
+		Model:     answered.Model,
+		System:    titleInstructions,
+		MaxTokens: &maxTokens,
+		Messages:  []apimodels.GPTChatMsg{{Role: apimodels.LLMRoleUser, Content: truncateRunes(prompt, titlePromptLength)}},
+	}
+
+	callStart := time.Now()
+	result, err := svc.Clients.SendRequestToGPT(ctx, titleReq)
+	if err != nil {
+		logs.LogUnexpected(err)
+		return promptTitle(prompt), nil
+	}
+	svc.consumeBudgets(ctx, charge.budgets, int64(result.Usage.InTotal))
+	usage := newChatUsage(models.LLMTitle, result, callStart)
+
+	// It may still come with the "Title:" we ask for on chats, or in quotes.
+	title, _, _ := strings.Cut(strings.TrimSpace(result.Content), "\n")
+	if afterPrefix, ok := cutTitlePrefix(title); ok {
+		title = afterPrefix
+	}
+	if title = truncateTitle(strings.Trim(title, titleMarkdown+`"'`)); title == "" {
+		title = promptTitle(prompt)
+	}
+	return title, usage
+}
+
+// The prompt on a single line, short enough to be a title. Chats start with it until they get a better one.
+func promptTitle(prompt string) string {
+	return truncateTitle(strings.Join(strings.Fields(prompt), " "))
+}
+
+// Long titles are cut on a space, if there's one near the end.
+func truncateTitle(title string) string {
+	title = strings.TrimSpace(title)
+	if len([]rune(title)) <= maxTitleLength {
+		return title
+	}
+	title = truncateRunes(title, maxTitleLength-1)
+	if i := strings.LastIndex(title, " "); i > len(title)*3/4 {
+		title = title[:i]
+	}
+	return strings.TrimSpace(title) + "…"
+}
+
+func truncateRunes(s string, maxRunes int) string {
+	if runes := []rune(s); len(runes) > maxRunes {
+		return string(runes[:maxRunes])
+	}
+	return s
+}
+
+/* -~-~-~- Streaming -~-~-~- */
+
+// Holds the start of a streamed answer back until it's known whether it starts with a title, and leaves the title out.
+// It's like splitTitle, but on the deltas.
+type titleStripper struct {
+	send     func(delta string) error
+	held     string
+	decided  bool
+	trimming bool // After a title, until the answer itself starts.
+}
+
+// Titles longer than this aren't waited for, the answer is sent as it comes.
+const maxHeldTitle = 500
+
+func (ts *titleStripper) write(delta string) error {
+	if !ts.decided {
+		ts.held += delta
+		start := strings.TrimLeftFunc(ts.held, unicode.IsSpace)
+		firstLine, rest, lineDone := strings.Cut(start, "\n")
+		if !lineDone && mayStartWithTitle(firstLine) && len(ts.held) < maxHeldTitle {
+			return nil
+		}
+
+		ts.decided = true
+		delta = ts.held
+		if _, ok := cutTitlePrefix(firstLine); ok && lineDone {
+			delta, ts.trimming = rest, true
+		}
+	}
+
+	if ts.trimming {
+		if delta = strings.TrimLeftFunc(delta, unicode.IsSpace); delta == "" {
+			return nil
+		}
+		ts.trimming = false
+	}
+	return ts.send(delta)
+}
+
+// Sends what's still held back, for answers that end before their first line does.
+func (ts *titleStripper) flush() error {
+	if ts.decided || ts.held == "" {
+		return nil
+	}
+	ts.decided = true
+	return ts.send(ts.held)
+}
+
+// Whether the line starts with "Title:", or could once more of it comes.
+func mayStartWithTitle(line string) bool {
+	line = strings.ToLower(strings.TrimLeft(line, titleMarkdown))
+	return strings.HasPrefix(line, "title:") || strings.HasPrefix("title:", line)
+}
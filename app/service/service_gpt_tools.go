@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*             - GPT Tools -           */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// A function of ours that GPT can ask us to run. It gets the arguments GPT wrote, which follow
+// the Parameters schema if GPT behaved, and runs with the caller's ctx, so it can only do what they can.
+// What it returns is sent back to GPT as JSON.
+type gptTool struct {
+	apimodels.LLMTool
+	run func(ctx context.Context, svc *GPTSvc, args json.RawMessage) (any, error)
+}
+
+var gptTools = []gptTool{
+	{
+		LLMTool: apimodels.LLMTool{
+			Name:        "get_weather",
+			Description: "Gets the current weather at the given coordinates. Temperatures are in Kelvin.",
+			Parameters: json.RawMessage(`{"type": "object", "properties": {
+				"lat": {"type": "number", "minimum": -90, "maximum": 90, "description": "Latitude"},
+				"lon": {"type": "number", "minimum": -180, "maximum": 180, "description": "Longitude"}
+			}, "required": ["lat", "lon"]}`),
+		},
+		run: getWeatherTool,
+	},
+	{
+		LLMTool: apimodels.LLMTool{
+			Name:        "get_my_groups",
+			Description: "Lists the groups the user talking to you is a member or the owner of.",
+			Parameters:  json.RawMessage(`{"type": "object", "properties": {}}`),
+		},
+		run: getMyGroupsTool,
+	},
+	{
+		LLMTool: apimodels.LLMTool{
+			Name:        "search_users",
+			Description: "Searches users by username, returning their IDs and usernames.",
+			Parameters: json.RawMessage(`{"type": "object", "properties": {
+				"query": {"type": "string", "minLength": 1, "description": "Part of the username"},
+				"limit": {"type": "integer", "minimum": 1, "maximum": 20, "description": "Defaults to 5"}
+			}, "required": ["query"]}`),
+		},
+		run: searchUsersTool,
+	},
+}
+
+// How many times GPT can ask for tools before having to answer without them.
+const maxToolRounds = 5
+
+// ListGPTTools returns the tools GPT can be offered.
+func (svc *GPTSvc) ListGPTTools(ctx context.Context, req *pbs.ListGPTToolsRequest) (*pbs.ListGPTToolsResponse, error) {
+	toolsInfo := make([]*pbs.GPTToolInfo, 0, len(gptTools))
+	for _, tool := range gptTools {
+		toolsInfo = append(toolsInfo, &pbs.GPTToolInfo{Name: tool.Name, Description: tool.Description, Parameters: string(tool.Parameters)})
+	}
+	return &pbs.ListGPTToolsResponse{Tools: toolsInfo}, nil
+}
+
+// Returns the tools with the requested names, or an InvalidArgument if we don't have one of them.
+func getGPTTools(names []string) ([]apimodels.LLMTool, error) {
+	tools := make([]apimodels.LLMTool, 0, len(names))
+	for _, name := range names {
+		tool, ok := getGPTTool(name)
+		if !ok {
+			return nil, errs.NewGRPCError(codes.InvalidArgument, fmt.Errorf("unknown tool %q", name))
+		}
+		tools = append(tools, tool.LLMTool)
+	}
+	return tools, nil
+}
+
+func getGPTTool(name string) (gptTool, bool) {
+	i := slices.IndexFunc(gptTools, func(tool gptTool) bool { return tool.Name == name })
+	if i == -1 {
+		return gptTool{}, false
+	}
+	return gptTools[i], true
+}
+
+// Sends the request, and while GPT asks for tools instead of answering, runs them and sends their results back.
+// Returns GPT's answer with the usage of all the calls, and the messages to store before it: the tool calls and
+// their results, in order. After maxToolRounds, GPT has to answer without tools.
+//
+// Failing tools don't fail the request, GPT gets their error as the result and deals with it.
+func (svc *GPTSvc) sendWithTools(ctx context.Context, llmReq apimodels.LLMChatRequest) (apimodels.GPTChatResult, []*models.GPTMessage, error) {
+	var usage apimodels.GPTChatUsage
+	var toolMessages []*models.GPTMessage
+
+	for round := 0; ; round++ {
+		if round == maxToolRounds {
+			llmReq.Tools = nil
+		}
+
+		result, err := svc.Clients.SendRequestToGPT(ctx, llmReq)
+		if err != nil {
+			return apimodels.GPTChatResult{}, nil, err
+		}
+		usage = usage.Add(result.Usage)
+
+		if len(result.ToolCalls) == 0 || len(llmReq.Tools) == 0 {
+			result.ToolCalls, result.Usage = nil, usage
+			return result, toolMessages, nil
+		}
+
+		if result.Content != "" {
+			toolMessages = append(toolMessages, &models.GPTMessage{Title: "GPT response", From: apimodels.LLMRoleAssistant, Content: result.Content})
+		}
+		llmReq.Messages = append(llmReq.Messages, apimodels.GPTChatMsg{Role: apimodels.LLMRoleAssistant, Content: result.Content, ToolCalls: result.ToolCalls})
+
+		for _, toolCall := range result.ToolCalls {
+			output := svc.runTool(ctx, llmReq.Tools, toolCall)
+			llmReq.Messages = append(llmReq.Messages, apimodels.GPTChatMsg{Role: apimodels.LLMRoleTool, Content: output, ToolCallID: toolCall.ID})
+
+			toolMessages = append(toolMessages,
+				&models.GPTMessage{Title: "Tool call", From: models.GPTMessageToolCall, Content: toolCall.Function.Arguments, ToolName: toolCall.Function.Name, ToolCallID: toolCall.ID},
+				&models.GPTMessage{Title: "Tool result", From: models.GPTMessageToolResult, Content: output, ToolName: toolCall.Function.Name, ToolCallID: toolCall.ID},
+			)
+		}
+	}
+}
+
+// Runs the tool and returns its result as JSON, or {"error": "..."} if it failed.
+// GPT can only run the tools it was offered.
+func (svc *GPTSvc) runTool(ctx context.Context, offered []apimodels.LLMTool, toolCall apimodels.GPTToolCall) string {
+	name := toolCall.Function.Name
+	args := json.RawMessage(strings.TrimSpace(toolCall.Function.Arguments))
+	if len(args) == 0 {
+		args = json.RawMessage("{}")
+	}
+
+	var result any
+	var err error
+
+	start := time.Now()
+	tool, ok := getGPTTool(name)
+	if !ok || !slices.ContainsFunc(offered, func(t apimodels.LLMTool) bool { return t.Name == name }) {
+		err = fmt.Errorf("unknown tool %s", name)
+	} else {
+		result, err = tool.run(ctx, svc, args)
+	}
+
+	if err == nil {
+		var output []byte
+		if output, err = json.Marshal(result); err == nil {
+			logs.LogEvent("GPT tool call", "tool", name, "duration", time.Since(start).String())
+			return string(output)
+		}
+	}
+
+	logs.LogEvent("GPT tool call failed", "tool", name, "error", err.Error())
+	output, _ := json.Marshal(map[string]string{"error": status.Convert(err).Message()})
+	return string(output)
+}
+
+/* -~-~-~- Tools -~-~-~- */
+
+func getWeatherTool(ctx context.Context, svc *GPTSvc, args json.RawMessage) (any, error) {
+	var params struct {
+		Lat *float64 `json:"lat"`
+		Lon *float64 `json:"lon"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if params.Lat == nil || params.Lon == nil {
+		return nil, errors.New("lat and lon are required")
+	}
+	if *params.Lat < -90 || *params.Lat > 90 || *params.Lon < -180 || *params.Lon > 180 {
+		return nil, errors.New("lat must be between -90 and 90, and lon between -180 and 180")
+	}
+
+	weather, err := svc.Clients.GetCurrentWeather(ctx, *params.Lat, *params.Lon)
+	if err != nil {
+		return nil, errors.New("the weather service is unavailable")
+	}
+	return weather, nil
+}
+
+func getMyGroupsTool(ctx context.Context, svc *GPTSvc, _ json.RawMessage) (any, error) {
+	userID, err := getUserIDFromCtx(ctx, svc.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := svc.Clients.GroupRepository().GetGroupsByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("failed to fetch groups")
+	}
+
+	type groupResult struct {
+		ID      int    `json:"id"`
+		Name    string `json:"name"`
+		IsOwner bool   `json:"is_owner"`
+	}
+	results := make([]groupResult, 0, len(groups))
+	for _, group := range groups {
+		results = append(results, groupResult{group.ID, group.Name, group.OwnerID == userID})
+	}
+	return results, nil
+}
+
+// Only usernames and IDs are returned, as anyone can see those.
+func searchUsersTool(ctx context.Context, svc *GPTSvc, args json.RawMessage) (any, error) {
+	var params struct {
+		Query string `json:"query"`
+		Limit int    `json:"limit"`
+	}
+	if err := json.Unmarshal(args, &params); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if params.Query = strings.TrimSpace(params.Query); params.Query == "" {
+		return nil, errors.New("query is required")
+	}
+	if params.Limit <= 0 {
+		params.Limit = 5
+	}
+	params.Limit = min(params.Limit, 20)
+
+	users, err := svc.Clients.UserRepository().SearchUsers(ctx, params.Query, params.Limit)
+	if err != nil {
+		return nil, errors.New("failed to search users")
+	}
+
+	type userResult struct {
+		ID       int    `json:"id"`
+		Username string `json:"username"`
+	}
+	results := make([]userResult, 0, len(users))
+	for _, user := range users {
+		results = append(results, userResult{user.ID, user.Username})
+	}
+	return results, nil
+}
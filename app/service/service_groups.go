@@ -1,11 +1,20 @@
 package service
 
 import (
+	"encoding/base64"
+	"strconv"
+	"time"
+
 	"github.com/gilperopiola/god"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GroupSvc struct {
@@ -18,32 +27,891 @@ type GroupSvc struct {
 /*          - Groups Service -         */
 /* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
 
+// CreateGroup creates a group owned by the authenticated user.
+// Users in InvitedUserIds don't become members, they get a pending invite.
 func (s *GroupSvc) CreateGroup(ctx god.Ctx, req *pbs.CreateGroupRequest) (*pbs.CreateGroupResponse, error) {
-	groupOwnerID, err := god.ToIntAndErr(s.Tools.GetFromCtx(ctx, "user_id"))
+	groupOwnerID, err := getUserIDFromCtx(ctx, s.Tools)
 	if err != nil {
-		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+		return nil, err
 	}
 
 	invitedUserIDs := utils.Int32Slice(req.InvitedUserIds).ToIntSlice()
 
-	// Updated to use GroupRepository instead of direct DB call
 	group, err := s.Clients.GroupRepository().CreateGroup(ctx, req.Name, groupOwnerID, invitedUserIDs)
 	if err != nil {
-		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+		return nil, errCallingGroupsDB(ctx, err)
 	}
 
 	return &pbs.CreateGroupResponse{Group: s.Tools.GroupToGroupInfoPB(group)}, nil
 }
 
+// GetGroup returns the group with its members and their roles.
+// Only members of the group can see it.
 func (s *GroupSvc) GetGroup(ctx god.Ctx, req *pbs.GetGroupRequest) (*pbs.GetGroupResponse, error) {
-	// Updated to use GroupRepository instead of direct DB call
-	group, err := s.Clients.GroupRepository().GetGroupByID(ctx, int(req.GroupId))
+	group, _, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.GetGroupResponse{Group: s.Tools.GroupToGroupInfoPB(group)}, nil
+}
+
+// RenameGroup changes the group's name. Only the owner and admins can do this.
+func (s *GroupSvc) RenameGroup(ctx god.Ctx, req *pbs.RenameGroupRequest) (*pbs.RenameGroupResponse, error) {
+	group, callerRole, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if !callerRole.Outranks(models.GroupMemberRole) {
+		return nil, errNotGroupOwnerOrAdmin()
+	}
+
+	callerID, _ := getUserIDFromCtx(ctx, s.Tools)
+	details := map[string]string{"old_name": group.Name, "new_name": req.Name}
+	activity := models.NewGroupActivity(group.ID, callerID, models.GroupRenamedActivity, 0, details)
+
+	group.Name = req.Name
+	err = s.changeGroupWithActivity(ctx, activity, func(txRepo core.GroupRepository) error {
+		return txRepo.UpdateGroup(ctx, group)
+	})
+	if err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return &pbs.RenameGroupResponse{Group: s.Tools.GroupToGroupInfoPB(group)}, nil
+}
+
+// DeleteGroup soft-deletes the group. Only the owner can do this.
+func (s *GroupSvc) DeleteGroup(ctx god.Ctx, req *pbs.DeleteGroupRequest) (*pbs.DeleteGroupResponse, error) {
+	group, callerRole, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if callerRole != models.GroupOwnerRole {
+		return nil, errNotGroupOwner()
+	}
+
+	group.Deleted = true
+	if err := s.Clients.GroupRepository().UpdateGroup(ctx, group); err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return &pbs.DeleteGroupResponse{GroupId: int32(group.ID)}, nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - Group Members -          */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// LeaveGroup removes the authenticated user from the group.
+// As a group always has exactly one owner, the owner must transfer the ownership before leaving.
+func (s *GroupSvc) LeaveGroup(ctx god.Ctx, req *pbs.LeaveGroupRequest) (*pbs.LeaveGroupResponse, error) {
+	group, callerRole, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if callerRole == models.GroupOwnerRole {
+		return nil, errs.GRPCFailedPrecondition("the owner can't leave the group, transfer the ownership first")
+	}
+
+	callerID, _ := getUserIDFromCtx(ctx, s.Tools)
+	activity := models.NewGroupActivity(group.ID, callerID, models.MemberLeftActivity, 0, nil)
+
+	err = s.changeGroupWithActivity(ctx, activity, func(txRepo core.GroupRepository) error {
+		return txRepo.RemoveGroupMember(ctx, group.ID, callerID)
+	})
+	if err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return &pbs.LeaveGroupResponse{GroupId: int32(group.ID)}, nil
+}
+
+// RemoveMember kicks a member out of the group.
+// The owner can remove anyone but themselves, admins can only remove plain members.
+func (s *GroupSvc) RemoveMember(ctx god.Ctx, req *pbs.RemoveMemberRequest) (*pbs.RemoveMemberResponse, error) {
+	group, callerRole, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.getMemberFromGroup(group, int(req.MemberId))
+	if err != nil {
+		return nil, err
+	}
+
+	callerID, _ := getUserIDFromCtx(ctx, s.Tools)
+	if member.UserID == callerID {
+		return nil, errs.GRPCFailedPrecondition("can't remove yourself, leave the group instead")
+	}
+
+	if callerRole == models.GroupMemberRole || !callerRole.Outranks(member.Role) {
+		return nil, errs.GRPCPermissionDenied("can't remove a member with role " + string(member.Role))
+	}
+
+	activity := models.NewGroupActivity(group.ID, callerID, models.MemberRemovedActivity, member.UserID, nil)
+	err = s.changeGroupWithActivity(ctx, activity, func(txRepo core.GroupRepository) error {
+		return txRepo.RemoveGroupMember(ctx, group.ID, member.UserID)
+	})
+	if err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return reloadGroupAs(s, ctx, group.ID, func(g *pbs.GroupInfo) *pbs.RemoveMemberResponse {
+		return &pbs.RemoveMemberResponse{Group: g}
+	})
+}
+
+// ChangeMemberRole switches a member between admin and member. Only the owner can do this,
+// ownership itself is only changed through TransferOwnership.
+func (s *GroupSvc) ChangeMemberRole(ctx god.Ctx, req *pbs.ChangeMemberRoleRequest) (*pbs.ChangeMemberRoleResponse, error) {
+	group, callerRole, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if callerRole != models.GroupOwnerRole {
+		return nil, errNotGroupOwner()
+	}
+
+	newRole := s.Tools.GroupRoleFromPB(req.Role)
+	if newRole != models.GroupAdminRole && newRole != models.GroupMemberRole {
+		return nil, errs.GRPCFailedPrecondition("role can only be changed to admin or member")
+	}
+
+	member, err := s.getMemberFromGroup(group, int(req.MemberId))
+	if err != nil {
+		return nil, err
+	}
+
+	if member.Role == models.GroupOwnerRole {
+		return nil, errs.GRPCFailedPrecondition("the owner's role can only change by transferring the ownership")
+	}
+
+	if member.Role != newRole {
+		callerID, _ := getUserIDFromCtx(ctx, s.Tools)
+		details := map[string]string{"old_role": string(member.Role), "new_role": string(newRole)}
+		activity := models.NewGroupActivity(group.ID, callerID, models.RoleChangedActivity, member.UserID, details)
+
+		member.Role = newRole
+		err = s.changeGroupWithActivity(ctx, activity, func(txRepo core.GroupRepository) error {
+			return txRepo.UpdateGroupMember(ctx, member)
+		})
+		if err != nil {
+			return nil, errCallingGroupsDB(ctx, err)
+		}
+	}
+
+	return reloadGroupAs(s, ctx, group.ID, func(g *pbs.GroupInfo) *pbs.ChangeMemberRoleResponse {
+		return &pbs.ChangeMemberRoleResponse{Group: g}
+	})
+}
+
+// TransferOwnership makes another member the owner of the group, and the old owner an admin.
+// Both memberships and the group's OwnerID change on the same transaction, so there's always exactly one owner.
+func (s *GroupSvc) TransferOwnership(ctx god.Ctx, req *pbs.TransferOwnershipRequest) (*pbs.TransferOwnershipResponse, error) {
+	group, callerRole, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if callerRole != models.GroupOwnerRole {
+		return nil, errNotGroupOwner()
+	}
+
+	newOwner, err := s.getMemberFromGroup(group, int(req.NewOwnerId))
+	if err != nil {
+		return nil, err
+	}
+
+	if newOwner.UserID == group.OwnerID {
+		return nil, errs.GRPCFailedPrecondition("user is already the owner")
+	}
+
+	activity := models.NewGroupActivity(group.ID, group.OwnerID, models.OwnershipTransferredActivity, newOwner.UserID, nil)
+	err = s.changeGroupWithActivity(ctx, activity, func(txRepo core.GroupRepository) error {
+		oldOwner, err := txRepo.GetGroupMember(ctx, group.ID, group.OwnerID)
+		if err != nil && !errs.IsDBNotFound(err) {
+			return err
+		}
+
+		if oldOwner == nil {
+			err = txRepo.AddGroupMember(ctx, group.ID, group.OwnerID, models.GroupAdminRole)
+		} else {
+			oldOwner.Role = models.GroupAdminRole
+			err = txRepo.UpdateGroupMember(ctx, oldOwner)
+		}
+		if err != nil {
+			return err
+		}
+
+		newOwner.Role = models.GroupOwnerRole
+		if err := txRepo.UpdateGroupMember(ctx, newOwner); err != nil {
+			return err
+		}
+
+		group.OwnerID = newOwner.UserID
+		return txRepo.UpdateGroup(ctx, group)
+	})
+	if err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return reloadGroupAs(s, ctx, group.ID, func(g *pbs.GroupInfo) *pbs.TransferOwnershipResponse {
+		return &pbs.TransferOwnershipResponse{Group: g}
+	})
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - Group Invites -          */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// InviteToGroup sends invites to the given users. Only the group's owner can invite,
+// and the owner is always taken from the token.
+//
+// Inviting a user who already has a pending invite returns that same invite, and
+// inviting a user who is already a member is a no-op. Old expired invites are marked
+// as such and replaced by new ones.
+func (s *GroupSvc) InviteToGroup(ctx god.Ctx, req *pbs.InviteToGroupRequest) (*pbs.InviteToGroupResponse, error) {
+	inviterID, err := getUserIDFromCtx(ctx, s.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.getGroup(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if group.OwnerID != inviterID {
+		return nil, errNotGroupOwner()
+	}
+
+	invitedUserIDs := utils.Int32Slice(req.InvitedUserIds).ToIntSlice()
+	for _, invitedUserID := range invitedUserIDs {
+		if _, err := s.Clients.UserRepository().GetUserByID(ctx, invitedUserID); err != nil {
+			if errs.IsDBNotFound(err) {
+				return nil, errUserNotFound(invitedUserID)
+			}
+			return nil, errCallingUsersDB(ctx, err)
+		}
+	}
+
+	invites := []*models.GroupInvite{}
+	err = s.Clients.GroupRepository().InTransaction(ctx, func(txRepo core.GroupRepository) error {
+		alreadyInvited := map[int]bool{}
+		for _, invitedUserID := range invitedUserIDs {
+			if invitedUserID == inviterID || alreadyInvited[invitedUserID] {
+				continue
+			}
+			alreadyInvited[invitedUserID] = true
+
+			isMember, err := txRepo.IsGroupMember(ctx, group.ID, invitedUserID)
+			if err != nil {
+				return err
+			}
+			if isMember {
+				continue
+			}
+
+			lastInvite, err := txRepo.GetLastGroupInvite(ctx, group.ID, invitedUserID)
+			if err != nil && !errs.IsDBNotFound(err) {
+				return err
+			}
+
+			if lastInvite != nil && lastInvite.IsPending() {
+				invites = append(invites, lastInvite)
+				continue
+			}
+
+			if err := s.markAsExpiredIfNeeded(ctx, txRepo, lastInvite); err != nil {
+				return err
+			}
+
+			newInvite := models.NewGroupInvite(group.ID, invitedUserID, inviterID)
+			if err := txRepo.CreateGroupInvites(ctx, []*models.GroupInvite{newInvite}); err != nil {
+				return err
+			}
+			invites = append(invites, newInvite)
+
+			activity := models.NewGroupActivity(group.ID, inviterID, models.MemberInvitedActivity, invitedUserID, nil)
+			if err := txRepo.CreateGroupActivity(ctx, activity); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return &pbs.InviteToGroupResponse{
+		Group:   s.Tools.GroupToGroupInfoPB(group),
+		Invites: s.Tools.GroupInvitesToGroupInvitesInfoPB(invites),
+	}, nil
+}
+
+// AnswerGroupInvite accepts or declines the user's last invite to the group.
+// Accepting adds the user as a member of the group.
+//
+// Answers are idempotent: answering the same thing twice returns the invite as it is.
+// Changing the answer, or answering a revoked or expired invite, is a FailedPrecondition.
+func (s *GroupSvc) AnswerGroupInvite(ctx god.Ctx, req *pbs.AnswerGroupInviteRequest) (*pbs.AnswerGroupInviteResponse, error) {
+	groupID, userID := int(req.GroupId), int(req.UserId)
+
+	group, err := s.getGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, err := s.Clients.GroupRepository().GetLastGroupInvite(ctx, groupID, userID)
 	if err != nil {
 		if errs.IsDBNotFound(err) {
-			return nil, errs.GRPCNotFound("group", int(req.GroupId))
+			return nil, errGroupInviteNotFound(groupID)
 		}
-		return nil, errs.GRPCFromDB(err, core.GetRouteFromCtx(ctx).Name)
+		return nil, errCallingGroupsDB(ctx, err)
 	}
 
-	return &pbs.GetGroupResponse{Group: s.Tools.GroupToGroupInfoPB(group)}, nil
+	answer := models.GroupInviteDeclined
+	if req.Accept {
+		answer = models.GroupInviteAccepted
+	}
+
+	response := func() *pbs.AnswerGroupInviteResponse {
+		return &pbs.AnswerGroupInviteResponse{
+			Group:  s.Tools.GroupToGroupInfoPB(group),
+			Invite: s.Tools.GroupInviteToGroupInviteInfoPB(invite),
+		}
+	}
+
+	if invite.Status == answer {
+		return response(), nil
+	}
+
+	if !invite.IsPending() {
+		if err := s.markAsExpiredIfNeeded(ctx, s.Clients.GroupRepository(), invite); err != nil {
+			return nil, errCallingGroupsDB(ctx, err)
+		}
+		return nil, errGroupInviteNotPending(invite.Status)
+	}
+
+	err = s.Clients.GroupRepository().InTransaction(ctx, func(txRepo core.GroupRepository) error {
+		now := time.Now()
+		invite.Status = answer
+		invite.AnsweredAt = &now
+		if err := txRepo.UpdateGroupInvite(ctx, invite); err != nil {
+			return err
+		}
+
+		if answer != models.GroupInviteAccepted {
+			return nil
+		}
+
+		isMember, err := txRepo.IsGroupMember(ctx, groupID, userID)
+		if err != nil || isMember {
+			return err
+		}
+		if err := txRepo.AddGroupMember(ctx, groupID, userID, models.GroupMemberRole); err != nil {
+			return err
+		}
+
+		details := map[string]string{"via": "invite", "inviter_id": strconv.Itoa(invite.InviterID)}
+		return txRepo.CreateGroupActivity(ctx, models.NewGroupActivity(groupID, userID, models.MemberJoinedActivity, 0, details))
+	})
+	if err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return response(), nil
+}
+
+// RevokeGroupInvite lets the group's owner take back a pending invite.
+// Revoking an already revoked invite returns it as it is.
+func (s *GroupSvc) RevokeGroupInvite(ctx god.Ctx, req *pbs.RevokeGroupInviteRequest) (*pbs.RevokeGroupInviteResponse, error) {
+	ownerID, err := getUserIDFromCtx(ctx, s.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.getGroup(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if group.OwnerID != ownerID {
+		return nil, errNotGroupOwner()
+	}
+
+	invite, err := s.Clients.GroupRepository().GetLastGroupInvite(ctx, group.ID, int(req.InvitedUserId))
+	if err != nil {
+		if errs.IsDBNotFound(err) {
+			return nil, errGroupInviteNotFound(group.ID)
+		}
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	if invite.Status == models.GroupInviteRevoked {
+		return &pbs.RevokeGroupInviteResponse{Invite: s.Tools.GroupInviteToGroupInviteInfoPB(invite)}, nil
+	}
+
+	if !invite.IsPending() {
+		if err := s.markAsExpiredIfNeeded(ctx, s.Clients.GroupRepository(), invite); err != nil {
+			return nil, errCallingGroupsDB(ctx, err)
+		}
+		return nil, errGroupInviteNotPending(invite.Status)
+	}
+
+	now := time.Now()
+	invite.Status = models.GroupInviteRevoked
+	invite.AnsweredAt = &now
+	if err := s.Clients.GroupRepository().UpdateGroupInvite(ctx, invite); err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return &pbs.RevokeGroupInviteResponse{Invite: s.Tools.GroupInviteToGroupInviteInfoPB(invite)}, nil
 }
+
+// ListMyGroupInvites returns the user's pending invites, newest first.
+func (s *GroupSvc) ListMyGroupInvites(ctx god.Ctx, req *pbs.ListMyGroupInvitesRequest) (*pbs.ListMyGroupInvitesResponse, error) {
+	invites, err := s.Clients.GroupRepository().GetPendingGroupInvitesByUserID(ctx, int(req.UserId))
+	if err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return &pbs.ListMyGroupInvitesResponse{Invites: s.Tools.GroupInvitesToGroupInvitesInfoPB(invites)}, nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*       - Group Invite Links -        */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// CreateGroupInviteLink creates a shareable link to join the group. Only the owner can do this.
+// The plain token is only returned here, as we just store its hash.
+func (s *GroupSvc) CreateGroupInviteLink(ctx god.Ctx, req *pbs.CreateGroupInviteLinkRequest) (*pbs.CreateGroupInviteLinkResponse, error) {
+	group, callerRole, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if callerRole != models.GroupOwnerRole {
+		return nil, errNotGroupOwner()
+	}
+
+	role := s.Tools.GroupRoleFromPB(req.Role)
+	if role == "" {
+		role = models.GroupMemberRole
+	}
+	if role == models.GroupOwnerRole {
+		return nil, errs.GRPCFailedPrecondition("invite links can't make users owners")
+	}
+
+	token, tokenHash, err := s.Tools.GenerateSecret()
+	if err != nil {
+		return nil, errs.NewGRPCError(codes.Internal, err)
+	}
+
+	link := &models.GroupInviteLink{
+		GroupID:   group.ID,
+		CreatorID: group.OwnerID,
+		TokenHash: tokenHash,
+		Role:      role,
+		MaxUses:   int(req.GetMaxUses()),
+	}
+	if req.ExpiresInHours != nil {
+		expiresAt := time.Now().Add(time.Duration(req.GetExpiresInHours()) * time.Hour)
+		link.ExpiresAt = &expiresAt
+	}
+
+	if err := s.Clients.GroupRepository().CreateGroupInviteLink(ctx, link); err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return &pbs.CreateGroupInviteLinkResponse{
+		Link:  s.Tools.GroupInviteLinkToGroupInviteLinkInfoPB(link),
+		Token: token,
+	}, nil
+}
+
+// ListGroupInviteLinks returns the group's active links, or all of them, with who joined through each one.
+// Only the owner can do this.
+func (s *GroupSvc) ListGroupInviteLinks(ctx god.Ctx, req *pbs.ListGroupInviteLinksRequest) (*pbs.ListGroupInviteLinksResponse, error) {
+	group, callerRole, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if callerRole != models.GroupOwnerRole {
+		return nil, errNotGroupOwner()
+	}
+
+	links, err := s.Clients.GroupRepository().GetGroupInviteLinks(ctx, group.ID, !req.IncludeInactive)
+	if err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return &pbs.ListGroupInviteLinksResponse{Links: s.Tools.GroupInviteLinksToGroupInviteLinksInfoPB(links)}, nil
+}
+
+// RevokeGroupInviteLink disables the link. Only the owner can do this.
+// Revoking an already revoked link returns it as it is.
+func (s *GroupSvc) RevokeGroupInviteLink(ctx god.Ctx, req *pbs.RevokeGroupInviteLinkRequest) (*pbs.RevokeGroupInviteLinkResponse, error) {
+	group, callerRole, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if callerRole != models.GroupOwnerRole {
+		return nil, errNotGroupOwner()
+	}
+
+	link, err := s.Clients.GroupRepository().GetGroupInviteLink(ctx, group.ID, int(req.LinkId))
+	if err != nil {
+		if errs.IsDBNotFound(err) {
+			return nil, errInviteLinkNotFound(int(req.LinkId))
+		}
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	if link.RevokedAt == nil {
+		now := time.Now()
+		link.RevokedAt = &now
+		if err := s.Clients.GroupRepository().UpdateGroupInviteLink(ctx, link); err != nil {
+			return nil, errCallingGroupsDB(ctx, err)
+		}
+	}
+
+	return &pbs.RevokeGroupInviteLinkResponse{Link: s.Tools.GroupInviteLinkToGroupInviteLinkInfoPB(link)}, nil
+}
+
+// JoinGroupByLink adds the authenticated user to the link's group, with the link's role.
+// Joining a group you're already a member of doesn't use the link.
+func (s *GroupSvc) JoinGroupByLink(ctx god.Ctx, req *pbs.JoinGroupByLinkRequest) (*pbs.JoinGroupByLinkResponse, error) {
+	userID, err := getUserIDFromCtx(ctx, s.Tools)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := s.Clients.GroupRepository().GetGroupInviteLinkByTokenHash(ctx, s.Tools.HashSecret(req.Token))
+	if err != nil {
+		if errs.IsDBNotFound(err) {
+			return nil, errs.GRPCNotFound("invite link", "with that token")
+		}
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	group, err := s.getGroup(ctx, link.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember, err := s.Clients.GroupRepository().IsGroupMember(ctx, group.ID, userID)
+	if err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+	if isMember || group.OwnerID == userID {
+		return &pbs.JoinGroupByLinkResponse{Group: s.Tools.GroupToGroupInfoPB(group)}, nil
+	}
+
+	if !link.IsActive() {
+		return nil, errInviteLinkNotActive(link.InactiveReason())
+	}
+
+	err = s.Clients.GroupRepository().InTransaction(ctx, func(txRepo core.GroupRepository) error {
+		used, err := txRepo.UseGroupInviteLink(ctx, link.ID, userID)
+		if err != nil {
+			return err
+		}
+		if !used {
+			return errInviteLinkNotActive("used up")
+		}
+		if err := txRepo.AddGroupMember(ctx, group.ID, userID, link.Role); err != nil {
+			return err
+		}
+
+		details := map[string]string{"via": "link", "link_id": strconv.Itoa(link.ID)}
+		return txRepo.CreateGroupActivity(ctx, models.NewGroupActivity(group.ID, userID, models.MemberJoinedActivity, 0, details))
+	})
+	if err != nil {
+		if _, isGRPCErr := status.FromError(err); isGRPCErr {
+			return nil, err
+		}
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return reloadGroupAs(s, ctx, group.ID, func(g *pbs.GroupInfo) *pbs.JoinGroupByLinkResponse {
+		return &pbs.JoinGroupByLinkResponse{Group: g}
+	})
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - Group Activity -         */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// ListGroupActivity returns the group's events, newest first, optionally filtered by type.
+// Pages are cursor-based: the next_page_token of a response gets the following page.
+// Only members can see the group's activity.
+func (s *GroupSvc) ListGroupActivity(ctx god.Ctx, req *pbs.ListGroupActivityRequest) (*pbs.ListGroupActivityResponse, error) {
+	group, _, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	beforeID, err := decodeActivityPageToken(req.PageToken)
+	if err != nil {
+		return nil, errs.NewGRPCError(codes.InvalidArgument, err, "invalid page_token")
+	}
+
+	pageSize := defaultActivityPageSize
+	if req.PageSize != nil {
+		pageSize = int(req.GetPageSize())
+	}
+
+	types := make([]models.GroupActivityType, 0, len(req.Types))
+	for _, activityType := range req.Types {
+		types = append(types, s.Tools.GroupActivityTypeFromPB(activityType))
+	}
+
+	// We ask for one more than needed to know if there's a next page.
+	activities, err := s.Clients.GroupRepository().GetGroupActivities(ctx, group.ID, beforeID, types, pageSize+1)
+	if err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	nextPageToken := ""
+	if len(activities) > pageSize {
+		activities = activities[:pageSize]
+		nextPageToken = encodeActivityPageToken(activities[pageSize-1].ID)
+	}
+
+	return &pbs.ListGroupActivityResponse{
+		Activities:    s.Tools.GroupActivitiesToGroupActivitiesInfoPB(activities),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+const defaultActivityPageSize = 20
+
+// Page tokens are opaque to clients, but they're just the last ID they got.
+func encodeActivityPageToken(lastID int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(lastID)))
+}
+
+func decodeActivityPageToken(pageToken string) (int, error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(decoded))
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*         - Group Usage Budgets -     */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// ListGroupBudgets returns the group's GPT budgets and how much is left of them this month.
+// The owner and admins see all of them, plain members only the group-wide ones and their own.
+func (s *GroupSvc) ListGroupBudgets(ctx god.Ctx, req *pbs.ListGroupBudgetsRequest) (*pbs.ListGroupBudgetsResponse, error) {
+	group, callerRole, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := s.Clients.GroupRepository().GetUsageBudgets(ctx, group.ID)
+	if err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	if callerRole == models.GroupMemberRole {
+		callerID, _ := getUserIDFromCtx(ctx, s.Tools)
+		visible := make([]*models.UsageBudget, 0, len(budgets))
+		for _, budget := range budgets {
+			if budget.IsGroupWide() || budget.UserID == callerID {
+				visible = append(visible, budget)
+			}
+		}
+		budgets = visible
+	}
+
+	return &pbs.ListGroupBudgetsResponse{Budgets: s.Tools.UsageBudgetsToUsageBudgetsInfoPB(budgets)}, nil
+}
+
+// SetGroupBudget creates or updates the monthly budget of the group, or of one of its members
+// if UserId is set. Only the owner can do this.
+func (s *GroupSvc) SetGroupBudget(ctx god.Ctx, req *pbs.SetGroupBudgetRequest) (*pbs.SetGroupBudgetResponse, error) {
+	group, callerRole, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if callerRole != models.GroupOwnerRole {
+		return nil, errNotGroupOwner()
+	}
+
+	userID := int(req.GetUserId())
+	if userID != 0 && userID != group.OwnerID {
+		if _, err := s.getMemberFromGroup(group, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	unit := s.Tools.BudgetUnitFromPB(req.Unit)
+	budgets, err := s.Clients.GroupRepository().GetUsageBudgetsForUser(ctx, group.ID, userID, unit)
+	if err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	budget := &models.UsageBudget{GroupID: group.ID, UserID: userID, Unit: unit, Period: models.BudgetPeriod(time.Now())}
+	for _, existing := range budgets {
+		if existing.UserID == userID {
+			budget = existing
+		}
+	}
+
+	budget.MonthlyLimit = req.MonthlyLimit
+	if req.ResetUsed {
+		budget.Used = 0
+		budget.Period = models.BudgetPeriod(time.Now())
+	}
+
+	if err := s.Clients.GroupRepository().SaveUsageBudget(ctx, budget); err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return &pbs.SetGroupBudgetResponse{Budget: s.Tools.UsageBudgetToUsageBudgetInfoPB(budget)}, nil
+}
+
+// DeleteGroupBudget removes the budget, so that usage isn't capped anymore. Only the owner can do this.
+func (s *GroupSvc) DeleteGroupBudget(ctx god.Ctx, req *pbs.DeleteGroupBudgetRequest) (*pbs.DeleteGroupBudgetResponse, error) {
+	group, callerRole, err := s.getGroupAndCallerRole(ctx, int(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	if callerRole != models.GroupOwnerRole {
+		return nil, errNotGroupOwner()
+	}
+
+	budget, err := s.Clients.GroupRepository().GetUsageBudget(ctx, group.ID, int(req.BudgetId))
+	if err != nil {
+		if errs.IsDBNotFound(err) {
+			return nil, errs.GRPCNotFound("usage budget", int(req.BudgetId))
+		}
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	if err := s.Clients.GroupRepository().DeleteUsageBudget(ctx, budget); err != nil {
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+
+	return &pbs.DeleteGroupBudgetResponse{BudgetId: int32(budget.ID)}, nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*              - Helpers -            */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+func (s *GroupSvc) getGroup(ctx god.Ctx, groupID int) (*models.Group, error) {
+	group, err := s.Clients.GroupRepository().GetGroupByID(ctx, groupID)
+	if err != nil {
+		if errs.IsDBNotFound(err) {
+			return nil, errGroupNotFound(groupID)
+		}
+		return nil, errCallingGroupsDB(ctx, err)
+	}
+	return group, nil
+}
+
+// Returns the group and the role the authenticated user has on it.
+// Users that aren't members of the group get a PermissionDenied.
+//
+// The group's OwnerID is always treated as the owner, even for groups created before
+// memberships had roles.
+func (s *GroupSvc) getGroupAndCallerRole(ctx god.Ctx, groupID int) (*models.Group, models.GroupRole, error) {
+	callerID, err := getUserIDFromCtx(ctx, s.Tools)
+	if err != nil {
+		return nil, "", err
+	}
+
+	group, err := s.getGroup(ctx, groupID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if group.OwnerID == callerID {
+		return group, models.GroupOwnerRole, nil
+	}
+
+	for _, member := range group.Memberships {
+		if member.UserID == callerID {
+			return group, member.Role, nil
+		}
+	}
+
+	return nil, "", errNotGroupMember()
+}
+
+// Gets the member from the group's already loaded memberships.
+func (s *GroupSvc) getMemberFromGroup(group *models.Group, userID int) (*models.UsersInGroup, error) {
+	for i := range group.Memberships {
+		if group.Memberships[i].UserID == userID {
+			return &group.Memberships[i], nil
+		}
+	}
+	return nil, errs.GRPCNotFound("group member", userID)
+}
+
+// Gets the group again after changing its members, and wraps it on the response.
+func reloadGroupAs[T any](s *GroupSvc, ctx god.Ctx, groupID int, wrap func(*pbs.GroupInfo) T) (T, error) {
+	group, err := s.getGroup(ctx, groupID)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return wrap(s.Tools.GroupToGroupInfoPB(group)), nil
+}
+
+// Runs change and stores the activity describing it on the same transaction.
+func (s *GroupSvc) changeGroupWithActivity(ctx god.Ctx, activity *models.GroupActivity, change func(txRepo core.GroupRepository) error) error {
+	return s.Clients.GroupRepository().InTransaction(ctx, func(txRepo core.GroupRepository) error {
+		if err := change(txRepo); err != nil {
+			return err
+		}
+		return txRepo.CreateGroupActivity(ctx, activity)
+	})
+}
+
+// Expiration is lazy, a pending invite past its ExpiresAt only gets
+// its status changed when someone tries to use it.
+func (s *GroupSvc) markAsExpiredIfNeeded(ctx god.Ctx, repo core.GroupRepository, invite *models.GroupInvite) error {
+	if invite == nil || invite.Status != models.GroupInvitePending || !invite.IsExpired() {
+		return nil
+	}
+	invite.Status = models.GroupInviteExpired
+	return repo.UpdateGroupInvite(ctx, invite)
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+var (
+	errGroupNotFound         = func(id int) error { return errs.GRPCNotFound("group", id) }
+	errGroupInviteNotFound   = func(groupID int) error { return errs.GRPCNotFound("invite to group", groupID) }
+	errNotGroupOwner         = func() error { return errs.GRPCPermissionDenied("only the group owner can do this") }
+	errNotGroupOwnerOrAdmin  = func() error { return errs.GRPCPermissionDenied("only the group owner or admins can do this") }
+	errNotGroupMember        = func() error { return errs.GRPCPermissionDenied("not a member of the group") }
+	errInviteLinkNotFound    = func(id int) error { return errs.GRPCNotFound("invite link", id) }
+	errInviteLinkNotActive   = func(reason string) error { return errs.GRPCFailedPrecondition("invite link is " + reason) }
+	errGroupInviteNotPending = func(status models.GroupInviteStatus) error {
+		return errs.GRPCFailedPrecondition("invite is " + string(status))
+	}
+	errCallingGroupsDB = func(ctx god.Ctx, err error) error {
+		route := core.GetRouteFromCtx(ctx)
+		logs.LogUnexpected(err)
+		return errs.GRPCFromDB(err, route.Name)
+	}
+)
@@ -2,45 +2,77 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/gilperopiola/grpc-gateway-impl/app/core"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
-	"github.com/gilperopiola/grpc-gateway-impl/app/core/utils"
-	"go.uber.org/zap"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// Also holds the standard grpc.health.v1 service, which isn't one of our Svcs. See healthChecker.
 type HealthSvc struct {
 	pbs.UnimplementedHealthServiceServer
 	Clients core.Clients
 	Tools   core.Tools
+	checker *healthChecker
 }
 
-// Checks the DB connection and makes an HTTP call.
-// If both succeed, returns OK and sends the app version.
-func (h *HealthSvc) CheckHealth(ctx context.Context, _ *pbs.CheckHealthRequest) (*pbs.CheckHealthResponse, error) {
+// Each of the services gets its own status on grpc.health.v1.
+func newHealthSvc(clients core.Clients, tools core.Tools, cfg *core.HealthCfg, serviceDescs []*grpc.ServiceDesc) HealthSvc {
+	services := make([]string, 0, len(serviceDescs))
+	for _, serviceDesc := range serviceDescs {
+		services = append(services, serviceDesc.ServiceName)
+	}
 
-	msg := core.G.AppName + " " + core.G.Version
+	checker := newHealthChecker(cfg.CheckTimeout, services)
+	registerHealthChecks(checker, clients, cfg)
+	return HealthSvc{Clients: clients, Tools: tools, checker: checker}
+}
 
-	// Get the DB or return unhealthy.
-	if _, err := utils.TryAndRetryNoErr(h.Clients.GetDB, 3, false, nil); err != nil {
-		return nil, status.Error(codes.Unavailable, msg+" unhealthy: database connection not working")
+// Answers with the last checks. If a critical one is failing, returns Unavailable.
+func (h *HealthSvc) CheckHealth(_ context.Context, _ *pbs.CheckHealthRequest) (*pbs.CheckHealthResponse, error) {
+	report := h.HealthReport()
+	if !report.Ready {
+		return nil, status.Error(codes.Unavailable, report.Info)
 	}
+	return report, nil
+}
+
+// The last checks, and whether we're ready. Used by CheckHealth and /readyz.
+func (h *HealthSvc) HealthReport() *pbs.CheckHealthResponse {
+	msg := core.G.AppName + " " + core.G.Version
 
-	// Make HTTP call or return unhealthy.
-	word := "healthy"
-	gptResponse, err := h.Clients.SendRequestToGPT(ctx, fmt.Sprintf("Give a really short response, which includes the word '%s'.", word))
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, msg+" unhealthy: http calls not working")
+	report := &pbs.CheckHealthResponse{Ready: h.checker.ready(), Checks: h.checker.checksInfo()}
+	var failing []string
+	for _, check := range report.Checks {
+		if !check.Healthy {
+			failing = append(failing, check.Name+": "+check.Error)
+		}
 	}
 
-	if !strings.Contains(strings.ToLower(gptResponse), "healthy") {
-		zap.S().Warnf("GPT response does not contain 'healthy': %s", gptResponse)
+	switch {
+	case !report.Ready:
+		report.Info = msg + " unhealthy"
+	case len(failing) > 0:
+		report.Info = msg + " degraded"
+	default:
+		report.Info = msg + " healthy"
+	}
+	if len(failing) > 0 {
+		report.Info += " (" + strings.Join(failing, ", ") + ")"
 	}
+	return report
+}
+
+// Checks our dependencies once, see workers.RunHealthChecks.
+func (h *HealthSvc) RunHealthChecks(ctx context.Context) {
+	h.checker.runChecks(ctx)
+}
 
-	return &pbs.CheckHealthResponse{Info: msg + " healthy"}, nil
+// Makes us not ready and not serving from now on. It's the first thing we do on shutdown.
+func (h *HealthSvc) ShutdownHealth() {
+	h.checker.stop()
 }
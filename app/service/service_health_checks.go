@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*           - Health Checks -         */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Our dependencies are checked in the background, see workers.RunHealthChecks, and the probes only look at
+// the last results. So probing us is free, and a slow dependency doesn't make the probes slow.
+//
+// A failing critical check makes us not ready. Any failing check makes the services that need it not serving
+// on grpc.health.v1, so clients can tell which ones would fail.
+
+// A dependency to check. Its run should stop when its ctx is done.
+type healthCheck struct {
+	name     string
+	critical bool
+	services []string // The gRPC services that don't work without it.
+	run      func(ctx context.Context) error
+}
+
+type healthCheckResult struct {
+	err       error
+	checkedAt time.Time
+	took      time.Duration
+}
+
+// Runs the registered checks and keeps their last results, updating the statuses on grpc.health.v1 with them.
+type healthChecker struct {
+	checks     []healthCheck
+	services   []string
+	timeout    time.Duration
+	grpcHealth *health.Server
+
+	mu       sync.RWMutex
+	results  map[string]healthCheckResult
+	shutdown bool
+}
+
+// Everything starts as not serving, until the first checks are done.
+func newHealthChecker(timeout time.Duration, services []string) *healthChecker {
+	hc := &healthChecker{
+		services:   services,
+		timeout:    timeout,
+		grpcHealth: health.NewServer(),
+		results:    map[string]healthCheckResult{},
+	}
+	hc.grpcHealth.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	for _, service := range services {
+		hc.grpcHealth.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	return hc
+}
+
+func (hc *healthChecker) register(check healthCheck) {
+	hc.checks = append(hc.checks, check)
+}
+
+// Runs all checks at the same time, each with its own timeout, and returns when they're all done.
+func (hc *healthChecker) runChecks(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, check := range hc.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := hc.runCheck(ctx, check)
+
+			hc.mu.Lock()
+			hc.results[check.name] = result
+			hc.mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	hc.updateStatuses()
+}
+
+// Checks that don't stop on time are left running, and count as failed.
+func (hc *healthChecker) runCheck(ctx context.Context, check healthCheck) healthCheckResult {
+	ctx, cancel := context.WithTimeout(ctx, hc.timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("panic: %v", r)
+			}
+		}()
+		done <- check.run(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = fmt.Errorf("timed out after %s", hc.timeout)
+	}
+
+	// Only new failures are logged, not every run of the same one.
+	if err != nil && hc.lastError(check.name) == nil {
+		logs.LogStrange(fmt.Sprintf("health check %s failing: %v", check.name, err))
+	}
+	return healthCheckResult{err, start, time.Since(start)}
+}
+
+func (hc *healthChecker) updateStatuses() {
+	hc.mu.RLock()
+	defer hc.mu.RUnlock()
+
+	if hc.shutdown {
+		return
+	}
+
+	hc.grpcHealth.SetServingStatus("", servingStatus(hc.isReady()))
+	for _, service := range hc.services {
+		serving := true
+		for _, check := range hc.checks {
+			if slices.Contains(check.services, service) && !hc.passed(check.name) {
+				serving = false
+			}
+		}
+		hc.grpcHealth.SetServingStatus(service, servingStatus(serving))
+	}
+}
+
+// Ready once every critical check has passed on its last run, and until we start shutting down.
+func (hc *healthChecker) ready() bool {
+	hc.mu.RLock()
+	defer hc.mu.RUnlock()
+	return hc.isReady()
+}
+
+// Nil if it passed or hasn't run yet.
+func (hc *healthChecker) lastError(name string) error {
+	hc.mu.RLock()
+	defer hc.mu.RUnlock()
+	return hc.results[name].err
+}
+
+// From now on we're not ready and nothing is serving, so no new requests are sent our way while we stop.
+func (hc *healthChecker) stop() {
+	hc.mu.Lock()
+	hc.shutdown = true
+	hc.mu.Unlock()
+
+	hc.grpcHealth.Shutdown()
+}
+
+// The last results, in the order the checks were registered.
+func (hc *healthChecker) checksInfo() []*pbs.HealthCheckInfo {
+	hc.mu.RLock()
+	defer hc.mu.RUnlock()
+
+	checksInfo := make([]*pbs.HealthCheckInfo, 0, len(hc.checks))
+	for _, check := range hc.checks {
+		checkInfo := &pbs.HealthCheckInfo{Name: check.name, Critical: check.critical, Error: "not checked yet"}
+		if result, ok := hc.results[check.name]; ok {
+			checkInfo.Healthy = result.err == nil
+			checkInfo.Error = ""
+			if result.err != nil {
+				checkInfo.Error = result.err.Error()
+			}
+			checkInfo.CheckedAt = result.checkedAt.Format(time.RFC3339)
+			checkInfo.TookMs = result.took.Milliseconds()
+		}
+		checksInfo = append(checksInfo, checkInfo)
+	}
+	return checksInfo
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+// These two need hc.mu to be held.
+
+func (hc *healthChecker) isReady() bool {
+	if hc.shutdown {
+		return false
+	}
+	for _, check := range hc.checks {
+		if check.critical && !hc.passed(check.name) {
+			return false
+		}
+	}
+	return true
+}
+
+func (hc *healthChecker) passed(name string) bool {
+	result, ok := hc.results[name]
+	return ok && result.err == nil
+}
+
+func servingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+/* -~-~-~- Checks -~-~-~- */
+
+// The database is the only critical one. The upstream APIs are only checked if configured, as each check is a call,
+// and failing them only makes the services using them not serving.
+func registerHealthChecks(hc *healthChecker, clients core.Clients, cfg *core.HealthCfg) {
+	hc.register(healthCheck{
+		name:     "database",
+		critical: true,
+		services: []string{
+			pbs.AuthService_ServiceDesc.ServiceName,
+			pbs.UsersSvc_ServiceDesc.ServiceName,
+			pbs.GroupsService_ServiceDesc.ServiceName,
+			pbs.GPTService_ServiceDesc.ServiceName,
+		},
+		run: clients.PingDB,
+	})
+
+	if !cfg.CheckUpstreams {
+		return
+	}
+
+	// Moderation is free, unlike chatting with GPT.
+	hc.register(healthCheck{
+		name:     "openai",
+		services: []string{pbs.GPTService_ServiceDesc.ServiceName},
+		run: func(ctx context.Context) error {
+			_, err := clients.SendToModeration(ctx, "health check")
+			return err
+		},
+	})
+
+	// Not through the cache, as it would hide the provider failing until its answers expire.
+	hc.register(healthCheck{
+		name:     "weather",
+		services: []string{pbs.WeatherService_ServiceDesc.ServiceName},
+		run:      clients.PingWeather,
+	})
+}
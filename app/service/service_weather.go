@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"google.golang.org/grpc/codes"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*          - Weather Service -        */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// OpenWeatherMap answers in standard units, and they're converted here to the ones asked for.
+// The API client caches them, so asking again for the same place in other units doesn't call anyone.
+
+type WeatherSvc struct {
+	pbs.UnimplementedWeatherServiceServer
+	Clients core.Clients
+	Tools   core.Tools
+}
+
+const maxGeocodingResults = 5
+
+func (svc *WeatherSvc) GetCurrentWeather(ctx context.Context, req *pbs.GetCurrentWeatherRequest) (*pbs.GetCurrentWeatherResponse, error) {
+	location, err := svc.resolveLocation(ctx, req.Lat, req.Lon, req.City)
+	if err != nil {
+		return nil, err
+	}
+
+	weather, err := svc.Clients.GetCurrentWeather(ctx, location.Lat, location.Lon)
+	if err != nil {
+		return nil, grpcFromWeather(err)
+	}
+	if req.City == "" {
+		location.Name, location.Country = weather.Name, weather.Sys.Country
+	}
+
+	// It's the same as a forecast entry, but with the rain of the last hour.
+	units := weatherUnits(req.Units)
+	current := apimodels.ForecastEntry{
+		Dt:         weather.Dt,
+		Main:       weather.Main,
+		Weather:    weather.Weather,
+		Clouds:     weather.Clouds,
+		Wind:       weather.Wind,
+		Visibility: weather.Visibility,
+		Rain:       apimodels.ForecastRain{ThreeH: weather.Rain.OneH},
+	}
+
+	return &pbs.GetCurrentWeatherResponse{
+		Location: location,
+		Weather:  weatherConditions(current, units),
+		Units:    units,
+		Sunrise:  unixToRFC3339(weather.Sys.Sunrise),
+		Sunset:   unixToRFC3339(weather.Sys.Sunset),
+	}, nil
+}
+
+func (svc *WeatherSvc) GetWeatherForecast(ctx context.Context, req *pbs.GetWeatherForecastRequest) (*pbs.GetWeatherForecastResponse, error) {
+	location, err := svc.resolveLocation(ctx, req.Lat, req.Lon, req.City)
+	if err != nil {
+		return nil, err
+	}
+
+	forecast, err := svc.Clients.GetWeatherForecast(ctx, location.Lat, location.Lon)
+	if err != nil {
+		return nil, grpcFromWeather(err)
+	}
+	if req.City == "" {
+		location.Name, location.Country = forecast.City.Name, forecast.City.Country
+	}
+
+	units := weatherUnits(req.Units)
+	out := &pbs.GetWeatherForecastResponse{Location: location, Units: units}
+	for _, entry := range forecast.List {
+		if req.Days != nil && entry.Dt >= forecast.List[0].Dt+int(*req.Days)*24*60*60 {
+			break
+		}
+		out.Forecast = append(out.Forecast, weatherConditions(entry, units))
+	}
+	return out, nil
+}
+
+func (svc *WeatherSvc) GeocodeCity(ctx context.Context, req *pbs.GeocodeCityRequest) (*pbs.GeocodeCityResponse, error) {
+	limit := maxGeocodingResults
+	if req.Limit != nil {
+		limit = int(*req.Limit)
+	}
+
+	places, err := svc.Clients.GeocodeCity(ctx, req.City, limit)
+	if err != nil {
+		return nil, grpcFromWeather(err)
+	}
+
+	out := &pbs.GeocodeCityResponse{Locations: make([]*pbs.WeatherLocationInfo, 0, len(places))}
+	for _, place := range places {
+		out.Locations = append(out.Locations, weatherLocationInfo(place))
+	}
+	return out, nil
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+// Returns where to get the weather from: the coordinates, or the most relevant place with the city's name.
+func (svc *WeatherSvc) resolveLocation(ctx context.Context, lat, lon *float64, city string) (*pbs.WeatherLocationInfo, error) {
+	city = strings.TrimSpace(city)
+	if city != "" {
+		if lat != nil || lon != nil {
+			return nil, errs.GRPCInvalidArgument("a city or coordinates should be sent, not both")
+		}
+
+		places, err := svc.Clients.GeocodeCity(ctx, city, 1)
+		if err != nil {
+			return nil, grpcFromWeather(err)
+		}
+		if len(places) == 0 {
+			return nil, errs.GRPCNotFound("city", city)
+		}
+		return weatherLocationInfo(places[0]), nil
+	}
+
+	if lat == nil || lon == nil {
+		return nil, errs.GRPCInvalidArgument("either a city or both lat and lon are required")
+	}
+	return &pbs.WeatherLocationInfo{Lat: *lat, Lon: *lon}, nil
+}
+
+// METRIC is the default.
+func weatherUnits(units pbs.WeatherUnits) pbs.WeatherUnits {
+	if units == pbs.WeatherUnits_WEATHER_UNITS_UNSPECIFIED {
+		return pbs.WeatherUnits_WEATHER_UNITS_METRIC
+	}
+	return units
+}
+
+func weatherConditions(entry apimodels.ForecastEntry, units pbs.WeatherUnits) *pbs.WeatherConditions {
+	conditions := &pbs.WeatherConditions{
+		Time:              unixToRFC3339(entry.Dt),
+		Temperature:       convertTemperature(entry.Main.Temp, units),
+		FeelsLike:         convertTemperature(entry.Main.FeelsLike, units),
+		TemperatureMin:    convertTemperature(entry.Main.TempMin, units),
+		TemperatureMax:    convertTemperature(entry.Main.TempMax, units),
+		Humidity:          int32(entry.Main.Humidity),
+		Pressure:          int32(entry.Main.Pressure),
+		WindSpeed:         convertSpeed(entry.Wind.Speed, units),
+		WindDeg:           int32(entry.Wind.Deg),
+		Clouds:            int32(entry.Clouds.All),
+		RainMm:            entry.Rain.ThreeH,
+		PrecipitationProb: entry.Pop,
+	}
+	if len(entry.Weather) > 0 {
+		conditions.Main = entry.Weather[0].Main
+		conditions.Description = entry.Weather[0].Description
+		conditions.Icon = entry.Weather[0].Icon
+	}
+	return conditions
+}
+
+func weatherLocationInfo(place apimodels.GeocodingResult) *pbs.WeatherLocationInfo {
+	return &pbs.WeatherLocationInfo{Name: place.Name, Country: place.Country, State: place.State, Lat: place.Lat, Lon: place.Lon}
+}
+
+// Temperatures come in Kelvin.
+func convertTemperature(kelvin float64, units pbs.WeatherUnits) float64 {
+	switch units {
+	case pbs.WeatherUnits_WEATHER_UNITS_METRIC:
+		return roundTo2(kelvin - 273.15)
+	case pbs.WeatherUnits_WEATHER_UNITS_IMPERIAL:
+		return roundTo2((kelvin-273.15)*9/5 + 32)
+	}
+	return kelvin
+}
+
+// Speeds come in meters per second.
+func convertSpeed(metersPerSecond float64, units pbs.WeatherUnits) float64 {
+	if units == pbs.WeatherUnits_WEATHER_UNITS_IMPERIAL {
+		return roundTo2(metersPerSecond * 2.236936)
+	}
+	return metersPerSecond
+}
+
+func roundTo2(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func unixToRFC3339(unix int) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(int64(unix), 0).UTC().Format(time.RFC3339)
+}
+
+// OpenWeatherMap failing isn't the caller's fault, so it's an Unavailable unless the call was cancelled or timed out.
+func grpcFromWeather(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return errs.NewGRPCError(codes.Canceled, err)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return errs.NewGRPCError(codes.DeadlineExceeded, err)
+	}
+	return errs.NewGRPCError(codes.Unavailable, err, "error calling the weather API")
+}
@@ -3,12 +3,15 @@ package service
 import (
 	"context"
 
+	"github.com/gilperopiola/god"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/errs"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // ➤ Service: The entire Business Layer of our application.
@@ -31,17 +34,18 @@ type Service struct {
 	UserSvc
 	GroupSvc
 	GPTSvc
+	WeatherSvc
 	HealthSvc
 	// ...
 }
 
-func Setup(clients core.Clients, tools core.Tools) *Service {
+func Setup(clients core.Clients, tools core.Tools, cfg *core.Config) *Service {
 	service := Service{
-		HealthSvc: HealthSvc{Clients: clients, Tools: tools},
-		AuthSvc:   AuthSvc{Clients: clients, Tools: tools},
-		UserSvc:   UserSvc{Clients: clients, Tools: tools},
-		GroupSvc:  GroupSvc{Clients: clients, Tools: tools},
-		GPTSvc:    GPTSvc{Clients: clients, Tools: tools},
+		AuthSvc:    AuthSvc{Clients: clients, Tools: tools},
+		UserSvc:    UserSvc{Clients: clients, Tools: tools},
+		GroupSvc:   GroupSvc{Clients: clients, Tools: tools},
+		GPTSvc:     GPTSvc{Clients: clients, Tools: tools, ImageJobsCfg: &cfg.ImageJobsCfg},
+		WeatherSvc: WeatherSvc{Clients: clients, Tools: tools},
 		// ...
 		RegistrationInfo: RegistrationInfo{
 			GRPCServiceDescs: []*grpc.ServiceDesc{
@@ -49,6 +53,7 @@ func Setup(clients core.Clients, tools core.Tools) *Service {
 				&pbs.UsersSvc_ServiceDesc,
 				&pbs.GroupsService_ServiceDesc,
 				&pbs.GPTService_ServiceDesc,
+				&pbs.WeatherService_ServiceDesc,
 				&pbs.HealthService_ServiceDesc,
 				// ...
 			},
@@ -57,11 +62,14 @@ func Setup(clients core.Clients, tools core.Tools) *Service {
 				pbs.RegisterUsersSvcHandlerFromEndpoint,
 				pbs.RegisterGroupsServiceHandlerFromEndpoint,
 				pbs.RegisterGPTServiceHandlerFromEndpoint,
+				pbs.RegisterWeatherServiceHandlerFromEndpoint,
 				pbs.RegisterHealthServiceHandlerFromEndpoint,
 				// ...
 			},
 		},
 	}
+	service.HealthSvc = newHealthSvc(clients, tools, &cfg.HealthCfg, service.GRPCServiceDescs)
+
 	logs.InitModuleOK("Service", "⚡")
 	return &service
 }
@@ -78,6 +86,9 @@ func (s *Service) RegisterInGRPC(grpcServer grpc.ServiceRegistrar) {
 	for _, serviceDesc := range s.GRPCServiceDescs {
 		grpcServer.RegisterService(serviceDesc, s)
 	}
+
+	// The standard health service isn't one of our Svcs, so it's registered apart. See HealthSvc.
+	healthpb.RegisterHealthServer(grpcServer, s.HealthSvc.checker.grpcHealth)
 }
 
 // Registers all Svcs and endpoints on the HTTP Server.
@@ -90,6 +101,18 @@ func (s *Service) RegisterInHTTP(mux *runtime.ServeMux, opts ...grpc.DialOption)
 
 /* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
 
+// Returns the authenticated user's ID, taken from the JWT on the auth interceptor.
+// Only meant for routes that aren't RouteAuthPublic.
+func getUserIDFromCtx(ctx god.Ctx, ctxManager core.ContextManager) (int, error) {
+	userID, err := god.ToIntAndErr(ctxManager.GetUserIDFromCtx(ctx), nil)
+	if err != nil || userID == 0 {
+		return 0, errs.GRPCPermissionDenied(errs.AuthUserIDInvalid)
+	}
+	return userID, nil
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
 /* -> Scraped Ideas:
 
 type SvcBase[T pbs.UnimplementedAuthServiceServer | pbs.UnimplementedUsersSvcServer | pbs.UnimplementedGroupsServiceServer | pbs.UnimplementedGPTServiceServer | pbs.UnimplementedHealthServiceServer] struct {
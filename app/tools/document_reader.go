@@ -0,0 +1,189 @@
+package tools
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+)
+
+var _ core.DocumentReader = &documentReader{}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*     - Tools: Document Reader -      */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Reads plain text, Markdown and PDFs. Markdown is kept as it is, LLMs read it just fine.
+//
+// Chunks are up to chunkSize characters, and each one repeats the last chunkOverlap characters
+// of the one before, so an idea cut in half is still whole on one of them.
+// We try to cut between paragraphs, then lines, then sentences, then words.
+type documentReader struct {
+	chunkSize      int
+	chunkOverlap   int
+	maxDecodedSize int // For PDFs, see DocumentsCfg.
+}
+
+func NewDocumentReader(cfg *core.DocumentsCfg) core.DocumentReader {
+	chunkSize := max(cfg.ChunkSize, 100)
+	chunkOverlap := min(max(cfg.ChunkOverlap, 0), chunkSize/2)
+	maxDecodedSize := cfg.MaxDecodedSize
+	if maxDecodedSize <= 0 {
+		maxDecodedSize = 100 << 20
+	}
+	return &documentReader{chunkSize, chunkOverlap, maxDecodedSize}
+}
+
+// Returns the document's type and the text of each page, leaving out the empty ones.
+// Fails if the type isn't supported, if it's not valid UTF-8 text or if there's no text on it at all, like on scanned PDFs.
+func (dr *documentReader) ExtractText(name, contentType string, content []byte) (apimodels.DocumentText, error) {
+	var pages []apimodels.DocumentPage
+
+	docType := documentType(name, contentType, content)
+	switch docType {
+	case apimodels.DocumentTypePDF:
+		pdfPages, err := extractPDFText(content, dr.maxDecodedSize)
+		if err != nil {
+			return apimodels.DocumentText{}, err
+		}
+		for i, text := range pdfPages {
+			pages = append(pages, apimodels.DocumentPage{Number: i + 1, Text: text})
+		}
+
+	case apimodels.DocumentTypeText, apimodels.DocumentTypeMarkdown:
+		content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf")) // UTF-8 BOM.
+		if !utf8.Valid(content) {
+			return apimodels.DocumentText{}, fmt.Errorf("the document isn't valid UTF-8 text")
+		}
+		pages = append(pages, apimodels.DocumentPage{Number: 1, Text: string(content)})
+
+	default:
+		return apimodels.DocumentText{}, fmt.Errorf("unsupported document type %q, only text, Markdown and PDF documents are supported", contentType)
+	}
+
+	var nonEmpty []apimodels.DocumentPage
+	for _, page := range pages {
+		if page.Text = cleanDocumentText(page.Text); page.Text != "" {
+			nonEmpty = append(nonEmpty, page)
+		}
+	}
+	if len(nonEmpty) == 0 {
+		return apimodels.DocumentText{}, fmt.Errorf("no text found on the document")
+	}
+	return apimodels.DocumentText{ContentType: docType, Pages: nonEmpty}, nil
+}
+
+// Tells the document's type by its content type, then by its extension, then by its content.
+func documentType(name, contentType string, content []byte) string {
+	contentType, _, _ = strings.Cut(strings.ToLower(contentType), ";")
+	switch strings.TrimSpace(contentType) {
+	case apimodels.DocumentTypePDF:
+		return apimodels.DocumentTypePDF
+	case apimodels.DocumentTypeMarkdown, "text/x-markdown":
+		return apimodels.DocumentTypeMarkdown
+	case apimodels.DocumentTypeText:
+		return apimodels.DocumentTypeText
+	}
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".pdf":
+		return apimodels.DocumentTypePDF
+	case ".md", ".markdown":
+		return apimodels.DocumentTypeMarkdown
+	case ".txt", ".text":
+		return apimodels.DocumentTypeText
+	}
+
+	if bytes.HasPrefix(content, []byte("%PDF-")) {
+		return apimodels.DocumentTypePDF
+	}
+	if contentType == "" && utf8.Valid(content) {
+		return apimodels.DocumentTypeText
+	}
+	return contentType
+}
+
+var manyBlankLines = regexp.MustCompile(`\n{3,}`)
+
+// Unifies line endings, trims each line's trailing spaces and leaves at most one blank line between paragraphs.
+func cleanDocumentText(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+	text = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' || !unicode.IsControl(r) {
+			return r
+		}
+		return -1
+	}, text)
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRightFunc(line, unicode.IsSpace)
+	}
+	text = strings.Join(lines, "\n")
+
+	return strings.TrimSpace(manyBlankLines.ReplaceAllString(text, "\n\n"))
+}
+
+/* -~-~-~- Chunking -~-~-~- */
+
+func (dr *documentReader) ChunkPages(pages []apimodels.DocumentPage) []apimodels.DocumentChunkText {
+	var chunks []apimodels.DocumentChunkText
+	for _, page := range pages {
+		for _, content := range dr.chunkText(page.Text) {
+			chunks = append(chunks, apimodels.DocumentChunkText{Index: len(chunks), Page: page.Number, Content: content})
+		}
+	}
+	return chunks
+}
+
+// Works on runes, so chunks are never cut in the middle of a character.
+func (dr *documentReader) chunkText(text string) []string {
+	runes := []rune(text)
+
+	var chunks []string
+	for start := 0; start < len(runes); {
+		end := len(runes)
+		if end-start > dr.chunkSize {
+			end = start + chunkCutPoint(runes[start:start+dr.chunkSize])
+		}
+
+		if chunk := strings.TrimSpace(string(runes[start:end])); chunk != "" {
+			chunks = append(chunks, chunk)
+		}
+		if end == len(runes) {
+			break
+		}
+
+		// The next chunk starts a bit before this one ends, on the start of a word.
+		next := end - dr.chunkOverlap
+		for next > start && next < end && !unicode.IsSpace(runes[next-1]) {
+			next++
+		}
+		if next <= start || next >= end {
+			next = end
+		}
+		start = next
+	}
+	return chunks
+}
+
+// Where to cut a window of text that's too long, from the nicest cut to the ugliest.
+// Cuts in the first half of the window are skipped, they would make chunks too small.
+func chunkCutPoint(window []rune) int {
+	text := string(window)
+	half := len(text) / 2
+
+	for _, separator := range []string{"\n\n", "\n", ". ", "? ", "! ", "; ", " "} {
+		if i := strings.LastIndex(text, separator); i >= half {
+			return utf8.RuneCountInString(text[:i+len(separator)])
+		}
+	}
+	return len(window)
+}
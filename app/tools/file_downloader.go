@@ -24,16 +24,24 @@ var _ core.FileDownloader = fileDownloader{}
 
 /* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
 
-// Returns (filePath, fileSize, error).
+// Returns the file's content.
 // We avoid passing a context because we don't want to cancel the download if the context is cancelled.
-func (fd fileDownloader) DownloadFileToDisk(url, fileExt string, extraHeaders map[string]string) (string, int, error) {
-
+func (fd fileDownloader) DownloadFile(url string, extraHeaders map[string]string) ([]byte, error) {
 	status, content, err := utils.GET(context.Background(), url, extraHeaders, "", fd.client)
 	if err != nil {
-		return "", 0, fmt.Errorf("error downloading file from %s: %w", url, err)
+		return nil, fmt.Errorf("error downloading file from %s: %w", url, err)
 	}
 	if status != http.StatusOK {
-		return "", 0, fmt.Errorf("error downloading file from %s: received status %d with body %s", url, status, string(content))
+		return nil, fmt.Errorf("error downloading file from %s: received status %d with body %s", url, status, string(content))
+	}
+	return content, nil
+}
+
+// Returns (filePath, fileSize, error).
+func (fd fileDownloader) DownloadFileToDisk(url, fileExt string, extraHeaders map[string]string) (string, int, error) {
+	content, err := fd.DownloadFile(url, extraHeaders)
+	if err != nil {
+		return "", 0, err
 	}
 
 	filePath := fmt.Sprintf("./etc/downloads/%s.%s", uuid.New().String()[:12], fileExt)
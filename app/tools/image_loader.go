@@ -54,9 +54,15 @@ func (l *ImageLoader) LoadImgFromURL(url string) (image.Image, error) {
 	return decodeImage(resp.Body, filepath.Ext(url))
 }
 
-// Loads an image from a Base64-encoded string.
+// Loads an image from a Base64-encoded string, which can also be a data URL like "data:image/png;base64,iVBOR...".
 func (l *ImageLoader) LoadImgFromBase64(b64 string) (image.Image, error) {
-	b, err := base64.StdEncoding.DecodeString(b64)
+	if strings.HasPrefix(b64, "data:") {
+		if _, data, found := strings.Cut(b64, ";base64,"); found {
+			b64 = data
+		}
+	}
+
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(b64))
 	if err != nil {
 		return nil, err
 	}
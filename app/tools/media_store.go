@@ -0,0 +1,88 @@
+package tools
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+
+	_ "golang.org/x/image/webp"
+)
+
+var _ core.MediaStore = &mediaStore{}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*       - Tools: Media Store -        */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Stores media files on disk, named after the SHA-256 of their content.
+// Storing the same file twice just points both rows to it.
+type mediaStore struct {
+	folder string
+}
+
+func NewMediaStore(folder string) core.MediaStore {
+	return &mediaStore{folder}
+}
+
+// Writes the content to disk and fills the media's Path, SHA256, SizeBytes, ContentType and,
+// if it's an image, its Width and Height. Non-image files are stored too, without dimensions.
+func (ms *mediaStore) StoreMedia(media *models.GPTMedia, content []byte) error {
+	if len(content) == 0 {
+		return fmt.Errorf("error storing media: empty content")
+	}
+
+	hash := sha256.Sum256(content)
+	media.SHA256 = hex.EncodeToString(hash[:])
+	media.SizeBytes = int64(len(content))
+	media.ContentType = http.DetectContentType(content)
+
+	if imgCfg, format, err := image.DecodeConfig(bytes.NewReader(content)); err == nil {
+		media.Width, media.Height = imgCfg.Width, imgCfg.Height
+		media.ContentType = "image/" + format
+	}
+
+	ext := strings.TrimPrefix(media.ContentType, "image/")
+	if ext == media.ContentType || strings.ContainsAny(ext, "; /") {
+		ext = "bin"
+	}
+
+	if err := os.MkdirAll(ms.folder, os.ModePerm); err != nil {
+		return fmt.Errorf("error creating media folder %s: %w", ms.folder, err)
+	}
+
+	media.Path = filepath.Join(ms.folder, media.SHA256+"."+ext)
+	if _, err := os.Stat(media.Path); err == nil {
+		return nil // Already stored.
+	}
+
+	// Written to a temp file first, so a half written file is never served.
+	tmpPath := media.Path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0644); err != nil {
+		return fmt.Errorf("error saving media to %s: %w", tmpPath, err)
+	}
+	if err := os.Rename(tmpPath, media.Path); err != nil {
+		return fmt.Errorf("error saving media to %s: %w", media.Path, err)
+	}
+
+	return nil
+}
+
+func (ms *mediaStore) ReadMedia(media *models.GPTMedia) ([]byte, error) {
+	content, err := os.ReadFile(media.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading media %d from %s: %w", media.ID, media.Path, err)
+	}
+	return content, nil
+}
@@ -1,11 +1,14 @@
 package tools
 
 import (
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/gilperopiola/grpc-gateway-impl/app/core"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
 	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"google.golang.org/grpc/codes"
 )
 
 // Would this be better off inside of utils/converter or something like that?
@@ -39,14 +42,36 @@ func (this modelConverter) UsersToUsersInfoPB(users []*models.User) []*pbs.UserI
 
 // 🔻 Groups 🔻
 
+// Members are only filled if the group's Memberships were loaded.
 func (this modelConverter) GroupToGroupInfoPB(group *models.Group) *pbs.GroupInfo {
-	return &pbs.GroupInfo{
+	groupInfo := &pbs.GroupInfo{
 		Id:        int32(group.ID),
 		Name:      group.Name,
 		Owner:     &pbs.UserInfo{Id: int32(group.OwnerID)},
 		CreatedAt: group.CreatedAt.Format(time.RFC3339),
 		UpdatedAt: group.UpdatedAt.Format(time.RFC3339),
+		Members:   make([]*pbs.GroupMemberInfo, 0, len(group.Memberships)),
 	}
+	for i := range group.Memberships {
+		member := this.GroupMemberToGroupMemberInfoPB(&group.Memberships[i])
+		if group.Memberships[i].UserID == group.OwnerID && member.User.Username != "" {
+			groupInfo.Owner = member.User
+		}
+		groupInfo.Members = append(groupInfo.Members, member)
+	}
+	return groupInfo
+}
+
+func (this modelConverter) GroupMemberToGroupMemberInfoPB(member *models.UsersInGroup) *pbs.GroupMemberInfo {
+	memberInfo := &pbs.GroupMemberInfo{
+		User:     &pbs.UserInfo{Id: int32(member.UserID)},
+		Role:     groupRoleToPB(member.Role),
+		JoinedAt: member.CreatedAt.Format(time.RFC3339),
+	}
+	if member.User != nil {
+		memberInfo.User = this.UserToUserInfoPB(member.User)
+	}
+	return memberInfo
 }
 
 func (this modelConverter) GroupsToGroupsInfoPB(groups []*models.Group) []*pbs.GroupInfo {
@@ -56,3 +81,513 @@ func (this modelConverter) GroupsToGroupsInfoPB(groups []*models.Group) []*pbs.G
 	}
 	return groupsInfo
 }
+
+// 🔻 Group Invites 🔻
+
+func (this modelConverter) GroupInviteToGroupInviteInfoPB(invite *models.GroupInvite) *pbs.GroupInviteInfo {
+	inviteInfo := &pbs.GroupInviteInfo{
+		Id:        int32(invite.ID),
+		Group:     &pbs.GroupInfo{Id: int32(invite.GroupID)},
+		User:      &pbs.UserInfo{Id: int32(invite.UserID)},
+		Inviter:   &pbs.UserInfo{Id: int32(invite.InviterID)},
+		Status:    groupInviteStatusToPB(invite),
+		ExpiresAt: invite.ExpiresAt.Format(time.RFC3339),
+		CreatedAt: invite.CreatedAt.Format(time.RFC3339),
+	}
+	if invite.Group != nil {
+		inviteInfo.Group = this.GroupToGroupInfoPB(invite.Group)
+	}
+	if invite.User != nil {
+		inviteInfo.User = this.UserToUserInfoPB(invite.User)
+	}
+	if invite.Inviter != nil {
+		inviteInfo.Inviter = this.UserToUserInfoPB(invite.Inviter)
+	}
+	if invite.AnsweredAt != nil {
+		inviteInfo.AnsweredAt = invite.AnsweredAt.Format(time.RFC3339)
+	}
+	return inviteInfo
+}
+
+func (this modelConverter) GroupInvitesToGroupInvitesInfoPB(invites []*models.GroupInvite) []*pbs.GroupInviteInfo {
+	invitesInfo := make([]*pbs.GroupInviteInfo, 0, len(invites))
+	for _, invite := range invites {
+		invitesInfo = append(invitesInfo, this.GroupInviteToGroupInviteInfoPB(invite))
+	}
+	return invitesInfo
+}
+
+func groupInviteStatusToPB(invite *models.GroupInvite) pbs.GroupInviteStatus {
+	if invite.IsExpired() {
+		return pbs.GroupInviteStatus_GROUP_INVITE_STATUS_EXPIRED
+	}
+	switch invite.Status {
+	case models.GroupInvitePending:
+		return pbs.GroupInviteStatus_GROUP_INVITE_STATUS_PENDING
+	case models.GroupInviteAccepted:
+		return pbs.GroupInviteStatus_GROUP_INVITE_STATUS_ACCEPTED
+	case models.GroupInviteDeclined:
+		return pbs.GroupInviteStatus_GROUP_INVITE_STATUS_DECLINED
+	case models.GroupInviteRevoked:
+		return pbs.GroupInviteStatus_GROUP_INVITE_STATUS_REVOKED
+	}
+	return pbs.GroupInviteStatus_GROUP_INVITE_STATUS_UNSPECIFIED
+}
+
+func groupRoleToPB(role models.GroupRole) pbs.GroupRole {
+	switch role {
+	case models.GroupOwnerRole:
+		return pbs.GroupRole_GROUP_ROLE_OWNER
+	case models.GroupAdminRole:
+		return pbs.GroupRole_GROUP_ROLE_ADMIN
+	case models.GroupMemberRole:
+		return pbs.GroupRole_GROUP_ROLE_MEMBER
+	}
+	return pbs.GroupRole_GROUP_ROLE_UNSPECIFIED
+}
+
+// Returns an empty GroupRole for GROUP_ROLE_UNSPECIFIED.
+func (this modelConverter) GroupRoleFromPB(role pbs.GroupRole) models.GroupRole {
+	switch role {
+	case pbs.GroupRole_GROUP_ROLE_OWNER:
+		return models.GroupOwnerRole
+	case pbs.GroupRole_GROUP_ROLE_ADMIN:
+		return models.GroupAdminRole
+	case pbs.GroupRole_GROUP_ROLE_MEMBER:
+		return models.GroupMemberRole
+	}
+	return ""
+}
+
+// 🔻 Group Invite Links 🔻
+
+func (this modelConverter) GroupInviteLinkToGroupInviteLinkInfoPB(link *models.GroupInviteLink) *pbs.GroupInviteLinkInfo {
+	linkInfo := &pbs.GroupInviteLinkInfo{
+		Id:        int32(link.ID),
+		GroupId:   int32(link.GroupID),
+		Creator:   &pbs.UserInfo{Id: int32(link.CreatorID)},
+		Role:      groupRoleToPB(link.Role),
+		MaxUses:   int32(link.MaxUses),
+		Uses:      int32(link.Uses),
+		Active:    link.IsActive(),
+		CreatedAt: link.CreatedAt.Format(time.RFC3339),
+		Joins:     make([]*pbs.GroupInviteLinkJoinInfo, 0, len(link.Joins)),
+	}
+	if link.Creator != nil {
+		linkInfo.Creator = this.UserToUserInfoPB(link.Creator)
+	}
+	if link.ExpiresAt != nil {
+		linkInfo.ExpiresAt = link.ExpiresAt.Format(time.RFC3339)
+	}
+	if link.RevokedAt != nil {
+		linkInfo.RevokedAt = link.RevokedAt.Format(time.RFC3339)
+	}
+	for _, join := range link.Joins {
+		joinInfo := &pbs.GroupInviteLinkJoinInfo{
+			User:     &pbs.UserInfo{Id: int32(join.UserID)},
+			JoinedAt: join.CreatedAt.Format(time.RFC3339),
+		}
+		if join.User != nil {
+			joinInfo.User = this.UserToUserInfoPB(join.User)
+		}
+		linkInfo.Joins = append(linkInfo.Joins, joinInfo)
+	}
+	return linkInfo
+}
+
+func (this modelConverter) GroupInviteLinksToGroupInviteLinksInfoPB(links []*models.GroupInviteLink) []*pbs.GroupInviteLinkInfo {
+	linksInfo := make([]*pbs.GroupInviteLinkInfo, 0, len(links))
+	for _, link := range links {
+		linksInfo = append(linksInfo, this.GroupInviteLinkToGroupInviteLinkInfoPB(link))
+	}
+	return linksInfo
+}
+
+// 🔻 Group Activity 🔻
+
+var groupActivityTypesPB = map[models.GroupActivityType]pbs.GroupActivityType{
+	models.GroupCreatedActivity:         pbs.GroupActivityType_GROUP_ACTIVITY_TYPE_GROUP_CREATED,
+	models.GroupRenamedActivity:         pbs.GroupActivityType_GROUP_ACTIVITY_TYPE_GROUP_RENAMED,
+	models.MemberInvitedActivity:        pbs.GroupActivityType_GROUP_ACTIVITY_TYPE_MEMBER_INVITED,
+	models.MemberJoinedActivity:         pbs.GroupActivityType_GROUP_ACTIVITY_TYPE_MEMBER_JOINED,
+	models.MemberLeftActivity:           pbs.GroupActivityType_GROUP_ACTIVITY_TYPE_MEMBER_LEFT,
+	models.MemberRemovedActivity:        pbs.GroupActivityType_GROUP_ACTIVITY_TYPE_MEMBER_REMOVED,
+	models.RoleChangedActivity:          pbs.GroupActivityType_GROUP_ACTIVITY_TYPE_ROLE_CHANGED,
+	models.OwnershipTransferredActivity: pbs.GroupActivityType_GROUP_ACTIVITY_TYPE_OWNERSHIP_TRANSFERRED,
+	models.ChatSharedActivity:           pbs.GroupActivityType_GROUP_ACTIVITY_TYPE_CHAT_SHARED,
+}
+
+func (this modelConverter) GroupActivityToGroupActivityInfoPB(activity *models.GroupActivity) *pbs.GroupActivityInfo {
+	activityInfo := &pbs.GroupActivityInfo{
+		Id:        int32(activity.ID),
+		GroupId:   int32(activity.GroupID),
+		Type:      groupActivityTypesPB[activity.Type],
+		Actor:     &pbs.UserInfo{Id: int32(activity.ActorID)},
+		Details:   activity.GetDetails(),
+		CreatedAt: activity.CreatedAt.Format(time.RFC3339),
+	}
+	if activity.Actor != nil {
+		activityInfo.Actor = this.UserToUserInfoPB(activity.Actor)
+	}
+	if activity.TargetUser != nil {
+		activityInfo.TargetUser = this.UserToUserInfoPB(activity.TargetUser)
+	} else if activity.TargetUserID != nil {
+		activityInfo.TargetUser = &pbs.UserInfo{Id: int32(*activity.TargetUserID)}
+	}
+	return activityInfo
+}
+
+func (this modelConverter) GroupActivitiesToGroupActivitiesInfoPB(activities []*models.GroupActivity) []*pbs.GroupActivityInfo {
+	activitiesInfo := make([]*pbs.GroupActivityInfo, 0, len(activities))
+	for _, activity := range activities {
+		activitiesInfo = append(activitiesInfo, this.GroupActivityToGroupActivityInfoPB(activity))
+	}
+	return activitiesInfo
+}
+
+// Returns an empty GroupActivityType for GROUP_ACTIVITY_TYPE_UNSPECIFIED.
+func (this modelConverter) GroupActivityTypeFromPB(activityType pbs.GroupActivityType) models.GroupActivityType {
+	for modelType, pbType := range groupActivityTypesPB {
+		if pbType == activityType {
+			return modelType
+		}
+	}
+	return ""
+}
+
+// 🔻 Group Usage Budgets 🔻
+
+// Used and remaining are for the current month, past months' usage doesn't count.
+func (this modelConverter) UsageBudgetToUsageBudgetInfoPB(budget *models.UsageBudget) *pbs.UsageBudgetInfo {
+	now := time.Now()
+	period := models.BudgetPeriod(now)
+	return &pbs.UsageBudgetInfo{
+		Id:           int32(budget.ID),
+		GroupId:      int32(budget.GroupID),
+		UserId:       int32(budget.UserID),
+		Unit:         budgetUnitToPB(budget.Unit),
+		MonthlyLimit: budget.MonthlyLimit,
+		Used:         budget.UsedIn(period),
+		Remaining:    budget.RemainingIn(period),
+		Period:       period,
+		ResetsAt:     models.BudgetResetsAt(now).Format(time.RFC3339),
+	}
+}
+
+func (this modelConverter) UsageBudgetsToUsageBudgetsInfoPB(budgets []*models.UsageBudget) []*pbs.UsageBudgetInfo {
+	budgetsInfo := make([]*pbs.UsageBudgetInfo, 0, len(budgets))
+	for _, budget := range budgets {
+		budgetsInfo = append(budgetsInfo, this.UsageBudgetToUsageBudgetInfoPB(budget))
+	}
+	return budgetsInfo
+}
+
+func budgetUnitToPB(unit models.BudgetUnit) pbs.BudgetUnit {
+	switch unit {
+	case models.BudgetTokens:
+		return pbs.BudgetUnit_BUDGET_UNIT_TOKENS
+	case models.BudgetImages:
+		return pbs.BudgetUnit_BUDGET_UNIT_IMAGES
+	}
+	return pbs.BudgetUnit_BUDGET_UNIT_UNSPECIFIED
+}
+
+// Returns an empty BudgetUnit for BUDGET_UNIT_UNSPECIFIED.
+func (this modelConverter) BudgetUnitFromPB(unit pbs.BudgetUnit) models.BudgetUnit {
+	switch unit {
+	case pbs.BudgetUnit_BUDGET_UNIT_TOKENS:
+		return models.BudgetTokens
+	case pbs.BudgetUnit_BUDGET_UNIT_IMAGES:
+		return models.BudgetImages
+	}
+	return ""
+}
+
+// 🔻 GPT Chats 🔻
+
+func (this modelConverter) GPTChatToGPTChatInfoPB(chat *models.GPTChat) *pbs.GPTChatInfo {
+	chatInfo := &pbs.GPTChatInfo{
+		Id:            int32(chat.ID),
+		Title:         chat.Title,
+		CreatedAt:     chat.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     chat.UpdatedAt.Format(time.RFC3339),
+		Owner:         &pbs.UserInfo{Id: int32(chat.OwnerID)},
+		Visibility:    pbs.GPTChatVisibility_GPT_CHAT_VISIBILITY_PRIVATE,
+		GroupCanReply: chat.GroupCanReply,
+		Provider:      chat.Provider,
+		Model:         chat.Model,
+		Temperature:   chat.Temperature,
+		SystemPrompt:  chat.SystemPrompt,
+	}
+	if chat.MaxTokens != nil {
+		maxTokens := int32(*chat.MaxTokens)
+		chatInfo.MaxTokens = &maxTokens
+	}
+	if chat.SystemPromptID != nil {
+		chatInfo.SystemPromptId = int32(*chat.SystemPromptID)
+	}
+	if chat.Owner != nil {
+		chatInfo.Owner = this.UserToUserInfoPB(chat.Owner)
+	}
+	if chat.IsSharedWithGroup() {
+		chatInfo.Visibility = pbs.GPTChatVisibility_GPT_CHAT_VISIBILITY_GROUP
+		chatInfo.GroupId = int32(*chat.GroupID)
+	}
+	return chatInfo
+}
+
+func (this modelConverter) GPTChatsToGPTChatsInfoPB(chats []*models.GPTChat) []*pbs.GPTChatInfo {
+	chatsInfo := make([]*pbs.GPTChatInfo, 0, len(chats))
+	for _, chat := range chats {
+		chatsInfo = append(chatsInfo, this.GPTChatToGPTChatInfoPB(chat))
+	}
+	return chatsInfo
+}
+
+func (this modelConverter) GPTMessagesToGPTMessagesInfoPB(messages []models.GPTMessage) []*pbs.GPTMessageInfo {
+	messagesInfo := make([]*pbs.GPTMessageInfo, 0, len(messages))
+	for _, msg := range messages {
+		msgInfo := &pbs.GPTMessageInfo{
+			Id:         int32(msg.ID),
+			From:       msg.From,
+			Content:    msg.Content,
+			CreatedAt:  msg.CreatedAt.Format(time.RFC3339),
+			ToolName:   msg.ToolName,
+			ToolCallId: msg.ToolCallID,
+		}
+		if msg.ParentID != nil {
+			msgInfo.ParentId = int32(*msg.ParentID)
+		}
+		if msg.Sender != nil {
+			msgInfo.Sender = this.UserToUserInfoPB(msg.Sender)
+		} else if msg.SenderID != nil {
+			msgInfo.Sender = &pbs.UserInfo{Id: int32(*msg.SenderID)}
+		}
+		for _, media := range msg.Media {
+			msgInfo.Media = append(msgInfo.Media, this.GPTMediaToGPTMediaInfoPB(&media))
+		}
+		messagesInfo = append(messagesInfo, msgInfo)
+	}
+	return messagesInfo
+}
+
+func (this modelConverter) GPTMediaToGPTMediaInfoPB(media *models.GPTMedia) *pbs.GPTMediaInfo {
+	return &pbs.GPTMediaInfo{
+		Id:          int32(media.ID),
+		Url:         media.URL(),
+		Status:      string(media.Status),
+		ContentType: media.ContentType,
+		Sha256:      media.SHA256,
+		SizeBytes:   media.SizeBytes,
+		Width:       int32(media.Width),
+		Height:      int32(media.Height),
+	}
+}
+
+// 🔻 System Prompts 🔻
+
+func (this modelConverter) SystemPromptToSystemPromptInfoPB(prompt *models.SystemPrompt) *pbs.SystemPromptInfo {
+	return &pbs.SystemPromptInfo{
+		Id:          int32(prompt.ID),
+		Name:        prompt.Name,
+		Version:     int32(prompt.Version),
+		Description: prompt.Description,
+		Template:    prompt.Template,
+		CreatedById: int32(prompt.CreatedByID),
+		CreatedAt:   prompt.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (this modelConverter) SystemPromptsToSystemPromptsInfoPB(prompts []*models.SystemPrompt) []*pbs.SystemPromptInfo {
+	promptsInfo := make([]*pbs.SystemPromptInfo, 0, len(prompts))
+	for _, prompt := range prompts {
+		promptsInfo = append(promptsInfo, this.SystemPromptToSystemPromptInfoPB(prompt))
+	}
+	return promptsInfo
+}
+
+// 🔻 Moderation Events 🔻
+
+func (this modelConverter) ModerationEventsToModerationEventsInfoPB(events []*models.ModerationEvent) []*pbs.ModerationEventInfo {
+	eventsInfo := make([]*pbs.ModerationEventInfo, 0, len(events))
+	for _, event := range events {
+		eventsInfo = append(eventsInfo, &pbs.ModerationEventInfo{
+			Id:         int32(event.ID),
+			UserId:     int32(event.UserID),
+			Route:      event.Route,
+			Stage:      string(event.Stage),
+			Action:     event.Action,
+			Source:     event.Source,
+			Categories: splitNonEmpty(event.Categories, ","),
+			Rules:      splitNonEmpty(event.Rules, ","),
+			CreatedAt:  event.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return eventsInfo
+}
+
+func splitNonEmpty(s, sep string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, sep)
+}
+
+// 🔻 Image Jobs 🔻
+
+// The response isn't set, as it needs the job's chat and image.
+func (this modelConverter) ImageJobToGPTOperationPB(job *models.ImageJob) *pbs.GPTOperation {
+	operation := &pbs.GPTOperation{
+		Name: "operations/" + job.OperationID,
+		Done: job.IsDone(),
+		Metadata: &pbs.GPTOperationMetadata{
+			OperationId: job.OperationID,
+			State:       string(job.Status),
+			Attempts:    int32(job.Attempts),
+			ChatId:      int32(job.ChatID),
+			CreatedAt:   job.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   job.UpdatedAt.Format(time.RFC3339),
+		},
+	}
+
+	switch job.Status {
+	case models.ImageJobQueued:
+		operation.Metadata.NextAttemptAt = job.NextAttemptAt.Format(time.RFC3339)
+	case models.ImageJobFailed:
+		operation.Result = &pbs.GPTOperation_Error{Error: &pbs.GPTOperationError{Code: job.ErrorCode, Message: job.LastError}}
+	case models.ImageJobCancelled:
+		operation.Result = &pbs.GPTOperation_Error{Error: &pbs.GPTOperationError{Code: int32(codes.Canceled), Message: "the operation was cancelled"}}
+	}
+
+	return operation
+}
+
+// The items are in their batch order, and their operations don't have a response. Succeeded ones have their image's URL instead.
+func (this modelConverter) ImageBatchToImageBatchInfoPB(batch *models.ImageBatch, chat *models.GPTChat, jobs []*models.ImageJob) *pbs.ImageBatchInfo {
+	progress := &pbs.ImageBatchProgress{Total: int32(batch.Total), Done: true}
+	items := make([]*pbs.ImageBatchItem, 0, len(jobs))
+
+	for _, job := range jobs {
+		switch job.Status {
+		case models.ImageJobQueued:
+			progress.Queued++
+			if nextAt := job.NextAttemptAt.Format(time.RFC3339); progress.NextImageAt == "" || nextAt < progress.NextImageAt {
+				progress.NextImageAt = nextAt
+			}
+		case models.ImageJobRunning:
+			progress.Running++
+		case models.ImageJobSucceeded:
+			progress.Succeeded++
+		case models.ImageJobFailed:
+			progress.Failed++
+		case models.ImageJobCancelled:
+			progress.Cancelled++
+		}
+		progress.Done = progress.Done && job.IsDone()
+
+		item := &pbs.ImageBatchItem{
+			Index:     int32(job.BatchIndex),
+			Prompt:    job.Prompt,
+			Variables: map[string]string{},
+			Operation: this.ImageJobToGPTOperationPB(job),
+		}
+		_ = json.Unmarshal([]byte(job.Variables), &item.Variables)
+		if job.Status == models.ImageJobSucceeded && job.MediaID != nil {
+			item.ImageUrl = (&models.GPTMedia{ID: *job.MediaID}).URL()
+		}
+		items = append(items, item)
+	}
+
+	return &pbs.ImageBatchInfo{
+		Id:        int32(batch.ID),
+		Chat:      this.GPTChatToGPTChatInfoPB(chat),
+		Template:  batch.Template,
+		Mode:      string(batch.Mode),
+		Progress:  progress,
+		Items:     items,
+		CreatedAt: batch.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// 🔻 Documents 🔻
+
+// Documents are counted from the collection's preloaded ones.
+func (this modelConverter) DocumentCollectionToDocumentCollectionInfoPB(collection *models.DocumentCollection) *pbs.DocumentCollectionInfo {
+	return &pbs.DocumentCollectionInfo{
+		Id:          int32(collection.ID),
+		Name:        collection.Name,
+		Description: collection.Description,
+		Documents:   int32(len(collection.Documents)),
+		CreatedAt:   collection.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (this modelConverter) DocumentCollectionsToDocumentCollectionsInfoPB(collections []*models.DocumentCollection) []*pbs.DocumentCollectionInfo {
+	collectionsInfo := make([]*pbs.DocumentCollectionInfo, 0, len(collections))
+	for _, collection := range collections {
+		collectionsInfo = append(collectionsInfo, this.DocumentCollectionToDocumentCollectionInfoPB(collection))
+	}
+	return collectionsInfo
+}
+
+func (this modelConverter) DocumentToDocumentInfoPB(document *models.Document) *pbs.DocumentInfo {
+	return &pbs.DocumentInfo{
+		Id:           int32(document.ID),
+		CollectionId: int32(document.CollectionID),
+		Name:         document.Name,
+		ContentType:  document.ContentType,
+		SizeBytes:    document.SizeBytes,
+		Pages:        int32(document.Pages),
+		Chunks:       int32(document.Chunks),
+		CreatedAt:    document.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (this modelConverter) DocumentsToDocumentsInfoPB(documents []*models.Document) []*pbs.DocumentInfo {
+	documentsInfo := make([]*pbs.DocumentInfo, 0, len(documents))
+	for _, document := range documents {
+		documentsInfo = append(documentsInfo, this.DocumentToDocumentInfoPB(document))
+	}
+	return documentsInfo
+}
+
+// 🔻 Usage Report 🔻
+
+// Returns the rows and their total. The total's average latency is weighted by each row's calls.
+func (this modelConverter) UsageReportToUsageReportPB(rows []*core.UsageReportRow) ([]*pbs.UsageReportRow, *pbs.UsageReportRow) {
+	rowsPB := make([]*pbs.UsageReportRow, 0, len(rows))
+	total := &pbs.UsageReportRow{}
+	var totalLatencyMs float64
+
+	for _, row := range rows {
+		rowsPB = append(rowsPB, &pbs.UsageReportRow{
+			UserId:           int32(row.UserID),
+			Model:            row.Model,
+			Day:              row.Day.Format(time.DateOnly),
+			Calls:            row.Calls,
+			PromptTokens:     row.PromptTokens,
+			CompletionTokens: row.CompletionTokens,
+			ReasoningTokens:  row.ReasoningTokens,
+			TotalTokens:      row.TotalTokens,
+			Images:           row.Images,
+			CostUsd:          row.CostUSD,
+			AvgLatencyMs:     row.AvgLatencyMs,
+			CachedCalls:      row.CachedCalls,
+		})
+
+		total.Calls += row.Calls
+		total.PromptTokens += row.PromptTokens
+		total.CompletionTokens += row.CompletionTokens
+		total.ReasoningTokens += row.ReasoningTokens
+		total.TotalTokens += row.TotalTokens
+		total.Images += row.Images
+		total.CostUsd += row.CostUSD
+		total.CachedCalls += row.CachedCalls
+		totalLatencyMs += row.AvgLatencyMs * float64(row.Calls)
+	}
+
+	if total.Calls > 0 {
+		total.AvgLatencyMs = totalLatencyMs / float64(total.Calls)
+	}
+	return rowsPB, total
+}
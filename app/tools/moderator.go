@@ -0,0 +1,214 @@
+package tools
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+)
+
+var _ core.Moderator = &moderator{}
+
+// Checks texts against our local rules: the blocked words, the PII detectors and the rule sets on the rules file.
+// Each rule blocks the text or redacts what it matched, and the strictest action of all the matches wins.
+type moderator struct {
+	enabled      bool
+	withProvider bool
+	rules        []moderationRule
+}
+
+type moderationRule struct {
+	category string
+	name     string
+	action   apimodels.ModerationAction
+	regex    *regexp.Regexp
+	isMatch  func(match string) bool // Optional, to discard false positives.
+}
+
+// Fails if the rules file can't be read or has invalid rules.
+func NewModerator(cfg *core.ModerationCfg) (core.Moderator, error) {
+	m := &moderator{enabled: cfg.Enabled, withProvider: cfg.Enabled && cfg.UseProviderAPI}
+
+	if len(cfg.BlockedWords) > 0 {
+		if err := m.addRuleSet(apimodels.ModerationRuleSet{Category: "blocked_words", Action: apimodels.ModerationBlock, Keywords: cfg.BlockedWords}); err != nil {
+			return nil, err
+		}
+	}
+
+	piiAction := apimodels.ModerationAction(cfg.PIIAction)
+	switch piiAction {
+	case apimodels.ModerationRedact, apimodels.ModerationBlock:
+		for _, rule := range piiRules {
+			rule.action = piiAction
+			m.rules = append(m.rules, rule)
+		}
+	case apimodels.ModerationAllow:
+	default:
+		return nil, fmt.Errorf("invalid moderation pii action %q", cfg.PIIAction)
+	}
+
+	if cfg.RulesFile != "" {
+		rulesJSON, err := os.ReadFile(cfg.RulesFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading moderation rules file: %w", err)
+		}
+		var ruleSets []apimodels.ModerationRuleSet
+		if err := json.Unmarshal(rulesJSON, &ruleSets); err != nil {
+			return nil, fmt.Errorf("error unmarshalling moderation rules file: %w", err)
+		}
+		for _, ruleSet := range ruleSets {
+			if err := m.addRuleSet(ruleSet); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// Emails, card numbers and secrets that look like API keys or tokens.
+var piiRules = []moderationRule{
+	{category: "pii_email", name: "email", regex: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)},
+	{category: "pii_card", name: "card_number", regex: regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`), isMatch: passesLuhnCheck},
+	{category: "secret_token", name: "openai_key", regex: regexp.MustCompile(`\bsk-[A-Za-z0-9_-]{20,}`)},
+	{category: "secret_token", name: "aws_access_key", regex: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{category: "secret_token", name: "github_token", regex: regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`)},
+	{category: "secret_token", name: "slack_token", regex: regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`)},
+	{category: "secret_token", name: "jwt", regex: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`)},
+	{category: "secret_token", name: "bearer", regex: regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9._~+/-]{20,}=*`)},
+}
+
+func (m *moderator) addRuleSet(ruleSet apimodels.ModerationRuleSet) error {
+	if ruleSet.Category == "" {
+		return fmt.Errorf("moderation rule set without category")
+	}
+	if ruleSet.Action != apimodels.ModerationBlock && ruleSet.Action != apimodels.ModerationRedact {
+		return fmt.Errorf("invalid action %q on moderation rule set %s", ruleSet.Action, ruleSet.Category)
+	}
+
+	for _, keyword := range ruleSet.Keywords {
+		keyword = strings.TrimSpace(keyword)
+		if keyword == "" {
+			continue
+		}
+		regex := regexp.MustCompile(`(?i)` + wordBoundary(keyword, true) + regexp.QuoteMeta(keyword) + wordBoundary(keyword, false))
+		m.rules = append(m.rules, moderationRule{category: ruleSet.Category, name: keyword, action: ruleSet.Action, regex: regex})
+	}
+	for i, pattern := range ruleSet.Patterns {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern on moderation rule set %s: %w", ruleSet.Category, err)
+		}
+		name := fmt.Sprintf("%s_pattern_%d", ruleSet.Category, i+1)
+		m.rules = append(m.rules, moderationRule{category: ruleSet.Category, name: name, action: ruleSet.Action, regex: regex})
+	}
+	return nil
+}
+
+/* -~-~-~- Moderation -~-~-~- */
+
+func (m *moderator) ModerateText(text string) apimodels.ModerationResult {
+	result := apimodels.ModerationResult{Action: apimodels.ModerationAllow, Text: text}
+	if !m.enabled {
+		return result
+	}
+
+	type redaction struct {
+		start, end int
+		category   string
+	}
+	var redactions []redaction
+
+	for _, rule := range m.rules {
+		matched := false
+		for _, loc := range rule.regex.FindAllStringIndex(text, -1) {
+			if rule.isMatch != nil && !rule.isMatch(text[loc[0]:loc[1]]) {
+				continue
+			}
+			matched = true
+			if rule.action == apimodels.ModerationRedact {
+				redactions = append(redactions, redaction{loc[0], loc[1], rule.category})
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		result.Matches = append(result.Matches, apimodels.ModerationMatch{Category: rule.category, Rule: rule.name, Action: rule.action})
+		if rule.action.IsStricterThan(result.Action) {
+			result.Action = rule.action
+		}
+	}
+
+	if result.Action != apimodels.ModerationRedact {
+		return result
+	}
+
+	// Overlapping matches are redacted together, as the first one, or the longest if they start together.
+	slices.SortFunc(redactions, func(a, b redaction) int { return cmp.Or(a.start-b.start, b.end-a.end) })
+	var redacted strings.Builder
+	lastEnd := 0
+	for _, r := range redactions {
+		if r.start < lastEnd {
+			lastEnd = max(lastEnd, r.end)
+			continue
+		}
+		redacted.WriteString(text[lastEnd:r.start])
+		redacted.WriteString("[REDACTED_" + strings.ToUpper(r.category) + "]")
+		lastEnd = r.end
+	}
+	redacted.WriteString(text[lastEnd:])
+	result.Text = redacted.String()
+
+	return result
+}
+
+func (m *moderator) ModerationEnabled() bool {
+	return m.enabled
+}
+
+func (m *moderator) ModeratesWithProvider() bool {
+	return m.withProvider
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+// Keywords match whole words, but \b only works next to letters or digits.
+func wordBoundary(keyword string, atStart bool) string {
+	r := []rune(keyword)
+	edge := r[len(r)-1]
+	if atStart {
+		edge = r[0]
+	}
+	if unicode.IsLetter(edge) || unicode.IsDigit(edge) || edge == '_' {
+		return `\b`
+	}
+	return ""
+}
+
+// Card numbers have a check digit, which most other long numbers don't pass.
+func passesLuhnCheck(number string) bool {
+	sum, digits := 0, 0
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if digits%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		digits++
+	}
+	return digits >= 13 && sum%10 == 0
+}
@@ -0,0 +1,790 @@
+package tools
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*     - Tools: PDF Text Extraction -  */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Just enough of a PDF reader to get the text out of most PDFs, without pulling a whole PDF library.
+//
+// It doesn't use the xref table: it finds every `N G obj` on the file, plus the ones packed on object streams,
+// then walks the page tree and runs each page's content streams, keeping what the text operators show.
+// Fonts with a ToUnicode CMap are decoded through it, the rest as single byte Latin-1 text.
+//
+// Only FlateDecode streams are supported, and encrypted PDFs aren't. Scanned PDFs have no text, only images.
+type pdfReader struct {
+	data    []byte
+	objects map[int]any
+	cmaps   map[int]*pdfCMap // By the ToUnicode stream's object number.
+
+	// What streams can still expand to, all of them together. Once it runs out, tooBig is set and the extraction fails.
+	decodeBudget int
+	tooBig       error
+}
+
+type (
+	pdfName    string
+	pdfKeyword string
+	pdfString  []byte
+	pdfDict    map[pdfName]any
+	pdfRef     struct{ num, gen int }
+	pdfStream  struct {
+		dict pdfDict
+		raw  []byte
+	}
+)
+
+// Returns the text of each page, in order. Fails if the streams expand to more than maxDecodedSize bytes.
+func extractPDFText(data []byte, maxDecodedSize int) ([]string, error) {
+	if !bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte("%PDF-")) {
+		return nil, fmt.Errorf("the document isn't a PDF")
+	}
+
+	if pdfEncrypted.Match(data) {
+		return nil, fmt.Errorf("encrypted PDFs aren't supported")
+	}
+
+	r := &pdfReader{data: data, objects: map[int]any{}, cmaps: map[int]*pdfCMap{}, decodeBudget: maxDecodedSize}
+	r.readObjects()
+	if r.tooBig != nil {
+		return nil, r.tooBig
+	}
+
+	var pages []string
+	for _, page := range r.pages() {
+		pages = append(pages, r.pageText(page))
+	}
+
+	// Without a page tree we can still read every content stream we find, all as a single page.
+	if len(pages) == 0 {
+		var text strings.Builder
+		for _, obj := range r.objects {
+			if stream, ok := obj.(*pdfStream); ok {
+				if content, err := r.decodeStream(stream); err == nil && bytes.Contains(content, []byte("BT")) {
+					text.WriteString(r.contentText(content, nil, 0))
+					text.WriteString("\n")
+				}
+			}
+		}
+		pages = append(pages, text.String())
+	}
+
+	if r.tooBig != nil {
+		return nil, r.tooBig
+	}
+	return pages, nil
+}
+
+/* -~-~-~- Objects -~-~-~- */
+
+var pdfEncrypted = regexp.MustCompile(`/Encrypt\s*(\d+\s+\d+\s+R|<<)`)
+
+var pdfObjHeader = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+
+// Finds all the objects on the file. When the same object is defined twice, like on incremental updates, the last one wins.
+func (r *pdfReader) readObjects() {
+	var objStreams []*pdfStream
+
+	lastEnd := 0
+	for _, match := range pdfObjHeader.FindAllSubmatchIndex(r.data, -1) {
+		if match[0] < lastEnd {
+			continue // It's inside the stream we just read.
+		}
+		num, _ := strconv.Atoi(string(r.data[match[2]:match[3]]))
+
+		lx := &pdfLexer{data: r.data, pos: match[1]}
+		obj := lx.readValue(lx.next())
+
+		if dict, ok := obj.(pdfDict); ok && lx.peekKeyword("stream") {
+			stream := &pdfStream{dict: dict, raw: r.readStreamData(dict, lx)}
+			if dict["Type"] == pdfName("ObjStm") {
+				objStreams = append(objStreams, stream)
+			}
+			obj = stream
+		}
+
+		r.objects[num] = obj
+		lastEnd = lx.pos
+	}
+
+	for _, stream := range objStreams {
+		r.readObjectStream(stream)
+	}
+}
+
+// The lexer is right before the `stream` keyword. Trusts the stream's Length if it's right, or looks for `endstream`.
+func (r *pdfReader) readStreamData(dict pdfDict, lx *pdfLexer) []byte {
+	lx.skipSpace()
+	start := lx.pos + len("stream")
+	if start < len(r.data) && r.data[start] == '\r' {
+		start++
+	}
+	if start < len(r.data) && r.data[start] == '\n' {
+		start++
+	}
+
+	// Checked before converting it, as anything can be written there, like 1e300.
+	length, ok := dict["Length"].(float64)
+	if ok && length == float64(int(length)) && length >= 0 && length <= float64(len(r.data)-start) {
+		end := start + int(length)
+		if after := bytes.TrimLeft(r.data[end:], " \t\r\n"); bytes.HasPrefix(after, []byte("endstream")) {
+			lx.pos = len(r.data) - len(after) + len("endstream")
+			return r.data[start:end]
+		}
+	}
+
+	end := bytes.Index(r.data[start:], []byte("endstream"))
+	if end < 0 {
+		lx.pos = len(r.data)
+		return r.data[start:]
+	}
+	lx.pos = start + end + len("endstream")
+	return bytes.TrimRight(r.data[start:start+end], "\r\n")
+}
+
+// Object streams pack many objects together, compressed. They start with pairs of object numbers and offsets.
+func (r *pdfReader) readObjectStream(stream *pdfStream) {
+	content, err := r.decodeStream(stream)
+	if err != nil {
+		return
+	}
+	count, _ := stream.dict["N"].(float64)
+	first, _ := stream.dict["First"].(float64)
+	if first < 0 || first > float64(len(content)) {
+		return
+	}
+
+	header := &pdfLexer{data: content[:int(first)]}
+	for range int(count) {
+		num, ok1 := header.next().(float64)
+		offset, ok2 := header.next().(float64)
+		if !ok1 || !ok2 {
+			return
+		}
+		if _, defined := r.objects[int(num)]; defined {
+			continue
+		}
+		if offset < 0 || offset >= float64(len(content)) {
+			continue
+		}
+		if pos := int(first) + int(offset); pos < len(content) {
+			lx := &pdfLexer{data: content, pos: pos}
+			r.objects[int(num)] = lx.readValue(lx.next())
+		}
+	}
+}
+
+// Follows references until getting to an actual value.
+func (r *pdfReader) resolve(obj any) any {
+	for range 16 {
+		ref, ok := obj.(pdfRef)
+		if !ok {
+			return obj
+		}
+		obj = r.objects[ref.num]
+	}
+	return nil
+}
+
+func (r *pdfReader) resolveDict(obj any) pdfDict {
+	switch v := r.resolve(obj).(type) {
+	case pdfDict:
+		return v
+	case *pdfStream:
+		return v.dict
+	}
+	return nil
+}
+
+func (r *pdfReader) decodeStream(stream *pdfStream) ([]byte, error) {
+	if r.tooBig != nil {
+		return nil, r.tooBig
+	}
+
+	var filters []any
+	switch filter := r.resolve(stream.dict["Filter"]).(type) {
+	case pdfName:
+		filters = []any{filter}
+	case []any:
+		filters = filter
+	}
+
+	content := stream.raw
+	for _, filter := range filters {
+		switch r.resolve(filter) {
+		case pdfName("FlateDecode"), pdfName("Fl"):
+			zr, err := zlib.NewReader(bytes.NewReader(content))
+			if err != nil {
+				return nil, err
+			}
+			// Some writers leave the zlib checksum out or wrong, what we could read is still good.
+			decoded, err := io.ReadAll(io.LimitReader(zr, int64(r.decodeBudget)+1))
+			if len(decoded) > r.decodeBudget {
+				r.decodeBudget = 0
+				r.tooBig = fmt.Errorf("the PDF expands to too much data")
+				return nil, r.tooBig
+			}
+			if err != nil && len(decoded) == 0 {
+				return nil, err
+			}
+			r.decodeBudget -= len(decoded)
+			content = decoded
+		default:
+			return nil, fmt.Errorf("unsupported pdf filter %v", filter)
+		}
+	}
+	return content, nil
+}
+
+/* -~-~-~- Pages -~-~-~- */
+
+type pdfPage struct {
+	dict      pdfDict
+	resources pdfDict // Pages can inherit them from their parents.
+}
+
+func (r *pdfReader) pages() []pdfPage {
+	var catalog pdfDict
+	for _, obj := range r.objects {
+		if dict := r.resolveDict(obj); dict != nil && dict["Type"] == pdfName("Catalog") && dict["Pages"] != nil {
+			catalog = dict
+			break
+		}
+	}
+	if catalog == nil {
+		return nil
+	}
+
+	var pages []pdfPage
+	visited := map[int]bool{}
+
+	var walk func(node any, resources pdfDict, depth int)
+	walk = func(node any, resources pdfDict, depth int) {
+		if ref, ok := node.(pdfRef); ok {
+			if visited[ref.num] {
+				return
+			}
+			visited[ref.num] = true
+		}
+		dict := r.resolveDict(node)
+		if dict == nil || depth > 64 {
+			return
+		}
+		if own := r.resolveDict(dict["Resources"]); own != nil {
+			resources = own
+		}
+
+		kids, isTree := r.resolve(dict["Kids"]).([]any)
+		if !isTree {
+			pages = append(pages, pdfPage{dict, resources})
+			return
+		}
+		for _, kid := range kids {
+			walk(kid, resources, depth+1)
+		}
+	}
+	walk(catalog["Pages"], nil, 0)
+
+	return pages
+}
+
+func (r *pdfReader) pageText(page pdfPage) string {
+	var contents []any
+	switch v := r.resolve(page.dict["Contents"]).(type) {
+	case *pdfStream:
+		contents = []any{v}
+	case []any:
+		contents = v
+	}
+
+	var content []byte
+	for _, c := range contents {
+		if stream, ok := r.resolve(c).(*pdfStream); ok {
+			if decoded, err := r.decodeStream(stream); err == nil {
+				content = append(append(content, decoded...), '\n')
+			}
+		}
+	}
+	return r.contentText(content, page.resources, 0)
+}
+
+/* -~-~-~- Content Streams -~-~-~- */
+
+// Runs a content stream's operators, writing what the text ones show.
+// Moving to another line writes a newline, and moving right or leaving a big gap writes a space.
+// Form XObjects are run too, as they can have text in them.
+func (r *pdfReader) contentText(content []byte, resources pdfDict, depth int) string {
+	var text strings.Builder
+	writeBreak := func(brk byte) {
+		s := text.String()
+		if len(s) == 0 || s[len(s)-1] == '\n' || (brk == ' ' && s[len(s)-1] == ' ') {
+			return
+		}
+		text.WriteByte(brk)
+	}
+
+	var cmap *pdfCMap
+	show := func(obj any) {
+		if s, ok := obj.(pdfString); ok {
+			text.WriteString(cmap.decode(s))
+		}
+	}
+
+	lastY := 0.0
+	lx := &pdfLexer{data: content}
+	var operands []any
+	for {
+		tok := lx.next()
+		if tok == nil {
+			break
+		}
+		op, isOp := isPDFOperator(tok)
+		if !isOp {
+			operands = append(operands, lx.readValue(tok))
+			continue
+		}
+
+		switch op {
+		case "Tf":
+			if len(operands) >= 1 {
+				if name, ok := operands[0].(pdfName); ok {
+					cmap = r.fontCMap(resources, name)
+				}
+			}
+		case "Tj":
+			if len(operands) >= 1 {
+				show(operands[len(operands)-1])
+			}
+		case "TJ":
+			if len(operands) >= 1 {
+				parts, _ := operands[len(operands)-1].([]any)
+				for _, part := range parts {
+					if gap, ok := part.(float64); ok && gap < -180 {
+						writeBreak(' ')
+					}
+					show(part)
+				}
+			}
+		case "'", "\"":
+			writeBreak('\n')
+			if len(operands) >= 1 {
+				show(operands[len(operands)-1])
+			}
+		case "Td", "TD":
+			if len(operands) >= 2 {
+				if ty, _ := operands[1].(float64); ty != 0 {
+					writeBreak('\n')
+				} else if tx, _ := operands[0].(float64); tx > 0 {
+					writeBreak(' ')
+				}
+			}
+		case "T*":
+			writeBreak('\n')
+		case "Tm":
+			if len(operands) >= 6 {
+				if y, _ := operands[5].(float64); y != lastY {
+					lastY = y
+					writeBreak('\n')
+				} else {
+					writeBreak(' ')
+				}
+			}
+		case "ET":
+			writeBreak(' ')
+		case "Do":
+			if len(operands) >= 1 && depth < 4 {
+				if name, ok := operands[0].(pdfName); ok {
+					text.WriteString(r.xObjectText(resources, name, depth))
+				}
+			}
+		case "ID":
+			lx.skipInlineImage()
+		}
+		operands = operands[:0]
+	}
+
+	return text.String()
+}
+
+func (r *pdfReader) xObjectText(resources pdfDict, name pdfName, depth int) string {
+	xObjects := r.resolveDict(resources["XObject"])
+	stream, ok := r.resolve(xObjects[name]).(*pdfStream)
+	if !ok || stream.dict["Subtype"] != pdfName("Form") {
+		return ""
+	}
+	content, err := r.decodeStream(stream)
+	if err != nil {
+		return ""
+	}
+	if own := r.resolveDict(stream.dict["Resources"]); own != nil {
+		resources = own
+	}
+	return r.contentText(content, resources, depth+1) + "\n"
+}
+
+// Returns nil if the font has no ToUnicode CMap, its text is then read as Latin-1.
+func (r *pdfReader) fontCMap(resources pdfDict, name pdfName) *pdfCMap {
+	fonts := r.resolveDict(resources["Font"])
+	font := r.resolveDict(fonts[name])
+	ref, ok := font["ToUnicode"].(pdfRef)
+	if !ok {
+		return nil
+	}
+	if cmap, ok := r.cmaps[ref.num]; ok {
+		return cmap
+	}
+
+	var cmap *pdfCMap
+	if stream, ok := r.resolve(ref).(*pdfStream); ok {
+		if content, err := r.decodeStream(stream); err == nil {
+			cmap = parsePDFCMap(content)
+		}
+	}
+	r.cmaps[ref.num] = cmap
+	return cmap
+}
+
+/* -~-~-~- CMaps -~-~-~- */
+
+// Maps character codes to Unicode text, as the fonts' ToUnicode CMaps say.
+type pdfCMap struct {
+	codeLen int // In bytes.
+	chars   map[uint32]string
+}
+
+func parsePDFCMap(content []byte) *pdfCMap {
+	cmap := &pdfCMap{codeLen: 1, chars: map[uint32]string{}}
+
+	lx := &pdfLexer{data: content}
+	var operands []any
+	for {
+		tok := lx.next()
+		if tok == nil {
+			break
+		}
+		keyword, isKeyword := isPDFOperator(tok)
+		if !isKeyword {
+			operands = append(operands, lx.readValue(tok))
+			continue
+		}
+
+		switch keyword {
+		case "endcodespacerange":
+			if len(operands) >= 1 {
+				if low, ok := operands[0].(pdfString); ok && len(low) > 0 {
+					cmap.codeLen = len(low)
+				}
+			}
+		case "endbfchar":
+			for i := 0; i+1 < len(operands); i += 2 {
+				src, ok1 := operands[i].(pdfString)
+				dst, ok2 := operands[i+1].(pdfString)
+				if ok1 && ok2 {
+					cmap.chars[pdfCode(src)] = utf16BEText(dst)
+				}
+			}
+		case "endbfrange":
+			for i := 0; i+2 < len(operands); i += 3 {
+				low, ok1 := operands[i].(pdfString)
+				high, ok2 := operands[i+1].(pdfString)
+				if !ok1 || !ok2 || pdfCode(high) < pdfCode(low) || pdfCode(high)-pdfCode(low) > 0xFFFF {
+					continue
+				}
+				for code := pdfCode(low); code <= pdfCode(high); code++ {
+					offset := int(code - pdfCode(low))
+					switch dst := operands[i+2].(type) {
+					case pdfString: // Consecutive codes map to consecutive characters.
+						incremented := bytes.Clone(dst)
+						if len(incremented) > 0 {
+							incremented[len(incremented)-1] += byte(offset)
+						}
+						cmap.chars[code] = utf16BEText(incremented)
+					case []any: // Or each one is listed.
+						if offset < len(dst) {
+							if s, ok := dst[offset].(pdfString); ok {
+								cmap.chars[code] = utf16BEText(s)
+							}
+						}
+					}
+				}
+			}
+		}
+		operands = operands[:0]
+	}
+	return cmap
+}
+
+func (cmap *pdfCMap) decode(s pdfString) string {
+	if cmap == nil {
+		if bytes.HasPrefix(s, []byte{0xFE, 0xFF}) {
+			return utf16BEText(s[2:])
+		}
+		runes := make([]rune, len(s))
+		for i, b := range s {
+			runes[i] = rune(b)
+		}
+		return string(runes)
+	}
+
+	var text strings.Builder
+	for i := 0; i+cmap.codeLen <= len(s); i += cmap.codeLen {
+		if char, ok := cmap.chars[pdfCode(s[i:i+cmap.codeLen])]; ok {
+			text.WriteString(char)
+		} else if cmap.codeLen == 1 {
+			text.WriteRune(rune(s[i]))
+		}
+	}
+	return text.String()
+}
+
+func pdfCode(s pdfString) uint32 {
+	var code uint32
+	for _, b := range s {
+		code = code<<8 | uint32(b)
+	}
+	return code
+}
+
+func utf16BEText(s []byte) string {
+	units := make([]uint16, 0, len(s)/2)
+	for i := 0; i+1 < len(s); i += 2 {
+		units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+	}
+	return string(utf16.Decode(units))
+}
+
+/* -~-~-~- Lexer -~-~-~- */
+
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+func isPDFSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r' || b == '\n' || b == '\f' || b == 0
+}
+
+func isPDFDelimiter(b byte) bool {
+	return strings.IndexByte("()<>[]{}/%", b) >= 0
+}
+
+func (lx *pdfLexer) skipSpace() {
+	for lx.pos < len(lx.data) {
+		switch b := lx.data[lx.pos]; {
+		case isPDFSpace(b):
+			lx.pos++
+		case b == '%':
+			for lx.pos < len(lx.data) && lx.data[lx.pos] != '\n' && lx.data[lx.pos] != '\r' {
+				lx.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (lx *pdfLexer) peekKeyword(keyword string) bool {
+	lx.skipSpace()
+	return bytes.HasPrefix(lx.data[lx.pos:], []byte(keyword))
+}
+
+// Returns a number, name, string, keyword or one of the delimiters as a keyword, or nil at the end.
+func (lx *pdfLexer) next() any {
+	lx.skipSpace()
+	if lx.pos >= len(lx.data) {
+		return nil
+	}
+
+	switch b := lx.data[lx.pos]; b {
+	case '<':
+		if lx.pos+1 < len(lx.data) && lx.data[lx.pos+1] == '<' {
+			lx.pos += 2
+			return pdfKeyword("<<")
+		}
+		return lx.readHexString()
+	case '>':
+		if lx.pos+1 < len(lx.data) && lx.data[lx.pos+1] == '>' {
+			lx.pos += 2
+			return pdfKeyword(">>")
+		}
+		lx.pos++
+		return pdfKeyword(">")
+	case '[', ']', '{', '}', ')':
+		lx.pos++
+		return pdfKeyword(string(b))
+	case '(':
+		return lx.readLiteralString()
+	case '/':
+		lx.pos++
+		return pdfName(lx.readRegular())
+	}
+
+	token := lx.readRegular()
+	if token == "" { // A lone delimiter we don't care about.
+		lx.pos++
+		return pdfKeyword("")
+	}
+	if n, err := strconv.ParseFloat(token, 64); err == nil {
+		return n
+	}
+	return pdfKeyword(token)
+}
+
+func (lx *pdfLexer) readRegular() string {
+	start := lx.pos
+	for lx.pos < len(lx.data) && !isPDFSpace(lx.data[lx.pos]) && !isPDFDelimiter(lx.data[lx.pos]) {
+		lx.pos++
+	}
+	return string(lx.data[start:lx.pos])
+}
+
+func (lx *pdfLexer) readHexString() pdfString {
+	lx.pos++ // <
+	var digits []byte
+	for lx.pos < len(lx.data) && lx.data[lx.pos] != '>' {
+		if b := lx.data[lx.pos]; strings.IndexByte("0123456789abcdefABCDEF", b) >= 0 {
+			digits = append(digits, b)
+		}
+		lx.pos++
+	}
+	lx.pos++ // >
+
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	s := make(pdfString, len(digits)/2)
+	for i := range s {
+		n, _ := strconv.ParseUint(string(digits[2*i:2*i+2]), 16, 8)
+		s[i] = byte(n)
+	}
+	return s
+}
+
+func (lx *pdfLexer) readLiteralString() pdfString {
+	lx.pos++ // (
+	var s pdfString
+	for depth := 1; lx.pos < len(lx.data); lx.pos++ {
+		b := lx.data[lx.pos]
+		switch b {
+		case '(':
+			depth++
+		case ')':
+			if depth--; depth == 0 {
+				lx.pos++
+				return s
+			}
+		case '\\':
+			lx.pos++
+			if lx.pos >= len(lx.data) {
+				return s
+			}
+			switch e := lx.data[lx.pos]; e {
+			case 'n':
+				b = '\n'
+			case 'r':
+				b = '\r'
+			case 't':
+				b = '\t'
+			case 'b':
+				b = '\b'
+			case 'f':
+				b = '\f'
+			case '\r', '\n': // A line break that's not part of the string.
+				if e == '\r' && lx.pos+1 < len(lx.data) && lx.data[lx.pos+1] == '\n' {
+					lx.pos++
+				}
+				continue
+			default:
+				if e >= '0' && e <= '7' {
+					n := 0
+					for i := 0; i < 3 && lx.pos < len(lx.data) && lx.data[lx.pos] >= '0' && lx.data[lx.pos] <= '7'; i++ {
+						n = n*8 + int(lx.data[lx.pos]-'0')
+						lx.pos++
+					}
+					lx.pos--
+					b = byte(n)
+				} else {
+					b = e
+				}
+			}
+		}
+		s = append(s, b)
+	}
+	return s
+}
+
+// On content streams, keywords are operators, except for the ones starting arrays and dicts.
+func isPDFOperator(tok any) (pdfKeyword, bool) {
+	keyword, ok := tok.(pdfKeyword)
+	if !ok || keyword == "[" || keyword == "<<" || keyword == "true" || keyword == "false" || keyword == "null" {
+		return "", false
+	}
+	return keyword, true
+}
+
+// Turns the token into a value, reading the rest of it if it's an array, a dict or a reference.
+func (lx *pdfLexer) readValue(tok any) any {
+	switch t := tok.(type) {
+	case pdfKeyword:
+		switch t {
+		case "<<":
+			dict := pdfDict{}
+			for {
+				key := lx.next()
+				if key == nil || key == pdfKeyword(">>") {
+					return dict
+				}
+				name, ok := key.(pdfName)
+				if !ok {
+					continue
+				}
+				dict[name] = lx.readValue(lx.next())
+			}
+		case "[":
+			var array []any
+			for {
+				item := lx.next()
+				if item == nil || item == pdfKeyword("]") {
+					return array
+				}
+				array = append(array, lx.readValue(item))
+			}
+		case "true", "false":
+			return t == "true"
+		case "null":
+			return nil
+		}
+	case float64:
+		// Might be the start of a reference: `12 0 R`.
+		pos := lx.pos
+		if gen, ok := lx.next().(float64); ok {
+			if lx.next() == pdfKeyword("R") {
+				return pdfRef{int(t), int(gen)}
+			}
+		}
+		lx.pos = pos
+	}
+	return tok
+}
+
+// Inline images are raw bytes between `ID` and `EI`, which we skip.
+func (lx *pdfLexer) skipInlineImage() {
+	end := pdfInlineImageEnd.FindIndex(lx.data[lx.pos:])
+	if end == nil {
+		lx.pos = len(lx.data)
+		return
+	}
+	lx.pos += end[1]
+}
+
+var pdfInlineImageEnd = regexp.MustCompile(`\sEI(\s|$)`)
@@ -0,0 +1,41 @@
+package tools
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+)
+
+var _ core.SecretGenerator = &secretGenerator{}
+
+type secretGenerator struct {
+	size int
+}
+
+// Returns a generator of random secrets of size bytes, like the tokens of group invite links.
+func NewSecretGenerator(size int) core.SecretGenerator {
+	return &secretGenerator{size}
+}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Returns a new random URL-safe secret and its hash.
+// Only the hash should be stored, the plain secret is given once to the user.
+func (sg *secretGenerator) GenerateSecret() (string, string, error) {
+	b := make([]byte, sg.size)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	secret := base64.RawURLEncoding.EncodeToString(b)
+	return secret, sg.HashSecret(secret), nil
+}
+
+// Returns the hex encoded sha256 hash of the secret.
+// Secrets are random enough to not need a salt.
+func (sg *secretGenerator) HashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
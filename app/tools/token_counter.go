@@ -0,0 +1,58 @@
+package tools
+
+import (
+	"math"
+	"unicode"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+)
+
+var _ core.TokenCounter = &tokenCounter{}
+
+// We don't ship the models' actual tokenizers, so this approximates them. It's meant to err on the high side.
+//
+// Like BPE tokenizers, it counts words as a token every few characters depending on the model,
+// and symbols and non-latin letters as a token each. Each message adds a few tokens for its role and separators.
+type tokenCounter struct{}
+
+func NewTokenCounter() core.TokenCounter {
+	return &tokenCounter{}
+}
+
+const tokensPerMessage = 4
+
+func (tc *tokenCounter) CountTokens(model apimodels.GPTs, text string) int {
+	charsPerToken := apimodels.GetLLMModelInfo(model).CharsPerToken
+
+	tokens, wordLen := 0, 0
+	endWord := func() {
+		if wordLen > 0 {
+			tokens += int(math.Ceil(float64(wordLen) / charsPerToken))
+			wordLen = 0
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			wordLen++
+		case unicode.IsSpace(r):
+			endWord()
+		default:
+			endWord()
+			tokens++
+		}
+	}
+	endWord()
+
+	return tokens
+}
+
+func (tc *tokenCounter) CountChatTokens(model apimodels.GPTs, msgs ...apimodels.GPTChatMsg) int {
+	tokens := 0
+	for _, msg := range msgs {
+		tokens += tc.CountTokens(model, msg.Content) + tokensPerMessage
+	}
+	return tokens
+}
@@ -22,18 +22,24 @@ var _ core.Tools = &Tools{}
 type Tools struct {
 	core.TLSManager          // -> Holds and retrieves data for TLS communication.
 	core.ContextManager      // -> Manages context.
+	core.DocumentReader      // -> Gets the text out of documents and chunks it.
 	core.FileManager         // -> Creates folders and files.
 	core.FileDownloader      // -> Downloads files.
 	core.IDGenerator[string] // -> Generates unique IDs.
 	core.ImageLoader         // -> Loads images from different sources.
+	core.MediaStore          // -> Stores files attached to messages.
 	core.ModelConverter      // -> Converts between models and PBs.
+	core.Moderator           // -> Blocks or redacts prompts and answers.
 	core.PwdHasher           // -> Hashes and compares passwords.
 	core.RateLimiter         // -> Limits rate of requests.
 	core.RequestPaginator    // -> Helps handling GRPC requests with pagination.
 	core.RequestValidator    // -> Validates GRPC requests.
+	core.SecretGenerator     // -> Generates and hashes random secrets.
 	core.ShutdownJanitor     // -> Cleans up and frees resources on application shutdown.
+	core.TokenCounter        // -> Estimates how many tokens texts take on LLMs.
 	core.TokenGenerator      // -> Generates JWT Tokens.
 	core.TokenValidator      // -> Validates JWT Tokens.
+	core.VectorIndex         // -> Saves and searches the documents' vectors.
 }
 
 func Setup(cfg *core.Config) *Tools {
@@ -55,12 +61,21 @@ func Setup(cfg *core.Config) *Tools {
 	tools.FileManager = NewFileManager("etc/data/")
 	tools.FileDownloader = NewFileDownloader(&http.Client{Timeout: 0})
 	tools.ImageLoader = NewImageLoader()
+	tools.MediaStore = NewMediaStore("etc/downloads/media/")
+	tools.TokenCounter = NewTokenCounter()
+	tools.DocumentReader = NewDocumentReader(&cfg.DocumentsCfg)
+	tools.VectorIndex = NewVectorIndex(cfg.DocumentsCfg.IndexDir)
 	tools.IDGenerator = NewIDGenerator(GenerateCustomUUID)
 	tools.PwdHasher = NewPwdHasher(cfg.PwdHasherCfg.Salt)
+	tools.SecretGenerator = NewSecretGenerator(24)
 	tools.RateLimiter = NewRateLimiter(&cfg.RLimiterCfg)
 	tools.ModelConverter = NewModelConverter()
 	tools.ShutdownJanitor = NewShutdownJanitor()
 
+	var err error
+	tools.Moderator, err = NewModerator(&cfg.ModerationCfg)
+	logs.LogFatalIfErr(err)
+
 	logs.InitModuleOK("Tools", "🛠️ ")
 	return &tools
 }
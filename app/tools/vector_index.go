@@ -0,0 +1,214 @@
+package tools
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+)
+
+var _ core.VectorIndex = &vectorIndex{}
+
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+/*       - Tools: Vector Index -       */
+/* -~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~-~- */
+
+// Keeps each collection's vectors on its own gob file, and in memory once it's been read.
+// Searches compare the vector against all of the collection's, which is fine for the few
+// thousand chunks a collection usually has.
+//
+// All vectors on a collection must have the same length, so changing the embeddings model
+// means uploading its documents again.
+type vectorIndex struct {
+	folder      string
+	mu          sync.Mutex
+	collections map[int]*vectorCollection
+}
+
+// What's saved on each collection's file. Norms are calculated when loading it.
+type vectorCollection struct {
+	Dims    int
+	Entries []apimodels.VectorEntry
+	norms   []float64
+}
+
+func NewVectorIndex(folder string) core.VectorIndex {
+	return &vectorIndex{folder: folder, collections: map[int]*vectorCollection{}}
+}
+
+func (vi *vectorIndex) AddVectors(collectionID int, entries []apimodels.VectorEntry) error {
+	vi.mu.Lock()
+	defer vi.mu.Unlock()
+
+	collection, err := vi.load(collectionID)
+	if err != nil {
+		return err
+	}
+
+	updated := &vectorCollection{Dims: collection.Dims, Entries: slices.Clone(collection.Entries), norms: slices.Clone(collection.norms)}
+	for _, entry := range entries {
+		if updated.Dims == 0 {
+			updated.Dims = len(entry.Vector)
+		}
+		if len(entry.Vector) != updated.Dims {
+			return fmt.Errorf("vector for chunk %d has %d dimensions, collection %d has %d", entry.ChunkID, len(entry.Vector), collectionID, updated.Dims)
+		}
+		updated.Entries = append(updated.Entries, entry)
+		updated.norms = append(updated.norms, vectorNorm(entry.Vector))
+	}
+
+	return vi.save(collectionID, updated)
+}
+
+// Returns the topK closest chunks to the vector, closest first. Chunks with the same score keep their order.
+func (vi *vectorIndex) SearchVectors(collectionID int, vector []float32, topK int) ([]apimodels.VectorMatch, error) {
+	vi.mu.Lock()
+	defer vi.mu.Unlock()
+
+	collection, err := vi.load(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(collection.Entries) == 0 || topK <= 0 {
+		return nil, nil
+	}
+	if len(vector) != collection.Dims {
+		return nil, fmt.Errorf("searched vector has %d dimensions, collection %d has %d", len(vector), collectionID, collection.Dims)
+	}
+
+	norm := vectorNorm(vector)
+	matches := make([]apimodels.VectorMatch, len(collection.Entries))
+	for i, entry := range collection.Entries {
+		var dot float64
+		for j, v := range entry.Vector {
+			dot += float64(v) * float64(vector[j])
+		}
+		score := 0.0
+		if norm > 0 && collection.norms[i] > 0 {
+			score = dot / (norm * collection.norms[i])
+		}
+		matches[i] = apimodels.VectorMatch{ChunkID: entry.ChunkID, DocumentID: entry.DocumentID, Score: float32(score)}
+	}
+
+	slices.SortStableFunc(matches, func(a, b apimodels.VectorMatch) int {
+		switch {
+		case a.Score > b.Score:
+			return -1
+		case a.Score < b.Score:
+			return 1
+		}
+		return 0
+	})
+	return matches[:min(topK, len(matches))], nil
+}
+
+func (vi *vectorIndex) RemoveDocumentVectors(collectionID, documentID int) error {
+	vi.mu.Lock()
+	defer vi.mu.Unlock()
+
+	collection, err := vi.load(collectionID)
+	if err != nil {
+		return err
+	}
+
+	updated := &vectorCollection{Dims: collection.Dims}
+	for i, entry := range collection.Entries {
+		if entry.DocumentID != documentID {
+			updated.Entries = append(updated.Entries, entry)
+			updated.norms = append(updated.norms, collection.norms[i])
+		}
+	}
+	if len(updated.Entries) == 0 {
+		updated.Dims = 0 // Now it can take vectors of any length.
+	}
+
+	return vi.save(collectionID, updated)
+}
+
+func (vi *vectorIndex) DeleteVectorIndex(collectionID int) error {
+	vi.mu.Lock()
+	defer vi.mu.Unlock()
+
+	delete(vi.collections, collectionID)
+	if err := os.Remove(vi.path(collectionID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error deleting vector index of collection %d: %w", collectionID, err)
+	}
+	return nil
+}
+
+/* -~-~-~- Helpers -~-~-~- */
+
+func (vi *vectorIndex) path(collectionID int) string {
+	return filepath.Join(vi.folder, fmt.Sprintf("collection_%d.gob", collectionID))
+}
+
+// A collection without a file is just empty.
+func (vi *vectorIndex) load(collectionID int) (*vectorCollection, error) {
+	if collection, ok := vi.collections[collectionID]; ok {
+		return collection, nil
+	}
+
+	collection := &vectorCollection{}
+	file, err := os.Open(vi.path(collectionID))
+	if errors.Is(err, os.ErrNotExist) {
+		vi.collections[collectionID] = collection
+		return collection, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening vector index of collection %d: %w", collectionID, err)
+	}
+	defer file.Close()
+
+	if err := gob.NewDecoder(file).Decode(collection); err != nil {
+		return nil, fmt.Errorf("error reading vector index of collection %d: %w", collectionID, err)
+	}
+	for _, entry := range collection.Entries {
+		collection.norms = append(collection.norms, vectorNorm(entry.Vector))
+	}
+
+	vi.collections[collectionID] = collection
+	return collection, nil
+}
+
+// Written to a temp file first, so a half written index is never read.
+// The cached collection is only replaced once it's on disk.
+func (vi *vectorIndex) save(collectionID int, collection *vectorCollection) error {
+	if err := os.MkdirAll(vi.folder, os.ModePerm); err != nil {
+		return fmt.Errorf("error creating vector index folder %s: %w", vi.folder, err)
+	}
+
+	path := vi.path(collectionID)
+	tmpPath := path + ".tmp"
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("error saving vector index to %s: %w", tmpPath, err)
+	}
+	if err := gob.NewEncoder(file).Encode(collection); err != nil {
+		file.Close()
+		return fmt.Errorf("error saving vector index to %s: %w", tmpPath, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error saving vector index to %s: %w", tmpPath, err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("error saving vector index to %s: %w", path, err)
+	}
+
+	vi.collections[collectionID] = collection
+	return nil
+}
+
+func vectorNorm(vector []float32) float64 {
+	var sum float64
+	for _, v := range vector {
+		sum += float64(v) * float64(v)
+	}
+	return math.Sqrt(sum)
+}
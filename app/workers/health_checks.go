@@ -0,0 +1,27 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/service"
+)
+
+// ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— —> Health Checks Worker
+
+// Checks our dependencies right away and then every cfg.CheckInterval, so the health probes have fresh results to answer with.
+// The interval starts counting when a round of checks ends, so slow ones don't pile up.
+func RunHealthChecks(ctx context.Context, service *service.Service, cfg *core.HealthCfg) {
+	logs.InitModuleOK("Health Checks Worker", "🩺")
+	for {
+		service.RunHealthChecks(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(cfg.CheckInterval):
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/logs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/service"
+)
+
+// ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— ——— —> Image Jobs Workers
+
+// Runs the images queued by async NewGPTImage requests. There are cfg.Workers of them, each running one job
+// at a time, so that's also how many images we ask DALL-E for at once. Idle workers look for jobs every cfg.PollInterval.
+//
+// Jobs left running by a previous run are queued again first.
+func RunImageJobWorkers(ctx context.Context, service *service.Service, cfg *core.ImageJobsCfg) {
+	if err := service.RequeueImageJobs(ctx); err != nil {
+		logs.LogUnexpected(err)
+	}
+
+	for i := 0; i < cfg.Workers; i++ {
+		go runImageJobWorker(ctx, service, cfg)
+	}
+	logs.InitModuleOK("Image Jobs Workers", "🧑‍🏭")
+}
+
+func runImageJobWorker(ctx context.Context, service *service.Service, cfg *core.ImageJobsCfg) {
+	for {
+		job, err := service.ClaimImageJob(ctx)
+		if err != nil {
+			logs.LogUnexpected(err)
+		}
+		if job != nil {
+			service.RunImageJob(ctx, job, cfg)
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(cfg.PollInterval):
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/clients"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTokensBudget(t *testing.T, testClients *clients.Clients, groupID, userID int, limit, used int64, period string) *models.UsageBudget {
+	budget := &models.UsageBudget{GroupID: groupID, UserID: userID, Unit: models.BudgetTokens, MonthlyLimit: limit, Used: used, Period: period}
+	assert.NoError(t, testClients.GroupRepository().SaveUsageBudget(context.Background(), budget))
+	return budget
+}
+
+func usedOf(t *testing.T, testClients *clients.Clients, budget *models.UsageBudget) (int64, string) {
+	stored, err := testClients.GroupRepository().GetUsageBudget(context.Background(), budget.GroupID, budget.ID)
+	assert.NoError(t, err)
+	return stored.Used, stored.Period
+}
+
+// Returns the ErrorInfo's metadata, and how many QuotaFailure violations there are.
+func budgetExhaustedDetails(t *testing.T, err error) (map[string]string, int) {
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	metadata, violations := map[string]string{}, 0
+	for _, detail := range status.Convert(err).Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			assert.Equal(t, "BUDGET_EXHAUSTED", detail.Reason)
+			metadata = detail.Metadata
+		case *errdetails.QuotaFailure:
+			violations = len(detail.Violations)
+		}
+	}
+	return metadata, violations
+}
+
+func TestGroupAndMemberBudgets(t *testing.T) {
+	svc, testClients, testTools := newTestService(t)
+	owner, alice, bob := newTestUser(t, testClients, "owner"), newTestUser(t, testClients, "alice"), newTestUser(t, testClients, "bob")
+	groupID := newTestGroup(t, testClients, owner, map[int]models.GroupRole{alice: models.GroupMemberRole, bob: models.GroupMemberRole})
+	groupID32 := int32(groupID)
+	period := models.BudgetPeriod(time.Now())
+
+	groupBudget := newTokensBudget(t, testClients, groupID, 0, 1_000_000, 0, period)
+	aliceBudget := newTokensBudget(t, testClients, groupID, alice, 1_000_000, 0, period)
+
+	// Alice's call counts against both the group's budget and her own. Bob's only against the group's.
+	_, err := svc.NewGPTChat(userCtx(testTools, alice), &pbs.NewGPTChatRequest{Message: "Hi there", GroupId: &groupID32})
+	assert.NoError(t, err)
+	groupUsed, _ := usedOf(t, testClients, groupBudget)
+	aliceUsed, _ := usedOf(t, testClients, aliceBudget)
+	assert.Positive(t, groupUsed)
+	assert.Equal(t, groupUsed, aliceUsed)
+
+	_, err = svc.NewGPTChat(userCtx(testTools, bob), &pbs.NewGPTChatRequest{Message: "Hi there", GroupId: &groupID32})
+	assert.NoError(t, err)
+	groupUsedAfterBob, _ := usedOf(t, testClients, groupBudget)
+	aliceUsedAfterBob, _ := usedOf(t, testClients, aliceBudget)
+	assert.Greater(t, groupUsedAfterBob, groupUsed)
+	assert.Equal(t, aliceUsed, aliceUsedAfterBob)
+
+	// Once Alice's own budget is spent, she's stopped, but Bob isn't.
+	aliceBudget.Used = aliceBudget.MonthlyLimit
+	assert.NoError(t, testClients.GroupRepository().SaveUsageBudget(context.Background(), aliceBudget))
+
+	_, err = svc.NewGPTChat(userCtx(testTools, alice), &pbs.NewGPTChatRequest{Message: "Hi again", GroupId: &groupID32})
+	metadata, violations := budgetExhaustedDetails(t, err)
+	assert.Equal(t, "tokens", metadata["unit"])
+	assert.Equal(t, "0", metadata["remaining"])
+	assert.Equal(t, models.BudgetResetsAt(time.Now()).Format(time.RFC3339), metadata["resets_at"])
+	assert.Equal(t, 2, violations)
+
+	_, err = svc.NewGPTChat(userCtx(testTools, bob), &pbs.NewGPTChatRequest{Message: "Hi again", GroupId: &groupID32})
+	assert.NoError(t, err)
+
+	// And once the group's is spent, everyone is.
+	groupBudget.Used = groupBudget.MonthlyLimit
+	assert.NoError(t, testClients.GroupRepository().SaveUsageBudget(context.Background(), groupBudget))
+
+	_, err = svc.NewGPTChat(userCtx(testTools, bob), &pbs.NewGPTChatRequest{Message: "Hi again", GroupId: &groupID32})
+	metadata, violations = budgetExhaustedDetails(t, err)
+	assert.Equal(t, "0", metadata["user_id"])
+	assert.Equal(t, 1, violations)
+}
+
+func TestBudgetsMonthlyWindow(t *testing.T) {
+	svc, testClients, testTools := newTestService(t)
+	owner := newTestUser(t, testClients, "owner")
+	groupID := newTestGroup(t, testClients, owner, nil)
+	groupID32 := int32(groupID)
+
+	// Spent, but last month.
+	lastMonth := models.BudgetPeriod(time.Now().AddDate(0, -1, 0))
+	budget := newTokensBudget(t, testClients, groupID, 0, 1_000_000, 1_000_000, lastMonth)
+
+	_, err := svc.NewGPTChat(userCtx(testTools, owner), &pbs.NewGPTChatRequest{Message: "Hi there", GroupId: &groupID32})
+	assert.NoError(t, err)
+
+	// It counts from zero on this month.
+	used, period := usedOf(t, testClients, budget)
+	assert.Equal(t, models.BudgetPeriod(time.Now()), period)
+	assert.Positive(t, used)
+	assert.Less(t, used, int64(1000))
+
+	// From then on it adds up.
+	_, err = svc.NewGPTChat(userCtx(testTools, owner), &pbs.NewGPTChatRequest{Message: "Hi there", GroupId: &groupID32})
+	assert.NoError(t, err)
+	usedAfter, _ := usedOf(t, testClients, budget)
+	assert.Equal(t, 2*used, usedAfter)
+}
+
+// Calls without a group are capped by the user's own budgets on the groups they're on, but not by group-wide ones.
+func TestBudgetsWithoutGroup(t *testing.T) {
+	svc, testClients, testTools := newTestService(t)
+	owner, alice, loner := newTestUser(t, testClients, "owner"), newTestUser(t, testClients, "alice"), newTestUser(t, testClients, "loner")
+	groupID := newTestGroup(t, testClients, owner, map[int]models.GroupRole{alice: models.GroupMemberRole})
+	period := models.BudgetPeriod(time.Now())
+
+	newTokensBudget(t, testClients, groupID, 0, 10, 10, period)
+	aliceBudget := newTokensBudget(t, testClients, groupID, alice, 1_000_000, 0, period)
+
+	_, err := svc.NewGPTChat(userCtx(testTools, owner), &pbs.NewGPTChatRequest{Message: "Hi there"})
+	assert.NoError(t, err)
+	_, err = svc.NewGPTChat(userCtx(testTools, loner), &pbs.NewGPTChatRequest{Message: "Hi there"})
+	assert.NoError(t, err)
+
+	_, err = svc.NewGPTChat(userCtx(testTools, alice), &pbs.NewGPTChatRequest{Message: "Hi there"})
+	assert.NoError(t, err)
+	used, _ := usedOf(t, testClients, aliceBudget)
+	assert.Positive(t, used)
+
+	aliceBudget.Used = aliceBudget.MonthlyLimit
+	assert.NoError(t, testClients.GroupRepository().SaveUsageBudget(context.Background(), aliceBudget))
+	_, err = svc.NewGPTChat(userCtx(testTools, alice), &pbs.NewGPTChatRequest{Message: "Hi there"})
+	metadata, _ := budgetExhaustedDetails(t, err)
+	assert.Equal(t, "0", metadata["remaining"])
+
+	// Once she leaves the group, its budgets don't apply to her anymore.
+	assert.NoError(t, testClients.GroupRepository().RemoveGroupMember(context.Background(), groupID, alice))
+	_, err = svc.NewGPTChat(userCtx(testTools, alice), &pbs.NewGPTChatRequest{Message: "Hi there"})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,204 @@
+package tests
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/tools"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/* -~-~-~-~-~    PDF Text    ~-~-~-~-~-~- */
+
+func TestExtractPDFText(t *testing.T) {
+	page := "<< /Type /Page /Parent 2 0 R /Contents 4 0 R >>"
+	pages := "<< /Type /Pages /Kids [3 0 R] /Count 1 >>"
+	catalog := "<< /Type /Catalog /Pages 2 0 R >>"
+	content := "BT /F1 12 Tf (Hello PDF) Tj ET"
+
+	objStmHeader := "2 0 3 44 "
+	objStmBody := pages + page
+	objStm := objStmHeader + objStmBody
+
+	testCases := []struct {
+		name    string
+		pdf     []byte
+		maxSize int
+		want    string
+		wantErr string
+	}{
+		{
+			name: "minimal",
+			pdf:  buildPDF(catalog, pages, page, pdfStream("", content)),
+			want: "Hello PDF",
+		},
+		{
+			name: "compressed content",
+			pdf:  buildPDF(catalog, pages, page, pdfStream("/Filter /FlateDecode", deflate(content))),
+			want: "Hello PDF",
+		},
+		{
+			name: "object stream",
+			pdf:  buildPDF(catalog, "null", "null", pdfStream("", content), pdfStream(fmt.Sprintf("/Type /ObjStm /N 2 /First %d /Filter /FlateDecode", len(objStmHeader)), deflate(objStm))),
+			want: "Hello PDF",
+		},
+		{
+			name:    "not a pdf",
+			pdf:     []byte("hello"),
+			wantErr: "isn't a PDF",
+		},
+		{
+			name:    "encrypted",
+			pdf:     append(buildPDF(catalog, pages, page, pdfStream("", content)), []byte("trailer << /Root 1 0 R /Encrypt 9 0 R >>")...),
+			wantErr: "encrypted",
+		},
+		{
+			name:    "truncated",
+			pdf:     buildPDF(catalog, pages, page, pdfStream("", content))[:60],
+			wantErr: "no text found",
+		},
+		{
+			name: "huge length",
+			pdf:  buildPDF(catalog, pages, page, "<< /Length 1e300 >>\nstream\n"+content+"\nendstream"),
+			want: "Hello PDF",
+		},
+		{
+			name: "negative length",
+			pdf:  buildPDF(catalog, pages, page, "<< /Length -5 >>\nstream\n"+content+"\nendstream"),
+			want: "Hello PDF",
+		},
+		{
+			name: "negative first on object stream",
+			pdf:  buildPDF(catalog, "null", "null", pdfStream("", content), pdfStream("/Type /ObjStm /N 2 /First -5 /Filter /FlateDecode", deflate(objStm))),
+			want: "Hello PDF", // Without the page tree, every content stream is read.
+		},
+		{
+			name: "negative offset on object stream",
+			pdf:  buildPDF(catalog, "null", "null", pdfStream("", content), pdfStream(fmt.Sprintf("/Type /ObjStm /N 2 /First %d /Filter /FlateDecode", len("7 0 8 -20 ")), deflate("7 0 8 -20 "+objStmBody))),
+			want: "Hello PDF", // Without the page tree, every content stream is read.
+		},
+		{
+			name:    "too much to decode",
+			pdf:     buildPDF(catalog, pages, page, pdfStream("/Filter /FlateDecode", deflate(content+strings.Repeat(" ", 1<<20)))),
+			maxSize: 1 << 16,
+			wantErr: "expands to too much data",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reader := tools.NewDocumentReader(&core.DocumentsCfg{ChunkSize: 1000, MaxDecodedSize: tc.maxSize})
+			text, err := reader.ExtractText("doc.pdf", "application/pdf", tc.pdf)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, text.Pages, 1)
+			assert.Contains(t, text.Pages[0].Text, tc.want)
+		})
+	}
+}
+
+// Each object is numbered from 1, in order.
+func buildPDF(objects ...string) []byte {
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.7\n")
+	for i, obj := range objects {
+		fmt.Fprintf(&pdf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	return pdf.Bytes()
+}
+
+func pdfStream(dict, data string) string {
+	return fmt.Sprintf("<< /Length %d %s >>\nstream\n%s\nendstream", len(data), dict, data)
+}
+
+func deflate(data string) string {
+	var out bytes.Buffer
+	zw := zlib.NewWriter(&out)
+	zw.Write([]byte(data))
+	zw.Close()
+	return out.String()
+}
+
+/* -~-~-~-~-~    Chunking    ~-~-~-~-~-~- */
+
+func TestChunkPages(t *testing.T) {
+	reader := tools.NewDocumentReader(&core.DocumentsCfg{ChunkSize: 100, ChunkOverlap: 30})
+
+	testCases := []struct {
+		name string
+		text string
+	}{
+		{"words", strings.Repeat("lorem ipsum dolor sit amet ", 40)},
+		{"multibyte runes", strings.Repeat("añejo 東京 ünïcödé ", 50)},
+		{"no spaces", strings.Repeat("ñ", 450)},
+		{"paragraphs", strings.Repeat("A short paragraph here.\n\n", 30)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chunks := reader.ChunkPages([]apimodels.DocumentPage{{Number: 1, Text: tc.text}})
+			assert.Greater(t, len(chunks), 1)
+
+			for i, chunk := range chunks {
+				assert.Equal(t, i, chunk.Index)
+				assert.NotEmpty(t, strings.TrimSpace(chunk.Content))
+				assert.True(t, utf8.ValidString(chunk.Content))
+				assert.LessOrEqual(t, utf8.RuneCountInString(chunk.Content), 100)
+			}
+
+			// Each chunk repeats the end of the one before, unless there was no space to cut it on.
+			if strings.Contains(tc.text, " ") {
+				for i := 1; i < len(chunks); i++ {
+					prevWords := strings.Fields(chunks[i-1].Content)
+					assert.True(t, strings.HasPrefix(chunks[i].Content, prevWords[len(prevWords)-1]) ||
+						strings.Contains(chunks[i-1].Content, strings.Fields(chunks[i].Content)[0]))
+				}
+			}
+		})
+	}
+
+	assert.Empty(t, reader.ChunkPages([]apimodels.DocumentPage{{Number: 1, Text: "   \n\n  "}}))
+}
+
+/* -~-~-~-~-~    Vector Index    ~-~-~-~-~-~- */
+
+func TestVectorIndex(t *testing.T) {
+	index := tools.NewVectorIndex(t.TempDir())
+
+	assert.NoError(t, index.AddVectors(1, []apimodels.VectorEntry{
+		{ChunkID: 1, DocumentID: 10, Vector: []float32{1, 0, 0}},
+		{ChunkID: 2, DocumentID: 10, Vector: []float32{0, 1, 0}},
+	}))
+
+	// Vectors of other lengths are rejected, both to add and to search.
+	assert.ErrorContains(t, index.AddVectors(1, []apimodels.VectorEntry{{ChunkID: 3, DocumentID: 11, Vector: []float32{1, 0}}}), "dimensions")
+	_, err := index.SearchVectors(1, []float32{1, 0}, 5)
+	assert.ErrorContains(t, err, "dimensions")
+
+	matches, err := index.SearchVectors(1, []float32{0.9, 0.1, 0}, 5)
+	assert.NoError(t, err)
+	assert.Len(t, matches, 2)
+	assert.Equal(t, 1, matches[0].ChunkID)
+
+	// Once it's empty, the collection takes vectors of any length again.
+	assert.NoError(t, index.RemoveDocumentVectors(1, 10))
+	matches, err = index.SearchVectors(1, []float32{1, 0, 0}, 5)
+	assert.NoError(t, err)
+	assert.Empty(t, matches)
+
+	assert.NoError(t, index.AddVectors(1, []apimodels.VectorEntry{{ChunkID: 4, DocumentID: 12, Vector: []float32{0, 1}}}))
+	matches, err = index.SearchVectors(1, []float32{0, 1}, 5)
+	assert.NoError(t, err)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, 12, matches[0].DocumentID)
+}
@@ -0,0 +1,173 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/clients/apis"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixtureTransport(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		body, _ := io.ReadAll(req.Body)
+		if req.URL.Path == "/image" {
+			rw.Header().Set("Content-Type", "image/png")
+			rw.Write([]byte{0x89, 'P', 'N', 'G', 0xff, 0xfe})
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(rw, `{"method":%q,"query":%q,"body":%q}`, req.Method, req.URL.Query().Get("q"), body)
+	}))
+
+	dir := t.TempDir()
+	recorder := apis.NewAPIHTTPClient(core.FixturesCfg{Mode: "record", Dir: dir})
+
+	type call struct {
+		method, path, body string
+	}
+	recorded := []call{
+		{http.MethodGet, "/weather?q=London&appid=first-secret", ""},
+		{http.MethodPost, "/chat", `{"model": "gpt", "messages": ["hi"]}`},
+		{http.MethodGet, "/image", ""},
+	}
+
+	answers := map[call][]byte{}
+	for _, c := range recorded {
+		status, body, err := doCall(recorder, c.method, server.URL+c.path, c.body)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, status)
+		answers[c] = body
+	}
+	assert.Equal(t, len(recorded), calls)
+
+	// One file per call, without the secrets on the query.
+	files, _ := os.ReadDir(dir)
+	assert.Len(t, files, len(recorded))
+	for _, file := range files {
+		fixtureJSON, _ := os.ReadFile(dir + "/" + file.Name())
+		assert.NotContains(t, string(fixtureJSON), "first-secret")
+	}
+
+	// From now on, every answer has to come from the fixtures.
+	server.Close()
+
+	replayer := apis.NewAPIHTTPClient(core.FixturesCfg{Mode: "replay", Dir: dir, Strict: true})
+
+	t.Run("replays", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			call     call
+			recorded call
+		}{
+			{"same call", recorded[0], recorded[0]},
+			{"other secret and query order", call{http.MethodGet, "/weather?appid=other-secret&q=London", ""}, recorded[0]},
+			{"json keys and spacing", call{http.MethodPost, "/chat", `{"messages":["hi"],"model":"gpt"}`}, recorded[1]},
+			{"binary body", recorded[2], recorded[2]},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				status, body, err := doCall(replayer, tc.call.method, server.URL+tc.call.path, tc.call.body)
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, status)
+				assert.Equal(t, answers[tc.recorded], body)
+			})
+		}
+	})
+
+	t.Run("strict fails on unmatched calls", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			call call
+		}{
+			{"method", call{http.MethodPost, "/weather?q=London&appid=first-secret", ""}},
+			{"url", call{http.MethodGet, "/weather?q=Paris&appid=first-secret", ""}},
+			{"body", call{http.MethodPost, "/chat", `{"model": "gpt", "messages": ["bye"]}`}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, _, err := doCall(replayer, tc.call.method, server.URL+tc.call.path, tc.call.body)
+				assert.ErrorContains(t, err, "no fixture for "+tc.call.method)
+			})
+		}
+	})
+
+	// Without strict, the call is really made, and here the server is gone.
+	t.Run("not strict makes unmatched calls", func(t *testing.T) {
+		lenient := apis.NewAPIHTTPClient(core.FixturesCfg{Mode: "replay", Dir: dir})
+		_, _, err := doCall(lenient, http.MethodGet, server.URL+"/weather?q=Paris", "")
+		assert.Error(t, err)
+		assert.NotContains(t, err.Error(), "no fixture")
+	})
+
+	assert.Equal(t, len(recorded), calls)
+}
+
+// Both of our APIs go through them.
+func TestFixturesOnAPIs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/moderations" {
+			rw.Write([]byte(`{"results": [{"flagged": true, "categories": {"violence": true}}]}`))
+			return
+		}
+		fmt.Fprintf(rw, `[{"name": %q, "lat": 51.5, "lon": -0.1, "country": "GB"}]`, req.URL.Query().Get("q"))
+	}))
+
+	newAPIs := func(fixtures core.FixturesCfg) *apis.APIClients {
+		return apis.NewAPIs(&core.APIsCfg{
+			Weather:  core.OpenWeatherMapAPICfg{Provider: "openweathermap", BaseURL: server.URL, AppID: "app-id"},
+			GPT:      core.ChatGptAPICfg{BaseURL: server.URL, ModerationBaseURL: server.URL},
+			Fixtures: fixtures,
+		})
+	}
+	dir := t.TempDir()
+
+	recorder := newAPIs(core.FixturesCfg{Mode: "record", Dir: dir})
+	cities, err := recorder.GeocodeCity(context.Background(), "London", 1)
+	assert.NoError(t, err)
+	moderation, err := recorder.SendToModeration(context.Background(), "some text")
+	assert.NoError(t, err)
+	server.Close()
+
+	replayer := newAPIs(core.FixturesCfg{Mode: "replay", Dir: dir, Strict: true})
+	replayedCities, err := replayer.GeocodeCity(context.Background(), "London", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, cities, replayedCities)
+	replayedModeration, err := replayer.SendToModeration(context.Background(), "some text")
+	assert.NoError(t, err)
+	assert.Equal(t, moderation, replayedModeration)
+
+	_, err = replayer.GeocodeCity(context.Background(), "Paris", 1)
+	assert.ErrorContains(t, err, "no fixture for GET")
+}
+
+func doCall(client *http.Client, method, url, body string) (int, []byte, error) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		return 0, nil, err
+	}
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	return resp.StatusCode, respBody, err
+}
@@ -0,0 +1,182 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/clients/apis/gpt"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestDefaultLLMModel(t *testing.T) {
+	api := gpt.NewAPI(http.DefaultClient, &core.APIsCfg{
+		LLMProvider: "openai",
+		GPT:         core.ChatGptAPICfg{DefaultModel: "gpt-4o"},
+		Anthropic:   core.AnthropicAPICfg{DefaultModel: "claude-3-5-sonnet-latest"},
+	})
+
+	assert.Equal(t, apimodels.GPTs("gpt-4o"), api.DefaultLLMModel(""))
+	assert.Equal(t, apimodels.GPTs("gpt-4o"), api.DefaultLLMModel(apimodels.LLMOpenAI))
+	assert.Equal(t, apimodels.GPTs("claude-3-5-sonnet-latest"), api.DefaultLLMModel(apimodels.LLMAnthropic))
+	assert.Equal(t, apimodels.FAKE_LLM, api.DefaultLLMModel(apimodels.LLMFake))
+	assert.Empty(t, api.DefaultLLMModel("nope"))
+
+	// So switching to Anthropic fits the chat in Claude's window, not in the 8k we use for unknown models.
+	assert.Greater(t, apimodels.GetLLMModelInfo(api.DefaultLLMModel(apimodels.LLMAnthropic)).ContextTokens, 8192)
+}
+
+/* -~-~-~- Response Cache -~-~-~- */
+
+// A GPT API with the cache on NewGPTChat, answering from a server that counts its calls.
+// Each answer says which call it was, so cached ones can be told apart.
+func newCachedGPTAPI(t *testing.T, ttl time.Duration, maxEntries int) (core.GPTAPI, *int) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		fmt.Fprintf(rw, `{"choices": [{"message": {"role": "assistant", "content": "answer %d"}}], "usage": {"total_tokens": 10}}`, calls)
+	}))
+	t.Cleanup(server.Close)
+
+	api := gpt.NewAPI(http.DefaultClient, &core.APIsCfg{
+		LLMProvider: "openai",
+		GPT:         core.ChatGptAPICfg{BaseURL: server.URL, DefaultModel: "gpt-4o"},
+		GPTCache:    core.GPTCacheCfg{Routes: map[string]time.Duration{"NewGPTChat": ttl}, MaxEntries: maxEntries},
+	})
+	return api, &calls
+}
+
+// The cache knows the route by the gRPC method on the context, as on a real call.
+type fakeServerStream struct{ method string }
+
+func (s fakeServerStream) Method() string               { return s.method }
+func (s fakeServerStream) SetHeader(metadata.MD) error  { return nil }
+func (s fakeServerStream) SendHeader(metadata.MD) error { return nil }
+func (s fakeServerStream) SetTrailer(metadata.MD) error { return nil }
+
+func ctxOnMethod(method string, md ...string) context.Context {
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), fakeServerStream{method})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(md...))
+}
+
+func chatRequest(prompt string) apimodels.LLMChatRequest {
+	return apimodels.LLMChatRequest{System: "Be brief.", Messages: []apimodels.GPTChatMsg{{Role: apimodels.LLMRoleUser, Content: prompt}}}
+}
+
+func TestResponseCacheKeys(t *testing.T) {
+	api, calls := newCachedGPTAPI(t, time.Minute, 10)
+	ctx := ctxOnMethod("/pbs.GPTService/NewGPTChat")
+
+	first, err := api.SendRequestToGPT(ctx, chatRequest("Hello\r\nthere"))
+	assert.NoError(t, err)
+	assert.False(t, first.Cached)
+	assert.NotZero(t, first.Usage.InTotal)
+
+	testCases := []struct {
+		name       string
+		prompt     string
+		wantCached bool
+	}{
+		{"same", "Hello\r\nthere", true},
+		{"whitespace around it", "  Hello\r\nthere\n\n", true},
+		{"other line endings", "Hello\nthere", true},
+		{"other case", "hello\nthere", false},
+		{"whitespace inside it", "Hello\n  there", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			callsBefore := *calls
+			result, err := api.SendRequestToGPT(ctx, chatRequest(tc.prompt))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantCached, result.Cached)
+			if tc.wantCached {
+				assert.Equal(t, first.Content, result.Content)
+				assert.Zero(t, result.Usage.InTotal)
+				assert.Equal(t, callsBefore, *calls)
+			} else {
+				assert.Equal(t, callsBefore+1, *calls)
+			}
+		})
+	}
+
+	// Other routes don't use it.
+	result, err := api.SendRequestToGPT(ctxOnMethod("/pbs.GPTService/ReplyToGPTChat"), chatRequest("Hello\r\nthere"))
+	assert.NoError(t, err)
+	assert.False(t, result.Cached)
+}
+
+func TestResponseCacheTTL(t *testing.T) {
+	api, calls := newCachedGPTAPI(t, 50*time.Millisecond, 10)
+	ctx := ctxOnMethod("/pbs.GPTService/NewGPTChat")
+
+	for range 2 {
+		_, err := api.SendRequestToGPT(ctx, chatRequest("Hi"))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, *calls)
+
+	time.Sleep(80 * time.Millisecond)
+	result, err := api.SendRequestToGPT(ctx, chatRequest("Hi"))
+	assert.NoError(t, err)
+	assert.False(t, result.Cached)
+	assert.Equal(t, "answer 2", result.Content)
+}
+
+func TestResponseCacheSize(t *testing.T) {
+	api, calls := newCachedGPTAPI(t, time.Minute, 2)
+	ctx := ctxOnMethod("/pbs.GPTService/NewGPTChat")
+
+	// B is the least recently used when C comes, so it's the one dropped.
+	for _, prompt := range []string{"A", "B", "A", "C", "A", "B"} {
+		_, err := api.SendRequestToGPT(ctx, chatRequest(prompt))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 4, *calls)
+}
+
+func TestResponseCacheControl(t *testing.T) {
+	testCases := []struct {
+		name       string
+		md         []string
+		wantCached bool // On the second call.
+		wantStored bool // For the third call, without the header.
+	}{
+		{"none", nil, true, true},
+		{"no-cache", []string{"cache-control", "no-cache"}, false, true},
+		{"no-store", []string{"cache-control", "no-store"}, false, false},
+		{"no-cache from the gateway", []string{"grpcgateway-cache-control", "No-Cache"}, false, true},
+		{"no-store from the gateway, among others", []string{"grpcgateway-cache-control", "max-age=0, no-store"}, false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api, _ := newCachedGPTAPI(t, time.Minute, 10)
+			ctx := ctxOnMethod("/pbs.GPTService/NewGPTChat")
+
+			_, err := api.SendRequestToGPT(ctx, chatRequest("Hi"))
+			assert.NoError(t, err)
+
+			result, err := api.SendRequestToGPT(ctxOnMethod("/pbs.GPTService/NewGPTChat", tc.md...), chatRequest("Hi"))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantCached, result.Cached)
+
+			// What's cached now is the second answer if it was stored, or still the first.
+			result, err = api.SendRequestToGPT(ctx, chatRequest("Hi"))
+			assert.NoError(t, err)
+			assert.True(t, result.Cached)
+			if tc.wantStored && !tc.wantCached {
+				assert.Equal(t, "answer 2", result.Content)
+			} else {
+				assert.Equal(t, "answer 1", result.Content)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A chat from before branches: no head and no parents, with a summary in the middle.
+func newChatBeforeBranches() *models.GPTChat {
+	return &models.GPTChat{Messages: []models.GPTMessage{
+		{ID: 1, From: "system"},
+		{ID: 2, From: "user"},
+		{ID: 3, From: "assistant"},
+		{ID: 4, From: models.GPTMessageSummary},
+		{ID: 5, From: "user"},
+		{ID: 6, From: "assistant"},
+	}}
+}
+
+func messageIDs(messages []models.GPTMessage) []int {
+	ids := []int{}
+	for _, msg := range messages {
+		ids = append(ids, msg.ID)
+	}
+	return ids
+}
+
+func TestGPTChatBeforeBranches(t *testing.T) {
+	chat := newChatBeforeBranches()
+
+	assert.Equal(t, []int{1, 2, 3, 5, 6}, messageIDs(chat.BranchMessages()))
+	assert.Equal(t, []int{1, 2, 3}, messageIDs(chat.MessagesUpTo(3)))
+	assert.Empty(t, chat.MessagesUpTo(4)) // Summaries aren't on any branch.
+	assert.Empty(t, chat.MessagesUpTo(99))
+
+	assert.Equal(t, 3, chat.ParentOf(5))
+	assert.Equal(t, []int{5}, chat.Alternatives(5))
+	assert.Nil(t, chat.Alternatives(4))
+	assert.Equal(t, 6, chat.LatestLeaf(1))
+	assert.Equal(t, 6, chat.LatestLeaf(6))
+}
+
+func TestGPTChatBranches(t *testing.T) {
+	chat := newChatBeforeBranches()
+	addMessage := func(id, parentID int, from string) {
+		chat.Messages = append(chat.Messages, models.GPTMessage{ID: id, ParentID: &parentID, From: from})
+		chat.HeadMessageID = &id
+	}
+
+	// Regenerating the last answer.
+	addMessage(7, 5, "assistant")
+	assert.Equal(t, []int{1, 2, 3, 5, 7}, messageIDs(chat.BranchMessages()))
+	assert.Equal(t, []int{6, 7}, chat.Alternatives(7))
+
+	// Editing the first prompt, and its answer.
+	addMessage(8, 1, "user")
+	addMessage(9, 8, "assistant")
+	assert.Equal(t, []int{1, 8, 9}, messageIDs(chat.BranchMessages()))
+	assert.Equal(t, []int{2, 8}, chat.Alternatives(8))
+	assert.Equal(t, []int{9}, chat.Alternatives(9))
+
+	// Switching back to the first prompt goes to its newest answer, the regenerated one.
+	head := chat.LatestLeaf(2)
+	chat.HeadMessageID = &head
+	assert.Equal(t, 7, head)
+	assert.Equal(t, []int{1, 2, 3, 5, 7}, messageIDs(chat.BranchMessages()))
+
+	// And to the edited one, from the start.
+	assert.Equal(t, 9, chat.LatestLeaf(1))
+	assert.Equal(t, []int{1, 8, 9}, messageIDs(chat.MessagesUpTo(chat.LatestLeaf(8))))
+
+	// A summary added after branching still isn't on any of them.
+	chat.Messages = append(chat.Messages, models.GPTMessage{ID: 10, From: models.GPTMessageSummary})
+	assert.Equal(t, []int{1, 2, 3, 5, 7}, messageIDs(chat.BranchMessages()))
+	assert.Equal(t, 9, chat.LatestLeaf(1))
+}
@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+	"github.com/gilperopiola/grpc-gateway-impl/app/service"
+	"github.com/gilperopiola/grpc-gateway-impl/app/tools"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const writtenTitle = "Written title"
+
+// The Service with OpenAI answering with the deltas, all at once or streamed one by one.
+// Titles asked for on their own are always writtenTitle.
+func newTitlesTestService(t *testing.T, deltas []string) (*service.Service, *tools.Tools, int) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		if strings.Contains(string(body), "You write titles") {
+			writeChatAnswer(rw, writtenTitle)
+			return
+		}
+		if !strings.Contains(string(body), `"stream":true`) {
+			writeChatAnswer(rw, strings.Join(deltas, ""))
+			return
+		}
+
+		rw.Header().Set("Content-Type", "text/event-stream")
+		for _, delta := range deltas {
+			content, _ := json.Marshal(delta)
+			fmt.Fprintf(rw, "data: {\"choices\": [{\"delta\": {\"content\": %s}}]}\n\n", content)
+			rw.(http.Flusher).Flush()
+		}
+		fmt.Fprint(rw, "data: {\"choices\": [], \"usage\": {\"prompt_tokens\": 5, \"completion_tokens\": 5, \"total_tokens\": 10}}\n\ndata: [DONE]\n\n")
+	}))
+	t.Cleanup(server.Close)
+
+	svc, testClients, testTools := newTestService(t, func(cfg *core.Config) {
+		cfg.APIsCfg.LLMProvider = "openai"
+		cfg.APIsCfg.GPT.BaseURL = server.URL
+		cfg.APIsCfg.GPT.APIKey = "key"
+		cfg.APIsCfg.GPT.DefaultModel = "gpt-4o"
+	})
+	return svc, testTools, newTestUser(t, testClients, "user")
+}
+
+func writeChatAnswer(rw http.ResponseWriter, answer string) {
+	content, _ := json.Marshal(answer)
+	fmt.Fprintf(rw, `{"choices": [{"message": {"role": "assistant", "content": %s}}], "usage": {"total_tokens": 10}}`, content)
+}
+
+// Keeps what StreamGPTChat sends, as the client would get it.
+type chatStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	deltas strings.Builder
+	chat   *pbs.GPTChatInfo
+	answer *pbs.GPTMessageInfo
+}
+
+func (s *chatStream) Context() context.Context { return s.ctx }
+
+func (s *chatStream) Send(resp *pbs.StreamGPTChatResponse) error {
+	switch event := resp.Event.(type) {
+	case *pbs.StreamGPTChatResponse_Delta:
+		s.deltas.WriteString(event.Delta)
+	case *pbs.StreamGPTChatResponse_Chat:
+		s.chat = event.Chat
+	case *pbs.StreamGPTChatResponse_Message:
+		s.answer = event.Message
+	}
+	return nil
+}
+
+func (s *chatStream) SetHeader(metadata.MD) error  { return nil }
+func (s *chatStream) SendHeader(metadata.MD) error { return nil }
+func (s *chatStream) SetTrailer(metadata.MD)       {}
+
+func TestGPTAnswerTitles(t *testing.T) {
+	testCases := []struct {
+		name        string
+		deltas      []string
+		wantTitle   string // The chat's.
+		wantContent string
+	}{
+		{"title", []string{"Title: Rain in Paris\n\nIt rains a lot."}, "Rain in Paris", "It rains a lot."},
+		{"title split across deltas", []string{"Ti", "tle", ": Rain in ", "Paris\n", "\n", "It rains", " a lot."}, "Rain in Paris", "It rains a lot."},
+		{"markdown title", []string{"**Title:**", " Rain in Paris**\n", "It rains."}, "Rain in Paris", "It rains."},
+		{"heading title", []string{"\n# title: Rain\r\n", "It rains."}, "Rain", "It rains."},
+		{"whitespace before the title", []string{"\n\n", "Title: Rain\n", "  ", "It rains."}, "Rain", "It rains."},
+		{"starts with T", []string{"T", "he rain", " in Paris."}, writtenTitle, "The rain in Paris."},
+		{"title word", []string{"Titles are hard.\n", "They are."}, writtenTitle, "Titles are hard.\nThey are."},
+		{"only T", []string{"T"}, writtenTitle, "T"},
+		{"no title", []string{"It rains", " a lot."}, writtenTitle, "It rains a lot."},
+		{"only a title", []string{"Title: ", "Rain"}, writtenTitle, "Title: Rain"},
+		{"long title", []string{"Title: " + strings.Repeat("word ", 40) + "\n", "It rains."}, strings.TrimSpace(strings.Repeat("word ", 19)) + "…", "It rains."},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, testTools, userID := newTitlesTestService(t, tc.deltas)
+			ctx := userCtx(testTools, userID)
+
+			resp, err := svc.NewGPTChat(ctx, &pbs.NewGPTChatRequest{Message: "How is the weather in Paris?"})
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.wantContent, resp.GetGptMessage())
+				assert.Equal(t, tc.wantTitle, resp.GetChat().GetTitle())
+			}
+
+			// Streamed, the title is taken out of the deltas too, so they end up as the stored answer.
+			stream := &chatStream{ctx: grpc.NewContextWithServerTransportStream(ctx, fakeServerStream{"/pbs.GPTService/StreamGPTChat"})}
+			err = svc.StreamGPTChat(&pbs.StreamGPTChatRequest{Message: "How is the weather in Paris?"}, stream)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.wantContent, stream.deltas.String())
+				assert.Equal(t, tc.wantContent, stream.answer.GetContent())
+				assert.Equal(t, tc.wantTitle, stream.chat.GetTitle())
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/pbs"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGroupRoleOutranks(t *testing.T) {
+	testCases := []struct {
+		role, other models.GroupRole
+		want        bool
+	}{
+		{models.GroupOwnerRole, models.GroupAdminRole, true},
+		{models.GroupOwnerRole, models.GroupMemberRole, true},
+		{models.GroupAdminRole, models.GroupMemberRole, true},
+		{models.GroupAdminRole, models.GroupAdminRole, false},
+		{models.GroupAdminRole, models.GroupOwnerRole, false},
+		{models.GroupMemberRole, models.GroupMemberRole, false},
+		{models.GroupMemberRole, models.GroupAdminRole, false},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, tc.role.Outranks(tc.other), "%s outranks %s", tc.role, tc.other)
+	}
+}
+
+// The roles each user has on the group after a call.
+func groupRoles(group *pbs.GroupInfo) map[int32]pbs.GroupRole {
+	roles := map[int32]pbs.GroupRole{}
+	for _, member := range group.GetMembers() {
+		roles[member.GetUser().GetId()] = member.GetRole()
+	}
+	return roles
+}
+
+func TestRemoveGroupMember(t *testing.T) {
+	testCases := []struct {
+		name     string
+		caller   string
+		target   string
+		wantCode codes.Code
+	}{
+		{"owner removes an admin", "owner", "admin", codes.OK},
+		{"owner removes a member", "owner", "member", codes.OK},
+		{"admin removes a member", "admin", "member", codes.OK},
+		{"admin can't remove another admin", "admin", "otherAdmin", codes.PermissionDenied},
+		{"admin can't remove the owner", "admin", "owner", codes.PermissionDenied},
+		{"member can't remove an admin", "member", "admin", codes.PermissionDenied},
+		{"member can't remove another member", "member", "otherMember", codes.PermissionDenied},
+		{"nobody removes themselves", "admin", "admin", codes.FailedPrecondition},
+		{"outsiders can't remove anyone", "outsider", "member", codes.PermissionDenied},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, testClients, testTools := newTestService(t)
+			users := map[string]int{}
+			for _, name := range []string{"owner", "admin", "otherAdmin", "member", "otherMember", "outsider"} {
+				users[name] = newTestUser(t, testClients, name)
+			}
+			groupID := newTestGroup(t, testClients, users["owner"], map[int]models.GroupRole{
+				users["admin"]:       models.GroupAdminRole,
+				users["otherAdmin"]:  models.GroupAdminRole,
+				users["member"]:      models.GroupMemberRole,
+				users["otherMember"]: models.GroupMemberRole,
+			})
+
+			req := &pbs.RemoveMemberRequest{GroupId: int32(groupID), MemberId: int32(users[tc.target])}
+			resp, err := svc.RemoveMember(userCtx(testTools, users[tc.caller]), req)
+			assert.Equal(t, tc.wantCode, status.Code(err))
+			if tc.wantCode == codes.OK {
+				assert.NotContains(t, groupRoles(resp.GetGroup()), int32(users[tc.target]))
+			}
+		})
+	}
+}
+
+func TestChangeGroupMemberRole(t *testing.T) {
+	testCases := []struct {
+		name     string
+		caller   string
+		target   string
+		role     pbs.GroupRole
+		wantCode codes.Code
+	}{
+		{"owner promotes a member", "owner", "member", pbs.GroupRole_GROUP_ROLE_ADMIN, codes.OK},
+		{"owner demotes an admin", "owner", "admin", pbs.GroupRole_GROUP_ROLE_MEMBER, codes.OK},
+		{"owner can't make another owner", "owner", "admin", pbs.GroupRole_GROUP_ROLE_OWNER, codes.FailedPrecondition},
+		{"owner can't change their own role", "owner", "owner", pbs.GroupRole_GROUP_ROLE_ADMIN, codes.FailedPrecondition},
+		{"admin can't promote to owner", "admin", "member", pbs.GroupRole_GROUP_ROLE_OWNER, codes.PermissionDenied},
+		{"admin can't promote to admin", "admin", "member", pbs.GroupRole_GROUP_ROLE_ADMIN, codes.PermissionDenied},
+		{"member can't promote themselves", "member", "member", pbs.GroupRole_GROUP_ROLE_ADMIN, codes.PermissionDenied},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, testClients, testTools := newTestService(t)
+			users := map[string]int{}
+			for _, name := range []string{"owner", "admin", "member"} {
+				users[name] = newTestUser(t, testClients, name)
+			}
+			groupID := newTestGroup(t, testClients, users["owner"], map[int]models.GroupRole{
+				users["admin"]:  models.GroupAdminRole,
+				users["member"]: models.GroupMemberRole,
+			})
+
+			req := &pbs.ChangeMemberRoleRequest{GroupId: int32(groupID), MemberId: int32(users[tc.target]), Role: tc.role}
+			resp, err := svc.ChangeMemberRole(userCtx(testTools, users[tc.caller]), req)
+			assert.Equal(t, tc.wantCode, status.Code(err))
+			if tc.wantCode == codes.OK {
+				assert.Equal(t, tc.role, groupRoles(resp.GetGroup())[int32(users[tc.target])])
+			}
+		})
+	}
+}
+
+func TestTransferGroupOwnership(t *testing.T) {
+	svc, testClients, testTools := newTestService(t)
+	owner, admin, member := newTestUser(t, testClients, "owner"), newTestUser(t, testClients, "admin"), newTestUser(t, testClients, "member")
+	groupID := newTestGroup(t, testClients, owner, map[int]models.GroupRole{admin: models.GroupAdminRole, member: models.GroupMemberRole})
+
+	// Only the owner can give it away, not even admins.
+	_, err := svc.TransferOwnership(userCtx(testTools, admin), &pbs.TransferOwnershipRequest{GroupId: int32(groupID), NewOwnerId: int32(admin)})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = svc.TransferOwnership(userCtx(testTools, owner), &pbs.TransferOwnershipRequest{GroupId: int32(groupID), NewOwnerId: int32(owner)})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	resp, err := svc.TransferOwnership(userCtx(testTools, owner), &pbs.TransferOwnershipRequest{GroupId: int32(groupID), NewOwnerId: int32(member)})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(member), resp.GetGroup().GetOwner().GetId())
+	roles := groupRoles(resp.GetGroup())
+	assert.Equal(t, pbs.GroupRole_GROUP_ROLE_OWNER, roles[int32(member)])
+	assert.Equal(t, pbs.GroupRole_GROUP_ROLE_ADMIN, roles[int32(owner)])
+
+	// The old owner is now an admin, so they can't kick the new one.
+	_, err = svc.RemoveMember(userCtx(testTools, owner), &pbs.RemoveMemberRequest{GroupId: int32(groupID), MemberId: int32(member)})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/clients"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+	"github.com/gilperopiola/grpc-gateway-impl/app/service"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+// DALL-E is always OpenAI's, so it's answered by dallE.
+func newImageJobsTestService(t *testing.T, dallE http.HandlerFunc) (*service.Service, *clients.Clients) {
+	server := httptest.NewServer(dallE)
+	t.Cleanup(server.Close)
+
+	svc, testClients, _ := newTestService(t, func(cfg *core.Config) {
+		cfg.APIsCfg.GPT.BaseURL = server.URL
+		cfg.APIsCfg.GPT.APIKey = "key"
+	})
+	return svc, testClients
+}
+
+func newQueuedImageJob(t *testing.T, testClients *clients.Clients, operationID string, dueAt time.Time) *models.ImageJob {
+	job := &models.ImageJob{OperationID: operationID, UserID: 1, ChatID: 1, Prompt: "A cat", Status: models.ImageJobQueued, NextAttemptAt: dueAt}
+	assert.NoError(t, testClients.ImageJobRepository().CreateImageJob(context.Background(), job))
+	return job
+}
+
+func getImageJob(t *testing.T, testClients *clients.Clients, operationID string) *models.ImageJob {
+	job, err := testClients.ImageJobRepository().GetImageJob(context.Background(), operationID)
+	assert.NoError(t, err)
+	return job
+}
+
+func TestClaimImageJob(t *testing.T) {
+	_, testClients := newImageJobsTestService(t, http.NotFound)
+	ctx := context.Background()
+
+	newQueuedImageJob(t, testClients, "later", time.Now().Add(time.Hour))
+	newQueuedImageJob(t, testClients, "due", time.Now().Add(-time.Minute))
+
+	// Many workers at once, only one gets it.
+	var mu sync.Mutex
+	var claimed []*models.ImageJob
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			job, err := testClients.ImageJobRepository().ClaimImageJob(ctx)
+			assert.NoError(t, err)
+			if job != nil {
+				mu.Lock()
+				claimed = append(claimed, job)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if assert.Len(t, claimed, 1) {
+		assert.Equal(t, "due", claimed[0].OperationID)
+		assert.Equal(t, models.ImageJobRunning, claimed[0].Status)
+		assert.Equal(t, 1, claimed[0].Attempts)
+	}
+
+	// The other one isn't due yet.
+	job, err := testClients.ImageJobRepository().ClaimImageJob(ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+	assert.Equal(t, models.ImageJobQueued, getImageJob(t, testClients, "later").Status)
+}
+
+func TestCancelRunningImageJob(t *testing.T) {
+	var testClients *clients.Clients
+
+	// DALL-E takes its time, and the job is cancelled meanwhile.
+	svc, testClients := newImageJobsTestService(t, func(rw http.ResponseWriter, req *http.Request) {
+		cancelled, err := testClients.ImageJobRepository().CancelImageJob(req.Context(), "job")
+		assert.NoError(t, err)
+		assert.True(t, cancelled)
+		rw.Write([]byte(`{"data": [{"url": "https://images.example.com/cat.png"}]}`))
+	})
+	ctx := context.Background()
+	cfg := &core.ImageJobsCfg{MaxAttempts: 3, RetryDelay: time.Second}
+
+	newQueuedImageJob(t, testClients, "job", time.Now())
+	job, err := testClients.ImageJobRepository().ClaimImageJob(ctx)
+	assert.NoError(t, err)
+
+	svc.RunImageJob(ctx, job, cfg)
+
+	stored := getImageJob(t, testClients, "job")
+	assert.Equal(t, models.ImageJobCancelled, stored.Status)
+	assert.Nil(t, stored.MediaID)
+	assert.NotNil(t, stored.FinishedAt)
+
+	// Whatever the job was doing when cancelled, it isn't saved over it.
+	job.Status = models.ImageJobQueued
+	saved, err := testClients.ImageJobRepository().UpdateRunningImageJob(ctx, job)
+	assert.NoError(t, err)
+	assert.False(t, saved)
+	assert.Equal(t, models.ImageJobCancelled, getImageJob(t, testClients, "job").Status)
+
+	// And it's done, so it can't be cancelled again.
+	cancelled, err := testClients.ImageJobRepository().CancelImageJob(ctx, "job")
+	assert.NoError(t, err)
+	assert.False(t, cancelled)
+}
+
+func TestImageJobBackoff(t *testing.T) {
+	svc, testClients := newImageJobsTestService(t, func(rw http.ResponseWriter, req *http.Request) {
+		http.Error(rw, `{"error": {"message": "overloaded"}}`, http.StatusServiceUnavailable)
+	})
+	ctx := context.Background()
+	cfg := &core.ImageJobsCfg{MaxAttempts: 5, RetryDelay: 3 * time.Minute}
+
+	newQueuedImageJob(t, testClients, "job", time.Now())
+
+	// Twice as long after each attempt, up to 10 minutes. The last attempt fails it.
+	wantDelays := []time.Duration{3 * time.Minute, 6 * time.Minute, 10 * time.Minute, 10 * time.Minute}
+	for attempt := 1; attempt <= cfg.MaxAttempts; attempt++ {
+		_, err := testClients.DB.Exec("UPDATE image_jobs SET next_attempt_at = ? WHERE operation_id = ?", time.Now(), "job")
+		assert.NoError(t, err)
+
+		job, err := testClients.ImageJobRepository().ClaimImageJob(ctx)
+		if !assert.NoError(t, err) || !assert.NotNil(t, job) {
+			return
+		}
+		assert.Equal(t, attempt, job.Attempts)
+
+		ranAt := time.Now()
+		svc.RunImageJob(ctx, job, cfg)
+		stored := getImageJob(t, testClients, "job")
+
+		if attempt < cfg.MaxAttempts {
+			assert.Equal(t, models.ImageJobQueued, stored.Status)
+			assert.WithinDuration(t, ranAt.Add(wantDelays[attempt-1]), stored.NextAttemptAt, 5*time.Second)
+			assert.Contains(t, stored.LastError, "overloaded")
+			continue
+		}
+
+		assert.Equal(t, models.ImageJobFailed, stored.Status)
+		assert.Equal(t, int32(codes.Unavailable), stored.ErrorCode)
+		assert.NotNil(t, stored.FinishedAt)
+	}
+}
@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/clients/apis/gpt"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/apimodels"
+	"github.com/gilperopiola/grpc-gateway-impl/app/tools"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// With chats on a local server, moderation still goes to its own base URL, with its own key.
+func TestModerationBaseURL(t *testing.T) {
+	localCalls := 0
+	local := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		localCalls++
+		http.NotFound(rw, req)
+	}))
+	defer local.Close()
+
+	var moderationAuth, moderationPath string
+	moderation := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		moderationAuth, moderationPath = req.Header.Get("Authorization"), req.URL.Path
+		rw.Write([]byte(`{"results": [{"flagged": true, "categories": {"violence": true, "harassment": false}}]}`))
+	}))
+	defer moderation.Close()
+
+	cfg := &core.APIsCfg{GPT: core.ChatGptAPICfg{
+		BaseURL:           local.URL + "/v1",
+		APIKey:            "local-key",
+		ModerationBaseURL: moderation.URL + "/v1/",
+		ModerationAPIKey:  "moderation-key",
+	}}
+	result, err := gpt.NewAPI(http.DefaultClient, cfg).SendToModeration(context.Background(), "some text")
+
+	assert.NoError(t, err)
+	assert.Equal(t, apimodels.ModerationBlock, result.Action)
+	assert.Equal(t, []string{"violence"}, result.Categories())
+	assert.Equal(t, "/v1/moderations", moderationPath)
+	assert.Contains(t, moderationAuth, "moderation-key")
+	assert.Zero(t, localCalls)
+}
+
+func TestModerateText(t *testing.T) {
+	rulesFile := filepath.Join(t.TempDir(), "rules.json")
+	rules := `[
+		{"category": "names", "action": "redact", "keywords": ["Bob", "Bob Smith", "hello!"]},
+		{"category": "suffixes", "action": "redact", "patterns": ["Smith Jr\\b"]}
+	]`
+	assert.NoError(t, os.WriteFile(rulesFile, []byte(rules), 0o600))
+
+	moderator, err := tools.NewModerator(&core.ModerationCfg{
+		Enabled:      true,
+		RulesFile:    rulesFile,
+		BlockedWords: []string{"c++", "darn"},
+		PIIAction:    "redact",
+	})
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		text           string
+		wantAction     apimodels.ModerationAction
+		wantText       string
+		wantCategories []string
+	}{
+		{"nothing", "Nothing to see here.", apimodels.ModerationAllow, "Nothing to see here.", nil},
+
+		// Overlapping redactions are replaced once, by the first match, or the longest if they start together.
+		{"same start", "Ask Bob Smith.", apimodels.ModerationRedact, "Ask [REDACTED_NAMES].", []string{"names"}},
+		{"partial overlap", "Ask Bob Smith Jr now.", apimodels.ModerationRedact, "Ask [REDACTED_NAMES] now.", []string{"names", "suffixes"}},
+		{"email with a name", "Write to bob@example.com.", apimodels.ModerationRedact, "Write to [REDACTED_PII_EMAIL].", []string{"names", "pii_email"}},
+
+		// Only numbers that pass the Luhn check are card numbers.
+		{"card", "Card 4111 1111 1111 1111 ok", apimodels.ModerationRedact, "Card [REDACTED_PII_CARD] ok", []string{"pii_card"}},
+		{"card with dashes", "5555-5555-5555-4444", apimodels.ModerationRedact, "[REDACTED_PII_CARD]", []string{"pii_card"}},
+		{"not a card", "Card 4111 1111 1111 1112 ok", apimodels.ModerationAllow, "Card 4111 1111 1111 1112 ok", nil},
+		{"order number", "Order 1234567890123 shipped", apimodels.ModerationAllow, "Order 1234567890123 shipped", nil},
+
+		// Keywords that start or end in punctuation can't use \b on that side.
+		{"keyword ending in punctuation", "I like C++.", apimodels.ModerationBlock, "I like C++.", []string{"blocked_words"}},
+		{"keyword ending in punctuation, inside a word", "I like abc++.", apimodels.ModerationAllow, "I like abc++.", nil},
+		{"keyword ending in !", "Well, hello!!", apimodels.ModerationRedact, "Well, [REDACTED_NAMES]!", []string{"names"}},
+		{"keyword ending in !, inside a word", "Othello! is a play.", apimodels.ModerationAllow, "Othello! is a play.", nil},
+		{"keyword inside a word", "Darnell and Bobby", apimodels.ModerationAllow, "Darnell and Bobby", nil},
+
+		// The strictest action wins, and blocked texts aren't redacted.
+		{"block and redact", "Darn, Bob took my card.", apimodels.ModerationBlock, "Darn, Bob took my card.", []string{"blocked_words"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := moderator.ModerateText(tc.text)
+			assert.Equal(t, tc.wantAction, result.Action)
+			assert.Equal(t, tc.wantText, result.Text)
+			assert.ElementsMatch(t, tc.wantCategories, result.Categories())
+		})
+	}
+}
+
+func TestModerateTextDisabled(t *testing.T) {
+	moderator, err := tools.NewModerator(&core.ModerationCfg{BlockedWords: []string{"darn"}, PIIAction: "block", UseProviderAPI: true})
+	assert.NoError(t, err)
+
+	result := moderator.ModerateText("Darn, it's bob@example.com")
+	assert.Equal(t, apimodels.ModerationAllow, result.Action)
+	assert.False(t, moderator.ModerationEnabled())
+	assert.False(t, moderator.ModeratesWithProvider())
+}
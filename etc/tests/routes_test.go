@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRouteFromGRPCMethod(t *testing.T) {
+	testCases := []struct {
+		method   string
+		wantName string
+		wantAuth core.AuthMethod
+	}{
+		{"/pbs.AuthService/Signup", "Signup", core.RouteAuthPublic},
+		{"/pbs.UsersSvc/GetUser", "GetUser", core.RouteAuthSelf},
+		{"/grpc.health.v1.Health/Check", "HealthCheck", core.RouteAuthPublic},
+		{"/grpc.health.v1.Health/Watch", "HealthWatch", core.RouteAuthPublic},
+		{"/grpc.health.v1.Health/List", "HealthList", core.RouteAuthPublic},
+
+		// Only the standard health service's methods are public, not any other with the same name.
+		{"/pbs.UsersSvc/Check", "", ""},
+		{"/pbs.GroupsService/List", "", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.method, func(t *testing.T) {
+			route := core.GetRouteFromGRPCMethod(tc.method)
+			assert.Equal(t, tc.wantName, route.Name)
+			assert.Equal(t, tc.wantAuth, route.Auth)
+		})
+	}
+}
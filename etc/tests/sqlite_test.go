@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/clients"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core/models"
+	"github.com/gilperopiola/grpc-gateway-impl/app/service"
+	"github.com/gilperopiola/grpc-gateway-impl/app/tools"
+)
+
+// The whole Service on a SQLite DB of its own, with the fake LLM provider and nothing else outside.
+// The cfg can be changed before it's used, on configure.
+func newTestService(t *testing.T, configure ...func(cfg *core.Config)) (*service.Service, *clients.Clients, *tools.Tools) {
+	cfg := core.LoadConfig()
+	cfg.DBCfg = core.DBCfg{
+		SQLiteFile:    filepath.Join(t.TempDir(), "test.db") + "?_busy_timeout=5000&_journal_mode=WAL",
+		MigrateModels: true,
+		Retries:       1,
+	}
+	cfg.APIsCfg.LLMProvider = "fake"
+	cfg.APIsCfg.Embeddings.Provider = "fake"
+	cfg.APIsCfg.Fixtures.Mode = "off"
+	cfg.APIsCfg.GPTCache.Routes = nil
+	cfg.ModerationCfg = core.ModerationCfg{PIIAction: "allow"}
+	for _, fn := range configure {
+		fn(cfg)
+	}
+
+	testTools := tools.Setup(cfg)
+	testClients, err := clients.Setup(cfg, testTools)
+	if err != nil {
+		t.Fatalf("error setting up the test DB: %v", err)
+	}
+	t.Cleanup(func() { testClients.CloseDB() })
+
+	return service.Setup(testClients, testTools, cfg), testClients, testTools
+}
+
+func newTestUser(t *testing.T, testClients *clients.Clients, username string) int {
+	user, err := testClients.UserRepository().CreateUser(context.Background(), username, "hashed-pwd")
+	if err != nil {
+		t.Fatalf("error creating test user: %v", err)
+	}
+	return user.ID
+}
+
+// The owner creates it, and the members are added with their roles.
+func newTestGroup(t *testing.T, testClients *clients.Clients, ownerID int, members map[int]models.GroupRole) int {
+	ctx := context.Background()
+	group, err := testClients.GroupRepository().CreateGroup(ctx, "Test group", ownerID, nil)
+	if err != nil {
+		t.Fatalf("error creating test group: %v", err)
+	}
+	for userID, role := range members {
+		if err := testClients.GroupRepository().AddGroupMember(ctx, group.ID, userID, role); err != nil {
+			t.Fatalf("error adding test group member: %v", err)
+		}
+	}
+	return group.ID
+}
+
+// As after the auth interceptors, with the user on it.
+func userCtx(testTools *tools.Tools, userID int) context.Context {
+	return testTools.AddUserInfoToCtx(context.Background(), strconv.Itoa(userID), "user"+strconv.Itoa(userID))
+}
@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gilperopiola/grpc-gateway-impl/app/clients/apis/weather"
+	"github.com/gilperopiola/grpc-gateway-impl/app/core"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newFakeWeatherAPI() core.WeatherAPI {
+	cfg := core.OpenWeatherMapAPICfg{Provider: "fake", CacheTTL: time.Minute, CacheDecimals: 2, CacheMaxEntries: 10}
+	return weather.NewAPI(http.DefaultClient, cfg)
+}
+
+func TestFakeWeatherCurrent(t *testing.T) {
+	current, err := newFakeWeatherAPI().GetCurrentWeather(context.Background(), -34.60123, -58.43987)
+	assert.NoError(t, err)
+	assert.Equal(t, "Buenos Aires", current.Name)
+	assert.Equal(t, -34.6, current.Coord.Lat)
+	assert.Equal(t, -58.44, current.Coord.Lon)
+	assert.NotEmpty(t, current.Weather)
+}
+
+func TestFakeWeatherForecast(t *testing.T) {
+	forecast, err := newFakeWeatherAPI().GetWeatherForecast(context.Background(), 48.86, 2.32)
+	assert.NoError(t, err)
+	assert.Equal(t, "Paris", forecast.City.Name)
+	assert.Len(t, forecast.List, 40)
+	assert.Greater(t, int64(forecast.List[0].Dt), time.Now().Unix())
+	assert.Equal(t, 3*60*60, forecast.List[1].Dt-forecast.List[0].Dt)
+}
+
+func TestFakeWeatherGeocoding(t *testing.T) {
+	api := newFakeWeatherAPI()
+
+	places, err := api.GeocodeCity(context.Background(), "london", 5)
+	assert.NoError(t, err)
+	assert.Len(t, places, 2)
+
+	places, err = api.GeocodeCity(context.Background(), "London,CA", 5)
+	assert.NoError(t, err)
+	assert.Len(t, places, 1)
+	assert.Equal(t, "Ontario", places[0].State)
+
+	places, err = api.GeocodeCity(context.Background(), "Atlantis", 5)
+	assert.NoError(t, err)
+	assert.Empty(t, places)
+}
+
+// The cache keeps answering once the provider is down, but pinging it doesn't.
+func TestWeatherPingSkipsCache(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`[{"name":"London","lat":51.5,"lon":-0.12,"country":"GB"}]`))
+	}))
+
+	cfg := core.OpenWeatherMapAPICfg{Provider: "openweathermap", BaseURL: server.URL, AppID: "test", CacheTTL: time.Minute, CacheMaxEntries: 10}
+	api := weather.NewAPI(http.DefaultClient, cfg)
+	ctx := context.Background()
+
+	_, err := api.GeocodeCity(ctx, "London", 1)
+	assert.NoError(t, err)
+	assert.NoError(t, api.PingWeather(ctx))
+	assert.NoError(t, api.PingWeather(ctx))
+	assert.Equal(t, 3, calls)
+
+	server.Close()
+
+	places, err := api.GeocodeCity(ctx, "London", 1)
+	assert.NoError(t, err)
+	assert.Len(t, places, 1)
+	assert.Error(t, api.PingWeather(ctx))
+}
+
+// Errors about the request itself, like it failing to connect, come with its URL but not with the app ID.
+func TestWeatherErrorsHideAppID(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	cfg := core.OpenWeatherMapAPICfg{Provider: "openweathermap", BaseURL: server.URL, AppID: "super-secret-app-id"}
+	_, err := weather.NewAPI(http.DefaultClient, cfg).GetCurrentWeather(context.Background(), 1, 2)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), server.URL+"/data/2.5/weather")
+	assert.NotContains(t, err.Error(), "super-secret-app-id")
+}
+
+// Past its size, the least recently used answers are dropped, and asking for them again makes the call.
+func TestWeatherCacheSize(t *testing.T) {
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		calls[req.URL.Query().Get("q")]++
+		rw.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	cfg := core.OpenWeatherMapAPICfg{Provider: "openweathermap", BaseURL: server.URL, AppID: "test", CacheTTL: time.Minute, CacheMaxEntries: 2}
+	api := weather.NewAPI(http.DefaultClient, cfg)
+	geocode := func(name string) {
+		_, err := api.GeocodeCity(context.Background(), name, 1)
+		assert.NoError(t, err)
+	}
+
+	geocode("Paris")
+	geocode("Tokyo")
+	geocode("Paris") // Cached, and now more recently used than Tokyo.
+	geocode("Lima")  // Drops Tokyo.
+	geocode("Paris")
+	geocode("Tokyo")
+
+	assert.Equal(t, map[string]int{"Paris": 1, "Tokyo": 2, "Lima": 1}, calls)
+}